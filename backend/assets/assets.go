@@ -0,0 +1,310 @@
+package assets
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"time"
+
+	"arjunmal1311/fans_flow_on_chain/backend/db"
+	"arjunmal1311/fans_flow_on_chain/backend/models"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+var store AssetStore
+
+// Init selects the asset store from the environment (see FromEnv).
+func Init() error {
+	s, err := FromEnv()
+	if err != nil {
+		return err
+	}
+	store = s
+	log.Printf("Storing assets in the %s store", store.Name())
+	return nil
+}
+
+func collection() *mongo.Collection {
+	return db.GetCollection("assets")
+}
+
+// Create stores data and records it as a new asset described by info, of
+// which the caller fills in the content type, name, owner, and optionally
+// how the content was generated.
+func Create(ctx context.Context, data []byte, info models.Asset) (*models.Asset, error) {
+	key := Key(data)
+	if err := store.Put(ctx, key, data, info.ContentType); err != nil {
+		return nil, err
+	}
+
+	asset := info
+	asset.ID = primitive.NewObjectID()
+	asset.Hash = key
+	asset.Size = int64(len(data))
+	asset.CreatedAt = time.Now()
+	if _, err := collection().InsertOne(ctx, asset); err != nil {
+		return nil, err
+	}
+	return &asset, nil
+}
+
+// Get returns the asset with the given hex ID, or nil if there is none or
+// the ID is malformed.
+func Get(ctx context.Context, id string) (*models.Asset, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, nil
+	}
+
+	var asset models.Asset
+	err = collection().FindOne(ctx, bson.M{"_id": objectID}).Decode(&asset)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &asset, nil
+}
+
+// Open returns the content of asset.
+func Open(ctx context.Context, asset *models.Asset) (io.ReadCloser, error) {
+	return store.Open(ctx, asset.Hash)
+}
+
+// Read returns the whole content of asset.
+func Read(ctx context.Context, asset *models.Asset) ([]byte, error) {
+	r, err := Open(ctx, asset)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return io.ReadAll(r)
+}
+
+// Content is one rendition to store with CreateSet.
+type Content struct {
+	Name        string
+	Data        []byte
+	ContentType string
+	Width       int
+	Height      int
+}
+
+// CreateSet stores the renditions of one image. The rendition named primary
+// becomes the returned asset, described by info, and lists every rendition;
+// the others are stored as assets linked back to it.
+func CreateSet(ctx context.Context, primary string, contents []Content, info models.Asset) (*models.Asset, error) {
+	var main *Content
+	for i := range contents {
+		if contents[i].Name == primary {
+			main = &contents[i]
+		}
+	}
+	if main == nil {
+		return nil, fmt.Errorf("no %s rendition to store", primary)
+	}
+
+	first := info
+	first.ContentType = main.ContentType
+	first.Width = main.Width
+	first.Height = main.Height
+	asset, err := Create(ctx, main.Data, first)
+	if err != nil {
+		return nil, err
+	}
+
+	renditions := map[string]models.Rendition{}
+	for _, content := range contents {
+		rendition := asset
+		if content.Name != primary {
+			child := info
+			child.ContentType = content.ContentType
+			child.Width = content.Width
+			child.Height = content.Height
+			child.SourceID = &asset.ID
+			if rendition, err = Create(ctx, content.Data, child); err != nil {
+				return nil, err
+			}
+		}
+		renditions[content.Name] = models.Rendition{
+			AssetID:     rendition.ID.Hex(),
+			ContentType: rendition.ContentType,
+			Width:       rendition.Width,
+			Height:      rendition.Height,
+			Size:        rendition.Size,
+		}
+	}
+
+	if _, err := collection().UpdateOne(ctx, bson.M{"_id": asset.ID}, bson.M{"$set": bson.M{"renditions": renditions}}); err != nil {
+		return nil, err
+	}
+	asset.Renditions = renditions
+	return asset, nil
+}
+
+// GetRendition returns the asset holding asset's rendition called name, or
+// nil if it has none.
+func GetRendition(ctx context.Context, asset *models.Asset, name string) (*models.Asset, error) {
+	rendition, ok := asset.Renditions[name]
+	if !ok {
+		return nil, nil
+	}
+	if rendition.AssetID == asset.ID.Hex() {
+		return asset, nil
+	}
+	return Get(ctx, rendition.AssetID)
+}
+
+// SetRenditionURL records where the public copy of asset's rendition called
+// name lives.
+func SetRenditionURL(ctx context.Context, asset *models.Asset, name, url string) error {
+	rendition := asset.Renditions[name]
+	rendition.URL = url
+
+	_, err := collection().UpdateOne(ctx, bson.M{"_id": asset.ID}, bson.M{"$set": bson.M{"renditions." + name: rendition}})
+	if err != nil {
+		return err
+	}
+	asset.Renditions[name] = rendition
+	return nil
+}
+
+// AddRendition stores content as a further rendition of asset.
+func AddRendition(ctx context.Context, asset *models.Asset, content Content) (*models.Asset, error) {
+	child := models.Asset{
+		ContentType: content.ContentType,
+		Name:        asset.Name,
+		OwnerWallet: asset.OwnerWallet,
+		Temporary:   asset.Temporary,
+		Width:       content.Width,
+		Height:      content.Height,
+		SourceID:    &asset.ID,
+	}
+	rendition, err := Create(ctx, content.Data, child)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := models.Rendition{
+		AssetID:     rendition.ID.Hex(),
+		ContentType: rendition.ContentType,
+		Width:       rendition.Width,
+		Height:      rendition.Height,
+		Size:        rendition.Size,
+	}
+	_, err = collection().UpdateOne(ctx, bson.M{"_id": asset.ID}, bson.M{"$set": bson.M{"renditions." + content.Name: entry}})
+	if err != nil {
+		return nil, err
+	}
+	if asset.Renditions == nil {
+		asset.Renditions = map[string]models.Rendition{}
+	}
+	asset.Renditions[content.Name] = entry
+	return rendition, nil
+}
+
+// SetAccess changes the access tier of asset and its renditions.
+func SetAccess(ctx context.Context, asset *models.Asset, access string, modelID *primitive.ObjectID, optionIDs []primitive.ObjectID) error {
+	set, unset := bson.M{"access": access}, bson.M{}
+	if modelID != nil {
+		set["model_id"] = *modelID
+	} else {
+		unset["model_id"] = ""
+	}
+	if len(optionIDs) > 0 {
+		set["option_ids"] = optionIDs
+	} else {
+		unset["option_ids"] = ""
+	}
+	update := bson.M{"$set": set}
+	if len(unset) > 0 {
+		update["$unset"] = unset
+	}
+
+	_, err := collection().UpdateMany(ctx, bson.M{"$or": []bson.M{{"_id": asset.ID}, {"source_id": asset.ID}}}, update)
+	if err != nil {
+		return err
+	}
+	asset.Access = access
+	asset.ModelID = modelID
+	asset.OptionIDs = optionIDs
+	return nil
+}
+
+// Keep marks asset and its renditions as permanent so the cleanup job
+// leaves them alone.
+func Keep(ctx context.Context, asset *models.Asset) error {
+	_, err := collection().UpdateMany(ctx,
+		bson.M{"$or": []bson.M{{"_id": asset.ID}, {"source_id": asset.ID}}},
+		bson.M{"$set": bson.M{"temporary": false}},
+	)
+	return err
+}
+
+// Delete removes the asset and its renditions, and their content once no
+// other asset shares it.
+func Delete(ctx context.Context, asset *models.Asset) error {
+	cursor, err := collection().Find(ctx, bson.M{"source_id": asset.ID})
+	if err != nil {
+		return err
+	}
+	var renditions []models.Asset
+	if err := cursor.All(ctx, &renditions); err != nil {
+		return err
+	}
+
+	for _, a := range append(renditions, *asset) {
+		if err := deleteOne(ctx, a); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func deleteOne(ctx context.Context, asset models.Asset) error {
+	if _, err := collection().DeleteOne(ctx, bson.M{"_id": asset.ID}); err != nil {
+		return err
+	}
+
+	shared, err := collection().CountDocuments(ctx, bson.M{"hash": asset.Hash})
+	if err != nil {
+		return err
+	}
+	if shared > 0 {
+		return nil
+	}
+	return store.Delete(ctx, asset.Hash)
+}
+
+// DeleteTemporary removes temporary assets created before cutoff, with
+// their renditions, and returns how many were removed.
+func DeleteTemporary(ctx context.Context, cutoff time.Time) (int, error) {
+	cursor, err := collection().Find(ctx, bson.M{
+		"temporary":  true,
+		"created_at": bson.M{"$lt": cutoff},
+		"source_id":  bson.M{"$exists": false},
+	})
+	if err != nil {
+		return 0, err
+	}
+	defer cursor.Close(ctx)
+
+	removed := 0
+	for cursor.Next(ctx) {
+		var asset models.Asset
+		if err := cursor.Decode(&asset); err != nil {
+			return removed, err
+		}
+		if err := Delete(ctx, &asset); err != nil {
+			log.Printf("Failed to remove temporary asset %s: %v", asset.ID.Hex(), err)
+			continue
+		}
+		removed++
+	}
+	return removed, cursor.Err()
+}
@@ -0,0 +1,86 @@
+package assets
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+const defaultLocalDir = "data/assets"
+
+// Local stores blobs on disk, sharded by the first two bytes of the key:
+// <dir>/ab/cd/abcd....
+type Local struct {
+	dir string
+}
+
+func NewLocal(dir string) (*Local, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("error creating asset directory %s: %v", dir, err)
+	}
+	return &Local{dir: dir}, nil
+}
+
+func (l *Local) Name() string {
+	return "local"
+}
+
+func (l *Local) path(key string) string {
+	return filepath.Join(l.dir, key[0:2], key[2:4], key)
+}
+
+func (l *Local) Put(ctx context.Context, key string, data []byte, contentType string) error {
+	if err := checkKey(key); err != nil {
+		return err
+	}
+
+	path := l.path(key)
+	if _, err := os.Stat(path); err == nil {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	// Write to a temporary file first so a reader never sees a partial blob.
+	tmp, err := os.CreateTemp(filepath.Dir(path), key+".tmp-*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+func (l *Local) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	if err := checkKey(key); err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(l.path(key))
+	if os.IsNotExist(err) {
+		return nil, ErrNotFound
+	}
+	return f, err
+}
+
+func (l *Local) Delete(ctx context.Context, key string) error {
+	if err := checkKey(key); err != nil {
+		return err
+	}
+
+	err := os.Remove(l.path(key))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
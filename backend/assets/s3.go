@@ -0,0 +1,201 @@
+package assets
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	defaultS3Region = "us-east-1"
+	s3Service       = "s3"
+	amzDateFormat   = "20060102T150405Z"
+)
+
+type S3Config struct {
+	Endpoint      string
+	Region        string
+	Bucket        string
+	AccessKey     string
+	SecretKey     string
+	VirtualHosted bool
+}
+
+// S3 stores blobs in an S3-compatible bucket (AWS, MinIO, R2, ...) using
+// plain HTTP requests signed with AWS Signature Version 4. Keys use the same
+// ab/cd/<hash> layout as the local store.
+type S3 struct {
+	endpoint *url.URL
+	config   S3Config
+	client   *http.Client
+}
+
+func NewS3(config S3Config) (*S3, error) {
+	if config.Endpoint == "" || config.Bucket == "" {
+		return nil, errors.New("S3_ENDPOINT and S3_BUCKET are required for the s3 asset store")
+	}
+	if config.AccessKey == "" || config.SecretKey == "" {
+		return nil, errors.New("S3_ACCESS_KEY and S3_SECRET_KEY are required for the s3 asset store")
+	}
+	if config.Region == "" {
+		config.Region = defaultS3Region
+	}
+
+	endpoint, err := url.Parse(strings.TrimRight(config.Endpoint, "/"))
+	if err != nil || endpoint.Host == "" {
+		return nil, fmt.Errorf("invalid S3_ENDPOINT %q", config.Endpoint)
+	}
+
+	return &S3{
+		endpoint: endpoint,
+		config:   config,
+		client:   &http.Client{Timeout: time.Minute},
+	}, nil
+}
+
+func (s *S3) Name() string {
+	return "s3"
+}
+
+func (s *S3) objectURL(key string) *url.URL {
+	u := *s.endpoint
+	object := key[0:2] + "/" + key[2:4] + "/" + key
+	if s.config.VirtualHosted {
+		u.Host = s.config.Bucket + "." + u.Host
+		u.Path = u.Path + "/" + object
+	} else {
+		u.Path = u.Path + "/" + s.config.Bucket + "/" + object
+	}
+	return &u
+}
+
+func (s *S3) Put(ctx context.Context, key string, data []byte, contentType string) error {
+	if err := checkKey(key); err != nil {
+		return err
+	}
+
+	res, err := s.do(ctx, http.MethodPut, key, data, contentType)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return s3Error(res)
+	}
+	return nil
+}
+
+func (s *S3) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	if err := checkKey(key); err != nil {
+		return nil, err
+	}
+
+	res, err := s.do(ctx, http.MethodGet, key, nil, "")
+	if err != nil {
+		return nil, err
+	}
+
+	switch res.StatusCode {
+	case http.StatusOK:
+		return res.Body, nil
+	case http.StatusNotFound:
+		res.Body.Close()
+		return nil, ErrNotFound
+	default:
+		defer res.Body.Close()
+		return nil, s3Error(res)
+	}
+}
+
+func (s *S3) Delete(ctx context.Context, key string) error {
+	if err := checkKey(key); err != nil {
+		return err
+	}
+
+	res, err := s.do(ctx, http.MethodDelete, key, nil, "")
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusNoContent && res.StatusCode != http.StatusOK && res.StatusCode != http.StatusNotFound {
+		return s3Error(res)
+	}
+	return nil
+}
+
+func (s *S3) do(ctx context.Context, method, key string, body []byte, contentType string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, s.objectURL(key).String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	s.sign(req, body, time.Now().UTC())
+
+	return s.client.Do(req)
+}
+
+// sign adds an AWS Signature Version 4 Authorization header to req. Only
+// host, x-amz-content-sha256 and x-amz-date are signed.
+func (s *S3) sign(req *http.Request, body []byte, now time.Time) {
+	payloadHash := sha256Hex(body)
+	amzDate := now.Format(amzDateFormat)
+	date := amzDate[:8]
+
+	req.Header.Set("x-amz-content-sha256", payloadHash)
+	req.Header.Set("x-amz-date", amzDate)
+
+	signedHeaders := "host;x-amz-content-sha256;x-amz-date"
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		"host:" + req.URL.Host + "\n" +
+			"x-amz-content-sha256:" + payloadHash + "\n" +
+			"x-amz-date:" + amzDate + "\n",
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + s.config.Region + "/" + s3Service + "/aws4_request"
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + sha256Hex([]byte(canonicalRequest))
+
+	signingKey := hmacSHA256([]byte("AWS4"+s.config.SecretKey), date)
+	signingKey = hmacSHA256(signingKey, s.config.Region)
+	signingKey = hmacSHA256(signingKey, s3Service)
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.config.AccessKey, scope, signedHeaders, signature,
+	))
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+func s3Error(res *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+	return fmt.Errorf("s3 request failed with status %d: %s", res.StatusCode, strings.TrimSpace(string(body)))
+}
@@ -0,0 +1,291 @@
+package assets
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+)
+
+const (
+	testAccessKey = "minio-access"
+	testSecretKey = "minio-secret"
+	testBucket    = "assets"
+	testRegion    = "eu-west-1"
+)
+
+// fakeS3 is a path-style, MinIO-like stand-in that only accepts requests
+// signed with testSecretKey for sharded object paths.
+type fakeS3 struct {
+	t       *testing.T
+	mu      sync.Mutex
+	objects map[string][]byte
+	types   map[string]string
+	// requests counts the requests that reached the server.
+	requests int
+}
+
+var objectPath = regexp.MustCompile(`^/` + testBucket + `/([0-9a-f]{2})/([0-9a-f]{2})/([0-9a-f]{64})$`)
+
+var credentialPattern = regexp.MustCompile(`^AWS4-HMAC-SHA256 Credential=([^/]+)/(\d{8})/([^/]+)/s3/aws4_request, SignedHeaders=([a-z0-9;-]+), Signature=([0-9a-f]{64})$`)
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.requests++
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := f.verifySignature(r, body); err != nil {
+		http.Error(w, "SignatureDoesNotMatch: "+err.Error(), http.StatusForbidden)
+		return
+	}
+
+	m := objectPath.FindStringSubmatch(r.URL.Path)
+	if m == nil || m[3][0:2] != m[1] || m[3][2:4] != m[2] {
+		f.t.Errorf("%s %s is not a sharded ab/cd/<hash> object path", r.Method, r.URL.Path)
+		http.Error(w, "bad path", http.StatusBadRequest)
+		return
+	}
+	key := m[3]
+
+	switch r.Method {
+	case http.MethodPut:
+		f.objects[key] = body
+		f.types[key] = r.Header.Get("Content-Type")
+	case http.MethodGet:
+		data, ok := f.objects[key]
+		if !ok {
+			http.Error(w, "NoSuchKey", http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", f.types[key])
+		w.Write(data)
+	case http.MethodDelete:
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// verifySignature recomputes the Signature Version 4 signature of r the way
+// S3 does and compares it with the one in the Authorization header.
+func (f *fakeS3) verifySignature(r *http.Request, body []byte) error {
+	m := credentialPattern.FindStringSubmatch(r.Header.Get("Authorization"))
+	if m == nil {
+		return errors.New("malformed Authorization header")
+	}
+	accessKey, date, region, signedHeaders, signature := m[1], m[2], m[3], m[4], m[5]
+	if accessKey != testAccessKey {
+		return errors.New("unknown access key")
+	}
+	if region != testRegion {
+		return errors.New("wrong region")
+	}
+
+	amzDate := r.Header.Get("x-amz-date")
+	if !strings.HasPrefix(amzDate, date) {
+		return errors.New("x-amz-date does not match the credential scope")
+	}
+	payloadSum := sha256.Sum256(body)
+	payloadHash := hex.EncodeToString(payloadSum[:])
+	if r.Header.Get("x-amz-content-sha256") != payloadHash {
+		return errors.New("x-amz-content-sha256 does not match the body")
+	}
+
+	var headers strings.Builder
+	for _, name := range strings.Split(signedHeaders, ";") {
+		value := r.Header.Get(name)
+		if name == "host" {
+			value = r.Host
+		}
+		headers.WriteString(name + ":" + strings.TrimSpace(value) + "\n")
+	}
+	for _, required := range []string{"host", "x-amz-content-sha256", "x-amz-date"} {
+		if !strings.Contains(";"+signedHeaders+";", ";"+required+";") {
+			return errors.New(required + " is not signed")
+		}
+	}
+
+	canonicalRequest := strings.Join([]string{
+		r.Method,
+		r.URL.EscapedPath(),
+		r.URL.RawQuery,
+		headers.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+	canonicalSum := sha256.Sum256([]byte(canonicalRequest))
+	scope := date + "/" + region + "/s3/aws4_request"
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(canonicalSum[:])
+
+	key := []byte("AWS4" + testSecretKey)
+	for _, part := range []string{date, region, "s3", "aws4_request"} {
+		mac := hmac.New(sha256.New, key)
+		mac.Write([]byte(part))
+		key = mac.Sum(nil)
+	}
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(stringToSign))
+	if hex.EncodeToString(mac.Sum(nil)) != signature {
+		return errors.New("signature mismatch")
+	}
+	return nil
+}
+
+func newFakeS3(t *testing.T) (*fakeS3, *httptest.Server) {
+	fake := &fakeS3{t: t, objects: map[string][]byte{}, types: map[string]string{}}
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+	return fake, server
+}
+
+func newTestS3(t *testing.T, endpoint, secretKey string) *S3 {
+	store, err := NewS3(S3Config{
+		Endpoint:  endpoint,
+		Region:    testRegion,
+		Bucket:    testBucket,
+		AccessKey: testAccessKey,
+		SecretKey: secretKey,
+	})
+	if err != nil {
+		t.Fatalf("NewS3: %v", err)
+	}
+	return store
+}
+
+func TestS3PutOpenDelete(t *testing.T) {
+	fake, server := newFakeS3(t)
+	store := newTestS3(t, server.URL, testSecretKey)
+	ctx := context.Background()
+
+	data := []byte("\x89PNG not really")
+	key := Key(data)
+
+	if err := store.Put(ctx, key, data, "image/png"); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+	if got := fake.objects[key]; string(got) != string(data) {
+		t.Fatalf("stored %q, want %q", got, data)
+	}
+	if got := fake.types[key]; got != "image/png" {
+		t.Fatalf("stored content type %q, want image/png", got)
+	}
+
+	rc, err := store.Open(ctx, key)
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	got, err := io.ReadAll(rc)
+	rc.Close()
+	if err != nil || string(got) != string(data) {
+		t.Fatalf("Open read %q, %v, want %q", got, err, data)
+	}
+
+	if err := store.Delete(ctx, key); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if _, err := store.Open(ctx, key); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Open after Delete error = %v, want ErrNotFound", err)
+	}
+	// Deleting what is not there is not an error.
+	if err := store.Delete(ctx, key); err != nil {
+		t.Fatalf("Delete of a missing object: %v", err)
+	}
+}
+
+func TestS3EmptyObject(t *testing.T) {
+	_, server := newFakeS3(t)
+	store := newTestS3(t, server.URL, testSecretKey)
+	ctx := context.Background()
+
+	key := Key(nil)
+	if err := store.Put(ctx, key, nil, ""); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+	rc, err := store.Open(ctx, key)
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	rc.Close()
+}
+
+func TestS3RejectedSignature(t *testing.T) {
+	_, server := newFakeS3(t)
+	store := newTestS3(t, server.URL, "wrong-secret")
+	ctx := context.Background()
+
+	data := []byte("content")
+	err := store.Put(ctx, Key(data), data, "text/plain")
+	if err == nil || !strings.Contains(err.Error(), "403") {
+		t.Fatalf("Put with the wrong secret error = %v, want a 403", err)
+	}
+	if _, err := store.Open(ctx, Key(data)); err == nil || errors.Is(err, ErrNotFound) {
+		t.Fatalf("Open with the wrong secret error = %v, want a 403", err)
+	}
+}
+
+func TestS3InvalidKey(t *testing.T) {
+	fake, server := newFakeS3(t)
+	store := newTestS3(t, server.URL, testSecretKey)
+	ctx := context.Background()
+
+	for _, key := range []string{"", "../../etc/passwd", strings.Repeat("A", 64), strings.Repeat("a", 63)} {
+		if err := store.Put(ctx, key, []byte("x"), ""); !errors.Is(err, ErrInvalidKey) {
+			t.Errorf("Put(%q) error = %v, want ErrInvalidKey", key, err)
+		}
+		if _, err := store.Open(ctx, key); !errors.Is(err, ErrInvalidKey) {
+			t.Errorf("Open(%q) error = %v, want ErrInvalidKey", key, err)
+		}
+		if err := store.Delete(ctx, key); !errors.Is(err, ErrInvalidKey) {
+			t.Errorf("Delete(%q) error = %v, want ErrInvalidKey", key, err)
+		}
+	}
+	if fake.requests != 0 {
+		t.Fatalf("%d requests reached the server for invalid keys", fake.requests)
+	}
+}
+
+func TestS3ObjectURL(t *testing.T) {
+	key := Key([]byte("content"))
+	object := key[0:2] + "/" + key[2:4] + "/" + key
+
+	tests := []struct {
+		endpoint      string
+		virtualHosted bool
+		want          string
+	}{
+		{"http://localhost:9000", false, "http://localhost:9000/" + testBucket + "/" + object},
+		{"http://localhost:9000/", false, "http://localhost:9000/" + testBucket + "/" + object},
+		{"https://minio.example.com/storage", false, "https://minio.example.com/storage/" + testBucket + "/" + object},
+		{"https://s3.eu-west-1.amazonaws.com", true, "https://" + testBucket + ".s3.eu-west-1.amazonaws.com/" + object},
+	}
+
+	for _, tt := range tests {
+		store, err := NewS3(S3Config{
+			Endpoint:      tt.endpoint,
+			Bucket:        testBucket,
+			AccessKey:     testAccessKey,
+			SecretKey:     testSecretKey,
+			VirtualHosted: tt.virtualHosted,
+		})
+		if err != nil {
+			t.Fatalf("NewS3(%q): %v", tt.endpoint, err)
+		}
+		if got := store.objectURL(key).String(); got != tt.want {
+			t.Errorf("objectURL with %q (virtual hosted %t) = %s, want %s", tt.endpoint, tt.virtualHosted, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+// Package assets stores files by content hash and tracks who owns them.
+// Handlers refer to assets by opaque ID and never build file paths from
+// request data.
+package assets
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+)
+
+var (
+	ErrNotFound   = errors.New("asset not found")
+	ErrInvalidKey = errors.New("invalid asset key")
+)
+
+var keyPattern = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+// AssetStore keeps blobs under their sha256 hex key.
+type AssetStore interface {
+	Name() string
+	// Put stores data under key. Storing an existing key is a no-op.
+	Put(ctx context.Context, key string, data []byte, contentType string) error
+	// Open returns the content stored under key, or ErrNotFound.
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// Key returns the content key of data.
+func Key(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func checkKey(key string) error {
+	if !keyPattern.MatchString(key) {
+		return ErrInvalidKey
+	}
+	return nil
+}
+
+// FromEnv builds the store named by ASSET_STORE: "local" (the default) or
+// "s3".
+func FromEnv() (AssetStore, error) {
+	switch store := os.Getenv("ASSET_STORE"); store {
+	case "", "local":
+		dir := os.Getenv("ASSET_DIR")
+		if dir == "" {
+			dir = defaultLocalDir
+		}
+		return NewLocal(dir)
+	case "s3":
+		return NewS3(S3Config{
+			Endpoint:  os.Getenv("S3_ENDPOINT"),
+			Region:    os.Getenv("S3_REGION"),
+			Bucket:    os.Getenv("S3_BUCKET"),
+			AccessKey: os.Getenv("S3_ACCESS_KEY"),
+			SecretKey: os.Getenv("S3_SECRET_KEY"),
+			// Path-style addressing is what MinIO and most S3-compatible
+			// servers expect; set S3_VIRTUAL_HOSTED=true for AWS-style
+			// bucket subdomains.
+			VirtualHosted: os.Getenv("S3_VIRTUAL_HOSTED") == "true",
+		})
+	default:
+		return nil, fmt.Errorf("unknown ASSET_STORE %q", store)
+	}
+}
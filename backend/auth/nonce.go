@@ -0,0 +1,109 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"arjunmal1311/fans_flow_on_chain/backend/db"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const nonceTTL = 10 * time.Minute
+
+// NonceStore keeps issued nonces until they are used or expire.
+type NonceStore interface {
+	Add(ctx context.Context, nonce string, now, expiresAt time.Time) error
+	// Take removes nonce and reports whether it was issued and had not
+	// expired by now.
+	Take(ctx context.Context, nonce string, now time.Time) (bool, error)
+}
+
+var nonceStore NonceStore = mongoNonces{}
+
+// UseNonceStore replaces the MongoDB nonce store, e.g. with
+// NewMemoryNonces in tests.
+func UseNonceStore(store NonceStore) {
+	nonceStore = store
+}
+
+// IssueNonce creates a single-use nonce for a SIWE message.
+func IssueNonce(ctx context.Context) (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	nonce := hex.EncodeToString(b)
+
+	now := time.Now()
+	if err := nonceStore.Add(ctx, nonce, now, now.Add(nonceTTL)); err != nil {
+		return "", err
+	}
+	return nonce, nil
+}
+
+// ConsumeNonce deletes nonce and reports whether it was issued and not yet
+// used or expired.
+func ConsumeNonce(ctx context.Context, nonce string) (bool, error) {
+	return nonceStore.Take(ctx, nonce, time.Now())
+}
+
+// mongoNonces keeps nonces in "auth_nonces"; a TTL index on expires_at
+// removes the expired ones.
+type mongoNonces struct{}
+
+func (mongoNonces) collection() *mongo.Collection {
+	return db.GetCollection("auth_nonces")
+}
+
+func (s mongoNonces) Add(ctx context.Context, nonce string, now, expiresAt time.Time) error {
+	_, err := s.collection().InsertOne(ctx, bson.M{
+		"_id":        nonce,
+		"created_at": now,
+		"expires_at": expiresAt,
+	})
+	return err
+}
+
+func (s mongoNonces) Take(ctx context.Context, nonce string, now time.Time) (bool, error) {
+	err := s.collection().FindOneAndDelete(ctx, bson.M{
+		"_id":        nonce,
+		"expires_at": bson.M{"$gt": now},
+	}).Err()
+	if err == mongo.ErrNoDocuments {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// NewMemoryNonces returns a NonceStore held in memory.
+func NewMemoryNonces() NonceStore {
+	return &memoryNonces{expiry: map[string]time.Time{}}
+}
+
+type memoryNonces struct {
+	mu     sync.Mutex
+	expiry map[string]time.Time
+}
+
+func (s *memoryNonces) Add(ctx context.Context, nonce string, now, expiresAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.expiry[nonce] = expiresAt
+	return nil
+}
+
+func (s *memoryNonces) Take(ctx context.Context, nonce string, now time.Time) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	expiresAt, ok := s.expiry[nonce]
+	delete(s.expiry, nonce)
+	return ok && now.Before(expiresAt), nil
+}
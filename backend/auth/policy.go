@@ -0,0 +1,143 @@
+package auth
+
+import (
+	"strings"
+
+	"arjunmal1311/fans_flow_on_chain/backend/models"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Policy error codes. Handlers answer them with 403.
+const (
+	CodeWalletMismatch        = "WALLET_MISMATCH"
+	CodeNotSubscriptionHolder = "NOT_SUBSCRIPTION_HOLDER"
+	CodeNotModelOwner         = "NOT_MODEL_OWNER"
+	CodeTransferForbidden     = "SUBSCRIPTION_TRANSFER_FORBIDDEN"
+	CodeAdminRequired         = "ADMIN_REQUIRED"
+	CodeNotAssetOwner         = "NOT_ASSET_OWNER"
+	CodeNotPinOwner           = "NOT_PIN_OWNER"
+	CodeSubscriptionRequired  = "SUBSCRIPTION_REQUIRED"
+)
+
+func isWallet(session *Claims, wallet string) bool {
+	return session != nil && wallet != "" && strings.EqualFold(session.WalletAddress, wallet)
+}
+
+// CanActAs allows a request made on behalf of wallet, e.g. registering it or
+// recording its purchase, only by that wallet.
+func CanActAs(session *Claims, wallet string) error {
+	if !isWallet(session, wallet) {
+		return &Error{Code: CodeWalletMismatch, Message: "Wallet address does not match the signed-in wallet"}
+	}
+	return nil
+}
+
+// CanManageSubscription allows listing, delisting and repricing a
+// subscription only by its holder.
+func CanManageSubscription(session *Claims, holder models.User) error {
+	if !isWallet(session, holder.WalletAddress) {
+		return &Error{Code: CodeNotSubscriptionHolder, Message: "Only the subscription holder can change it"}
+	}
+	return nil
+}
+
+// CanManageModel allows changes to a model, such as adding subscription
+// options, only by the model's registered wallet.
+func CanManageModel(session *Claims, model models.Model) error {
+	if !isWallet(session, model.WalletAddress) {
+		return &Error{Code: CodeNotModelOwner, Message: "Only the model's wallet can manage it"}
+	}
+	return nil
+}
+
+// CanTransferSubscription allows moving a subscription from holder to
+// recipient only when the signed-in wallet is the recipient. Whether the
+// transfer happened on chain is checked separately against the sale
+// transaction.
+func CanTransferSubscription(session *Claims, holder, recipient models.User) error {
+	if !isWallet(session, recipient.WalletAddress) {
+		return &Error{Code: CodeTransferForbidden, Message: "Subscriptions can only be transferred to the signed-in wallet"}
+	}
+	if holder.ID == recipient.ID {
+		return CanManageSubscription(session, holder)
+	}
+	return nil
+}
+
+// CanManageAsset allows reading unpublished assets, publishing and deleting
+// them only by the wallet that created them.
+func CanManageAsset(session *Claims, asset models.Asset) error {
+	if !isWallet(session, asset.OwnerWallet) {
+		return &Error{Code: CodeNotAssetOwner, Message: "Only the asset's owner can use it"}
+	}
+	return nil
+}
+
+// CanViewMedia allows the original of an asset to its owner, to anyone when
+// it is public and, for subscriber assets, to the model's wallet and active
+// subscribers of the model, who must hold one of the asset's options when it
+// names any. held is as for CanViewPost.
+func CanViewMedia(session *Claims, asset models.Asset, model *models.Model, held []*primitive.ObjectID) error {
+	switch {
+	case isWallet(session, asset.OwnerWallet):
+		return nil
+	case asset.Access == models.AssetPublic:
+		return nil
+	case asset.Access == models.AssetSubscribers:
+		if holdsOption(held, asset.OptionIDs) || (model != nil && isWallet(session, model.WalletAddress)) {
+			return nil
+		}
+		return &Error{Code: CodeSubscriptionRequired, Message: "Subscribe to the model to see the original"}
+	}
+	return &Error{Code: CodeNotAssetOwner, Message: "Only the asset's owner can use it"}
+}
+
+// CanViewPost allows the content of a post to the model's wallet, to anyone
+// when it is public and otherwise to active subscribers of the model, who
+// must hold one of the post's options when it names any. held has the
+// option of each of the caller's active subscriptions to the model, nil for
+// subscriptions recorded without one.
+func CanViewPost(session *Claims, model models.Model, post models.Post, held []*primitive.ObjectID) error {
+	if isWallet(session, model.WalletAddress) || post.Visibility == models.PostPublic {
+		return nil
+	}
+	if holdsOption(held, post.OptionIDs) {
+		return nil
+	}
+	return &Error{Code: CodeSubscriptionRequired, Message: "Subscribe to the model to see this post"}
+}
+
+// holdsOption reports whether the held subscriptions include one of the
+// allowed options, or any subscription at all when allowed is empty.
+func holdsOption(held []*primitive.ObjectID, allowed []primitive.ObjectID) bool {
+	if len(held) > 0 && len(allowed) == 0 {
+		return true
+	}
+	for _, option := range held {
+		for _, id := range allowed {
+			if option != nil && *option == id {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// CanManagePin allows unpinning content only by the wallet that pinned it
+// or an administrator.
+func CanManagePin(session *Claims, pin models.Pin) error {
+	if !isWallet(session, pin.OwnerWallet) && CanAdminister(session) != nil {
+		return &Error{Code: CodeNotPinOwner, Message: "Only the wallet that pinned this content can unpin it"}
+	}
+	return nil
+}
+
+// CanAdminister allows operational endpoints only to the wallets listed in
+// ADMIN_WALLETS.
+func CanAdminister(session *Claims) error {
+	if session == nil || !adminWallets[strings.ToLower(session.WalletAddress)] {
+		return &Error{Code: CodeAdminRequired, Message: "Only administrators can do this"}
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+package auth
+
+import (
+	"errors"
+	"testing"
+
+	"arjunmal1311/fans_flow_on_chain/backend/models"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	aliceWallet = "0x00000000000000000000000000000000000a11ce"
+	bobWallet   = "0x0000000000000000000000000000000000000b0b"
+	// aliceUpper is aliceWallet as a checksummed address would spell it.
+	aliceUpper = "0x00000000000000000000000000000000000A11CE"
+)
+
+var (
+	alice = &Claims{WalletAddress: aliceWallet, ChainID: 31337}
+	bob   = &Claims{WalletAddress: bobWallet, ChainID: 31337}
+)
+
+type policyTest struct {
+	name string
+	err  error
+	// code is the Error code expected, empty when the action is allowed.
+	code string
+}
+
+func checkPolicy(t *testing.T, tests []policyTest) {
+	t.Helper()
+	for _, tt := range tests {
+		var aerr *Error
+		switch {
+		case tt.code == "" && tt.err != nil:
+			t.Errorf("%s: %v, want allowed", tt.name, tt.err)
+		case tt.code != "" && (!errors.As(tt.err, &aerr) || aerr.Code != tt.code):
+			t.Errorf("%s: error %v, want %s", tt.name, tt.err, tt.code)
+		}
+	}
+}
+
+func TestCanActAs(t *testing.T) {
+	checkPolicy(t, []policyTest{
+		{"own wallet", CanActAs(alice, aliceWallet), ""},
+		{"own wallet in another case", CanActAs(alice, aliceUpper), ""},
+		{"another wallet", CanActAs(alice, bobWallet), CodeWalletMismatch},
+		{"empty wallet", CanActAs(alice, ""), CodeWalletMismatch},
+		{"no session", CanActAs(nil, aliceWallet), CodeWalletMismatch},
+	})
+}
+
+func TestCanManageSubscription(t *testing.T) {
+	holder := models.User{ID: primitive.NewObjectID(), WalletAddress: aliceUpper}
+	checkPolicy(t, []policyTest{
+		{"holder", CanManageSubscription(alice, holder), ""},
+		{"someone else", CanManageSubscription(bob, holder), CodeNotSubscriptionHolder},
+		{"holder without a wallet", CanManageSubscription(alice, models.User{ID: holder.ID}), CodeNotSubscriptionHolder},
+		{"no session", CanManageSubscription(nil, holder), CodeNotSubscriptionHolder},
+	})
+}
+
+func TestCanManageModel(t *testing.T) {
+	model := models.Model{ID: primitive.NewObjectID(), WalletAddress: aliceUpper}
+	checkPolicy(t, []policyTest{
+		{"model wallet", CanManageModel(alice, model), ""},
+		{"another wallet", CanManageModel(bob, model), CodeNotModelOwner},
+		{"no session", CanManageModel(nil, model), CodeNotModelOwner},
+	})
+}
+
+func TestCanTransferSubscription(t *testing.T) {
+	holder := models.User{ID: primitive.NewObjectID(), WalletAddress: aliceWallet}
+	recipient := models.User{ID: primitive.NewObjectID(), WalletAddress: bobWallet}
+	checkPolicy(t, []policyTest{
+		{"recipient", CanTransferSubscription(bob, holder, recipient), ""},
+		{"recipient in another case", CanTransferSubscription(&Claims{WalletAddress: "0x0000000000000000000000000000000000000B0B"}, holder, recipient), ""},
+		{"holder pushing to someone else", CanTransferSubscription(alice, holder, recipient), CodeTransferForbidden},
+		{"no session", CanTransferSubscription(nil, holder, recipient), CodeTransferForbidden},
+		// A "transfer" to the holder is an update by the holder.
+		{"holder to themselves", CanTransferSubscription(alice, holder, holder), ""},
+	})
+}
+
+func TestCanManageAsset(t *testing.T) {
+	asset := models.Asset{OwnerWallet: aliceWallet, Access: models.AssetPublic}
+	checkPolicy(t, []policyTest{
+		{"owner", CanManageAsset(&Claims{WalletAddress: aliceUpper}, asset), ""},
+		// Public assets can be viewed by anyone but managed only by their owner.
+		{"someone else", CanManageAsset(bob, asset), CodeNotAssetOwner},
+		{"no session", CanManageAsset(nil, asset), CodeNotAssetOwner},
+	})
+}
+
+func TestCanViewMedia(t *testing.T) {
+	gold, silver := primitive.NewObjectID(), primitive.NewObjectID()
+	model := &models.Model{ID: primitive.NewObjectID(), WalletAddress: bobWallet}
+	carol := &Claims{WalletAddress: "0x00000000000000000000000000000000000ca201"}
+
+	private := models.Asset{OwnerWallet: aliceWallet}
+	public := models.Asset{OwnerWallet: aliceWallet, Access: models.AssetPublic}
+	subscribers := models.Asset{OwnerWallet: aliceWallet, Access: models.AssetSubscribers, ModelID: &model.ID}
+	goldOnly := subscribers
+	goldOnly.OptionIDs = []primitive.ObjectID{gold}
+
+	checkPolicy(t, []policyTest{
+		{"owner of a private asset", CanViewMedia(&Claims{WalletAddress: aliceUpper}, private, nil, nil), ""},
+		{"someone else's private asset", CanViewMedia(carol, private, nil, []*primitive.ObjectID{nil}), CodeNotAssetOwner},
+		{"private asset without a session", CanViewMedia(nil, private, nil, nil), CodeNotAssetOwner},
+		{"public asset without a session", CanViewMedia(nil, public, nil, nil), ""},
+		{"subscriber", CanViewMedia(carol, subscribers, model, []*primitive.ObjectID{nil}), ""},
+		{"not a subscriber", CanViewMedia(carol, subscribers, model, nil), CodeSubscriptionRequired},
+		{"subscribers asset without a session", CanViewMedia(nil, subscribers, model, nil), CodeSubscriptionRequired},
+		{"model wallet", CanViewMedia(&Claims{WalletAddress: "0x0000000000000000000000000000000000000B0B"}, subscribers, model, nil), ""},
+		{"holder of the option", CanViewMedia(carol, goldOnly, model, []*primitive.ObjectID{&silver, &gold}), ""},
+		{"holder of another option", CanViewMedia(carol, goldOnly, model, []*primitive.ObjectID{&silver}), CodeSubscriptionRequired},
+		{"holder without an option", CanViewMedia(carol, goldOnly, model, []*primitive.ObjectID{nil}), CodeSubscriptionRequired},
+	})
+}
+
+func TestCanViewPost(t *testing.T) {
+	gold, silver := primitive.NewObjectID(), primitive.NewObjectID()
+	model := models.Model{ID: primitive.NewObjectID(), WalletAddress: aliceWallet}
+
+	public := models.Post{ModelID: model.ID, Visibility: models.PostPublic}
+	subscribers := models.Post{ModelID: model.ID, Visibility: models.PostSubscribers}
+	goldOnly := subscribers
+	goldOnly.OptionIDs = []primitive.ObjectID{gold}
+
+	checkPolicy(t, []policyTest{
+		{"public post without a session", CanViewPost(nil, model, public, nil), ""},
+		{"model wallet", CanViewPost(&Claims{WalletAddress: aliceUpper}, model, goldOnly, nil), ""},
+		{"subscriber", CanViewPost(bob, model, subscribers, []*primitive.ObjectID{nil}), ""},
+		{"not a subscriber", CanViewPost(bob, model, subscribers, nil), CodeSubscriptionRequired},
+		{"no session", CanViewPost(nil, model, subscribers, nil), CodeSubscriptionRequired},
+		{"holder of the option", CanViewPost(bob, model, goldOnly, []*primitive.ObjectID{&gold}), ""},
+		{"holder of another option", CanViewPost(bob, model, goldOnly, []*primitive.ObjectID{&silver, nil}), CodeSubscriptionRequired},
+	})
+}
+
+func TestCanManagePin(t *testing.T) {
+	withAdmins(t, bobWallet)
+	pin := models.Pin{OwnerWallet: aliceWallet}
+	checkPolicy(t, []policyTest{
+		{"owner", CanManagePin(&Claims{WalletAddress: aliceUpper}, pin), ""},
+		{"administrator", CanManagePin(bob, pin), ""},
+		{"someone else", CanManagePin(&Claims{WalletAddress: "0x00000000000000000000000000000000000ca201"}, pin), CodeNotPinOwner},
+		{"no session", CanManagePin(nil, pin), CodeNotPinOwner},
+	})
+}
+
+func TestCanAdminister(t *testing.T) {
+	withAdmins(t, " 0x00000000000000000000000000000000000000aa, 0x0000000000000000000000000000000000000B0B ")
+	checkPolicy(t, []policyTest{
+		{"administrator", CanAdminister(bob), ""},
+		{"administrator in another case", CanAdminister(&Claims{WalletAddress: "0x0000000000000000000000000000000000000B0B"}), ""},
+		{"someone else", CanAdminister(alice), CodeAdminRequired},
+		{"no session", CanAdminister(nil), CodeAdminRequired},
+	})
+}
+
+// withAdmins makes wallets the administrators for the rest of the test,
+// read from ADMIN_WALLETS by Init.
+func withAdmins(t *testing.T, wallets string) {
+	previous, previousSecret := adminWallets, sessionSecret
+	adminWallets = map[string]bool{}
+	t.Cleanup(func() { adminWallets, sessionSecret = previous, previousSecret })
+
+	t.Setenv("ADMIN_WALLETS", wallets)
+	t.Setenv("SESSION_SECRET", "policy-test-secret")
+	if err := Init(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,130 @@
+package auth
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"log"
+	"os"
+	"strings"
+	"time"
+)
+
+const defaultSessionTTL = 24 * time.Hour
+
+var (
+	ErrInvalidToken = errors.New("invalid session token")
+	ErrTokenExpired = errors.New("session token has expired")
+)
+
+var (
+	sessionSecret []byte
+	sessionTTL    = defaultSessionTTL
+	adminWallets  = map[string]bool{}
+)
+
+// tokenHeader is the fixed JWT header of every session token (HS256).
+var tokenHeader = base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+
+// Claims are the contents of a session token.
+type Claims struct {
+	WalletAddress string `json:"sub"`
+	ChainID       uint64 `json:"chain_id"`
+	IssuedAt      int64  `json:"iat"`
+	ExpiresAt     int64  `json:"exp"`
+}
+
+// Init reads SESSION_SECRET, SESSION_TTL and ADMIN_WALLETS. Without a
+// secret a random one is generated, so sessions do not survive a restart.
+func Init() error {
+	for _, wallet := range strings.Split(os.Getenv("ADMIN_WALLETS"), ",") {
+		if wallet = strings.TrimSpace(wallet); wallet != "" {
+			adminWallets[strings.ToLower(wallet)] = true
+		}
+	}
+
+	if ttl := os.Getenv("SESSION_TTL"); ttl != "" {
+		d, err := time.ParseDuration(ttl)
+		if err != nil {
+			return errors.New("invalid SESSION_TTL: " + err.Error())
+		}
+		sessionTTL = d
+	}
+
+	if secret := os.Getenv("SESSION_SECRET"); secret != "" {
+		sessionSecret = []byte(secret)
+		return nil
+	}
+
+	log.Printf("Warning: SESSION_SECRET is not set, sessions will not survive a restart")
+	sessionSecret = make([]byte, 32)
+	_, err := rand.Read(sessionSecret)
+	return err
+}
+
+// IssueToken signs a session token for walletAddress.
+func IssueToken(walletAddress string, chainID uint64) (string, *Claims, error) {
+	now := time.Now()
+	claims := &Claims{
+		WalletAddress: strings.ToLower(walletAddress),
+		ChainID:       chainID,
+		IssuedAt:      now.Unix(),
+		ExpiresAt:     now.Add(sessionTTL).Unix(),
+	}
+
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", nil, err
+	}
+
+	unsigned := tokenHeader + "." + base64.RawURLEncoding.EncodeToString(payload)
+	return unsigned + "." + sign(unsigned), claims, nil
+}
+
+// ParseToken verifies a session token and returns its claims.
+func ParseToken(token string) (*Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 || parts[0] != tokenHeader {
+		return nil, ErrInvalidToken
+	}
+	if !hmac.Equal([]byte(sign(parts[0]+"."+parts[1])), []byte(parts[2])) {
+		return nil, ErrInvalidToken
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+	var claims Claims
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.WalletAddress == "" {
+		return nil, ErrInvalidToken
+	}
+	if time.Now().Unix() >= claims.ExpiresAt {
+		return nil, ErrTokenExpired
+	}
+
+	return &claims, nil
+}
+
+func sign(unsigned string) string {
+	mac := hmac.New(sha256.New, sessionSecret)
+	mac.Write([]byte(unsigned))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+type contextKey struct{}
+
+// WithSession attaches the authenticated session to ctx.
+func WithSession(ctx context.Context, claims *Claims) context.Context {
+	return context.WithValue(ctx, contextKey{}, claims)
+}
+
+// SessionFromContext returns the session attached by WithSession.
+func SessionFromContext(ctx context.Context) (*Claims, bool) {
+	claims, ok := ctx.Value(contextKey{}).(*Claims)
+	return claims, ok
+}
@@ -0,0 +1,95 @@
+package auth
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+)
+
+// withSecret signs sessions with secret for the rest of the test.
+func withSecret(t *testing.T, secret string) {
+	previous, previousTTL := sessionSecret, sessionTTL
+	sessionSecret = []byte(secret)
+	t.Cleanup(func() { sessionSecret, sessionTTL = previous, previousTTL })
+}
+
+func TestParseToken(t *testing.T) {
+	withSecret(t, "session-test-secret")
+
+	token, claims, err := IssueToken("0x2c7536E3605D9C16a7a3D7b1898e529396a65c23", 31337)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := ParseToken(token)
+	if err != nil {
+		t.Fatalf("ParseToken: %v", err)
+	}
+	if got.WalletAddress != testAddress || got.ChainID != 31337 || *got != *claims {
+		t.Fatalf("ParseToken = %+v, want %+v", got, claims)
+	}
+
+	parts := strings.Split(token, ".")
+	forged := base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"0x0000000000000000000000000000000000000bad","chain_id":31337,"iat":0,"exp":99999999999}`))
+	otherHeader := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none","typ":"JWT"}`))
+
+	for name, tampered := range map[string]string{
+		"empty":             "",
+		"two parts":         parts[0] + "." + parts[1],
+		"other header":      otherHeader + "." + parts[1] + "." + parts[2],
+		"forged claims":     parts[0] + "." + forged + "." + parts[2],
+		"altered signature": parts[0] + "." + parts[1] + "." + strings.ToUpper(parts[2]),
+		"missing signature": parts[0] + "." + parts[1] + ".",
+	} {
+		if _, err := ParseToken(tampered); err != ErrInvalidToken {
+			t.Errorf("%s: ParseToken error %v, want ErrInvalidToken", name, err)
+		}
+	}
+
+	// A token signed with another key is rejected.
+	withSecret(t, "another-secret")
+	if _, err := ParseToken(token); err != ErrInvalidToken {
+		t.Errorf("token signed with another key: error %v, want ErrInvalidToken", err)
+	}
+}
+
+func TestParseTokenExpired(t *testing.T) {
+	withSecret(t, "session-test-secret")
+	sessionTTL = -time.Second
+
+	token, _, err := IssueToken(testAddress, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ParseToken(token); err != ErrTokenExpired {
+		t.Fatalf("ParseToken of an expired token: error %v, want ErrTokenExpired", err)
+	}
+}
+
+func TestMemoryNonces(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryNonces()
+	now := time.Now()
+
+	if err := store.Add(ctx, "fresh", now, now.Add(time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Add(ctx, "stale", now.Add(-time.Hour), now.Add(-time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		nonce string
+		want  bool
+	}{
+		{"fresh", true},
+		{"fresh", false}, // already used
+		{"stale", false},
+		{"unknown", false},
+	} {
+		if ok, err := store.Take(ctx, tt.nonce, now); err != nil || ok != tt.want {
+			t.Errorf("Take(%s) = %t, %v, want %t", tt.nonce, ok, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package auth
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"arjunmal1311/fans_flow_on_chain/backend/chain"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+)
+
+// RecoverAddress returns the lower-cased address whose key produced
+// signature over message with personal_sign (EIP-191).
+func RecoverAddress(message string, signature string) (string, error) {
+	sig, err := hex.DecodeString(strings.TrimPrefix(signature, "0x"))
+	if err != nil || len(sig) != 65 {
+		return "", &Error{Code: CodeInvalidSignature, Message: "Signature must be 65 hex-encoded bytes"}
+	}
+
+	// Wallets append v as 27/28, some as 0/1.
+	v := sig[64]
+	if v < 27 {
+		v += 27
+	}
+	if v != 27 && v != 28 {
+		return "", &Error{Code: CodeInvalidSignature, Message: "Signature has an invalid recovery id"}
+	}
+
+	// RecoverCompact expects the recovery byte first.
+	compact := make([]byte, 65)
+	compact[0] = v
+	copy(compact[1:], sig[:64])
+
+	prefixed := fmt.Sprintf("\x19Ethereum Signed Message:\n%d%s", len(message), message)
+	pub, _, err := ecdsa.RecoverCompact(compact, chain.Keccak256([]byte(prefixed)))
+	if err != nil {
+		return "", &Error{Code: CodeInvalidSignature, Message: "Signature could not be verified"}
+	}
+
+	hash := chain.Keccak256(pub.SerializeUncompressed()[1:])
+	return "0x" + hex.EncodeToString(hash[12:]), nil
+}
@@ -0,0 +1,52 @@
+package auth
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestRecoverAddress(t *testing.T) {
+	tests := []struct {
+		name      string
+		message   string
+		signature string
+	}{
+		// From the web3.js documentation of accounts.sign.
+		{"web3.js", "Some data", "0xb91467e570a6466aa9e9876cbcd013baba02900b8979d43fe208a4a4f339f5fd6007e74cd82e037b800186422fc2da167c747ef045e5d18a5f5d4300f8e1a0291c"},
+		{"recovery id 0/1", "Some data", "0xb91467e570a6466aa9e9876cbcd013baba02900b8979d43fe208a4a4f339f5fd6007e74cd82e037b800186422fc2da167c747ef045e5d18a5f5d4300f8e1a02901"},
+		{"without 0x", "Some data", "b91467e570a6466aa9e9876cbcd013baba02900b8979d43fe208a4a4f339f5fd6007e74cd82e037b800186422fc2da167c747ef045e5d18a5f5d4300f8e1a0291c"},
+		{"SIWE message", siweMessage, siweSignature},
+	}
+
+	for _, tt := range tests {
+		got, err := RecoverAddress(tt.message, tt.signature)
+		if err != nil || got != testAddress {
+			t.Errorf("%s: RecoverAddress = %s, %v, want %s", tt.name, got, err, testAddress)
+		}
+	}
+
+	// A signature over another message recovers some other address.
+	got, err := RecoverAddress(strings.Replace(siweMessage, "Chain ID: 1", "Chain ID: 2", 1), siweSignature)
+	if err == nil && got == testAddress {
+		t.Errorf("RecoverAddress of a tampered message = %s", got)
+	}
+}
+
+func TestRecoverAddressRejects(t *testing.T) {
+	valid := strings.TrimPrefix(siweSignature, "0x")
+	for name, signature := range map[string]string{
+		"empty":          "",
+		"not hex":        "0x" + strings.Repeat("zz", 65),
+		"64 bytes":       "0x" + valid[:128],
+		"66 bytes":       "0x" + valid + "00",
+		"recovery id 29": "0x" + valid[:128] + "1d",
+		"zero r and s":   "0x" + strings.Repeat("00", 64) + "1b",
+	} {
+		_, err := RecoverAddress(siweMessage, signature)
+		var aerr *Error
+		if !errors.As(err, &aerr) || aerr.Code != CodeInvalidSignature {
+			t.Errorf("%s: error %v, want %s", name, err, CodeInvalidSignature)
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package auth
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	CodeInvalidMessage   = "INVALID_SIWE_MESSAGE"
+	CodeInvalidSignature = "INVALID_SIGNATURE"
+	CodeDomainMismatch   = "SIWE_DOMAIN_MISMATCH"
+	CodeMessageExpired   = "SIWE_MESSAGE_EXPIRED"
+	CodeInvalidNonce     = "INVALID_NONCE"
+)
+
+const siweHeaderSuffix = " wants you to sign in with your Ethereum account:"
+
+var (
+	addressPattern = regexp.MustCompile(`^0x[0-9a-fA-F]{40}$`)
+	noncePattern   = regexp.MustCompile(`^[A-Za-z0-9]{8,}$`)
+)
+
+// Error explains why a sign-in attempt was rejected. Code is stable and
+// meant to be returned to clients.
+type Error struct {
+	Code    string
+	Message string
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func invalidMessage(format string, args ...interface{}) error {
+	return &Error{Code: CodeInvalidMessage, Message: fmt.Sprintf(format, args...)}
+}
+
+// Message is a parsed EIP-4361 (Sign-In With Ethereum) message.
+type Message struct {
+	Domain         string
+	Address        string
+	Statement      string
+	URI            string
+	Version        string
+	ChainID        uint64
+	Nonce          string
+	IssuedAt       time.Time
+	ExpirationTime *time.Time
+	NotBefore      *time.Time
+	RequestID      string
+	Resources      []string
+}
+
+// ParseMessage parses the plain-text message the wallet signed. Only the
+// fields are checked here; Validate checks them against the clock.
+func ParseMessage(raw string) (*Message, error) {
+	lines := strings.Split(strings.ReplaceAll(raw, "\r\n", "\n"), "\n")
+	if len(lines) < 2 || !strings.HasSuffix(lines[0], siweHeaderSuffix) {
+		return nil, invalidMessage("Message is not a Sign-In With Ethereum message")
+	}
+
+	m := &Message{
+		Domain:  strings.TrimSuffix(lines[0], siweHeaderSuffix),
+		Address: lines[1],
+	}
+	if m.Domain == "" {
+		return nil, invalidMessage("Message has no domain")
+	}
+	if !addressPattern.MatchString(m.Address) {
+		return nil, invalidMessage("Message has an invalid address")
+	}
+
+	// An empty line follows the address, then an optional statement that is
+	// itself followed by an empty line.
+	i := 2
+	if i < len(lines) && lines[i] == "" {
+		i++
+	}
+	if i < len(lines) && !strings.HasPrefix(lines[i], "URI: ") {
+		m.Statement = lines[i]
+		i++
+		if i < len(lines) && lines[i] == "" {
+			i++
+		}
+	}
+
+	for ; i < len(lines); i++ {
+		line := lines[i]
+		if line == "" {
+			continue
+		}
+		if line == "Resources:" {
+			for i++; i < len(lines) && strings.HasPrefix(lines[i], "- "); i++ {
+				m.Resources = append(m.Resources, strings.TrimPrefix(lines[i], "- "))
+			}
+			i--
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, ": ")
+		if !ok {
+			return nil, invalidMessage("Unexpected line %q", line)
+		}
+
+		var err error
+		switch key {
+		case "URI":
+			m.URI = value
+		case "Version":
+			m.Version = value
+		case "Chain ID":
+			m.ChainID, err = strconv.ParseUint(value, 10, 64)
+		case "Nonce":
+			m.Nonce = value
+		case "Issued At":
+			m.IssuedAt, err = time.Parse(time.RFC3339, value)
+		case "Expiration Time":
+			var t time.Time
+			t, err = time.Parse(time.RFC3339, value)
+			m.ExpirationTime = &t
+		case "Not Before":
+			var t time.Time
+			t, err = time.Parse(time.RFC3339, value)
+			m.NotBefore = &t
+		case "Request ID":
+			m.RequestID = value
+		default:
+			return nil, invalidMessage("Unknown field %q", key)
+		}
+		if err != nil {
+			return nil, invalidMessage("Invalid %s: %v", key, err)
+		}
+	}
+
+	switch {
+	case m.URI == "":
+		return nil, invalidMessage("Message has no URI")
+	case m.Version != "1":
+		return nil, invalidMessage("Unsupported message version %q", m.Version)
+	case m.ChainID == 0:
+		return nil, invalidMessage("Message has no chain ID")
+	case !noncePattern.MatchString(m.Nonce):
+		return nil, invalidMessage("Message has an invalid nonce")
+	case m.IssuedAt.IsZero():
+		return nil, invalidMessage("Message has no issued-at time")
+	}
+
+	return m, nil
+}
+
+// Validate checks the message was meant for domain and is valid at now.
+func (m *Message) Validate(domain string, now time.Time) error {
+	if !strings.EqualFold(m.Domain, domain) {
+		return &Error{Code: CodeDomainMismatch, Message: "Message was issued for " + m.Domain}
+	}
+	if m.ExpirationTime != nil && !now.Before(*m.ExpirationTime) {
+		return &Error{Code: CodeMessageExpired, Message: "Message has expired"}
+	}
+	if m.NotBefore != nil && now.Before(*m.NotBefore) {
+		return &Error{Code: CodeMessageExpired, Message: "Message is not valid yet"}
+	}
+	return nil
+}
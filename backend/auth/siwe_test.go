@@ -0,0 +1,141 @@
+package auth
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+// siweMessage is an EIP-4361 message signed by testAddress; siweSignature
+// is its personal_sign signature with testKey.
+const (
+	testKey     = "4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318"
+	testAddress = "0x2c7536e3605d9c16a7a3d7b1898e529396a65c23"
+
+	siweMessage = "example.com wants you to sign in with your Ethereum account:\n" +
+		"0x2c7536E3605D9C16a7a3D7b1898e529396a65c23\n" +
+		"\n" +
+		"Sign in to Fans Flow.\n" +
+		"\n" +
+		"URI: https://example.com/login\n" +
+		"Version: 1\n" +
+		"Chain ID: 1\n" +
+		"Nonce: 32891756aa\n" +
+		"Issued At: 2021-09-30T16:25:24Z\n" +
+		"Expiration Time: 2021-10-01T16:25:24Z"
+	siweSignature = "0xc6d1a674f18f2ff1093d46b3b58c39101931f26b8d020678de908886f25e50275f86a0c268a18380df85abb2be9d39b3c11450138f50648309a794535b9457281c"
+)
+
+func TestParseMessage(t *testing.T) {
+	full := "example.com wants you to sign in with your Ethereum account:\r\n" +
+		"0x2c7536E3605D9C16a7a3D7b1898e529396a65c23\r\n" +
+		"\r\n" +
+		"I accept the Terms of Service: https://example.com/tos\r\n" +
+		"\r\n" +
+		"URI: https://example.com/login\r\n" +
+		"Version: 1\r\n" +
+		"Chain ID: 31337\r\n" +
+		"Nonce: abcdef0123456789\r\n" +
+		"Issued At: 2021-09-30T16:25:24Z\r\n" +
+		"Expiration Time: 2021-10-01T16:25:24.000Z\r\n" +
+		"Not Before: 2021-09-30T16:00:00Z\r\n" +
+		"Request ID: 42\r\n" +
+		"Resources:\r\n" +
+		"- ipfs://bafybeiemxf5abjwjbikoz4mc3a3dla6ual3jsgpdr4cjr3oz3evfyavhwq/\r\n" +
+		"- https://example.com/my-web2-claim.json"
+
+	m, err := ParseMessage(full)
+	if err != nil {
+		t.Fatalf("ParseMessage: %v", err)
+	}
+	switch {
+	case m.Domain != "example.com", m.Address != "0x2c7536E3605D9C16a7a3D7b1898e529396a65c23":
+		t.Errorf("domain %q, address %q", m.Domain, m.Address)
+	case m.Statement != "I accept the Terms of Service: https://example.com/tos":
+		t.Errorf("statement %q", m.Statement)
+	case m.URI != "https://example.com/login", m.Version != "1", m.ChainID != 31337, m.Nonce != "abcdef0123456789":
+		t.Errorf("URI %q, version %q, chain ID %d, nonce %q", m.URI, m.Version, m.ChainID, m.Nonce)
+	case !m.IssuedAt.Equal(time.Date(2021, 9, 30, 16, 25, 24, 0, time.UTC)):
+		t.Errorf("issued at %s", m.IssuedAt)
+	case m.ExpirationTime == nil || !m.ExpirationTime.Equal(time.Date(2021, 10, 1, 16, 25, 24, 0, time.UTC)):
+		t.Errorf("expiration time %v", m.ExpirationTime)
+	case m.NotBefore == nil || !m.NotBefore.Equal(time.Date(2021, 9, 30, 16, 0, 0, 0, time.UTC)):
+		t.Errorf("not before %v", m.NotBefore)
+	case m.RequestID != "42", len(m.Resources) != 2 || m.Resources[1] != "https://example.com/my-web2-claim.json":
+		t.Errorf("request ID %q, resources %q", m.RequestID, m.Resources)
+	}
+
+	// The statement is optional.
+	m, err = ParseMessage(strings.Replace(siweMessage, "Sign in to Fans Flow.\n\n", "", 1))
+	if err != nil || m.Statement != "" || m.URI != "https://example.com/login" {
+		t.Fatalf("ParseMessage without a statement = %+v, %v", m, err)
+	}
+}
+
+func TestParseMessageRejects(t *testing.T) {
+	tests := []struct {
+		name string
+		from string
+		to   string
+	}{
+		{"not SIWE", "wants you to sign in with your Ethereum account:", "wants you to sign in:"},
+		{"no domain", "example.com wants", " wants"},
+		{"bad address", "0x2c7536E3605D9C16a7a3D7b1898e529396a65c23", "0x2c7536E3605D9C16a7a3D7b1898e529396a65c2"},
+		{"no URI", "URI: https://example.com/login\n", ""},
+		{"version 2", "Version: 1", "Version: 2"},
+		{"no chain ID", "Chain ID: 1\n", ""},
+		{"chain ID not a number", "Chain ID: 1", "Chain ID: one"},
+		{"short nonce", "Nonce: 32891756aa", "Nonce: 1234"},
+		{"nonce with symbols", "Nonce: 32891756aa", "Nonce: 32891756aa!"},
+		{"no issued at", "Issued At: 2021-09-30T16:25:24Z\n", ""},
+		{"bad expiration time", "Expiration Time: 2021-10-01T16:25:24Z", "Expiration Time: tomorrow"},
+		{"unknown field", "Version: 1", "Version: 1\nColour: blue"},
+		{"line without a field", "Version: 1", "Version: 1\nhello"},
+	}
+
+	for _, tt := range tests {
+		raw := strings.Replace(siweMessage, tt.from, tt.to, 1)
+		if raw == siweMessage {
+			t.Fatalf("%s: %q is not in the message", tt.name, tt.from)
+		}
+		_, err := ParseMessage(raw)
+		var aerr *Error
+		if !errors.As(err, &aerr) || aerr.Code != CodeInvalidMessage {
+			t.Errorf("%s: error %v, want %s", tt.name, err, CodeInvalidMessage)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	m, err := ParseMessage(strings.Replace(siweMessage, "Version: 1", "Version: 1\nNot Before: 2021-09-30T16:00:00Z", 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	valid := time.Date(2021, 9, 30, 18, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		domain string
+		now    time.Time
+		code   string
+	}{
+		{"valid", "example.com", valid, ""},
+		{"domain compared case-insensitively", "Example.COM", valid, ""},
+		{"wrong domain", "evil.example", valid, CodeDomainMismatch},
+		{"subdomain", "login.example.com", valid, CodeDomainMismatch},
+		{"expired", "example.com", *m.ExpirationTime, CodeMessageExpired},
+		{"not valid yet", "example.com", m.NotBefore.Add(-time.Second), CodeMessageExpired},
+	}
+
+	for _, tt := range tests {
+		err := m.Validate(tt.domain, tt.now)
+		var aerr *Error
+		switch {
+		case tt.code == "" && err != nil:
+			t.Errorf("%s: %v", tt.name, err)
+		case tt.code != "" && (!errors.As(err, &aerr) || aerr.Code != tt.code):
+			t.Errorf("%s: error %v, want %s", tt.name, err, tt.code)
+		}
+	}
+}
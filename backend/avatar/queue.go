@@ -0,0 +1,261 @@
+// Package avatar generates avatar images in the background. Jobs are stored
+// in Mongo, so queued work survives a restart, and run by a fixed number of
+// workers.
+package avatar
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"arjunmal1311/fans_flow_on_chain/backend/assets"
+	"arjunmal1311/fans_flow_on_chain/backend/db"
+	"arjunmal1311/fans_flow_on_chain/backend/imagegen"
+	"arjunmal1311/fans_flow_on_chain/backend/imageproc"
+	"arjunmal1311/fans_flow_on_chain/backend/models"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	defaultWorkers    = 2
+	defaultJobTimeout = 3 * time.Minute
+	// maxAttempts stops a job that keeps dying mid-run, e.g. by crashing
+	// the server, from being retried forever.
+	maxAttempts  = 3
+	pollInterval = 5 * time.Second
+)
+
+func collection() *mongo.Collection {
+	return db.GetCollection("avatar_jobs")
+}
+
+type Queue struct {
+	generator imagegen.ImageGenerator
+	pipeline  *imageproc.Pipeline
+	workers   int
+	timeout   time.Duration
+	wake      chan struct{}
+}
+
+func NewQueue(generator imagegen.ImageGenerator, pipeline *imageproc.Pipeline, workers int, timeout time.Duration) *Queue {
+	if workers <= 0 {
+		workers = defaultWorkers
+	}
+	if timeout <= 0 {
+		timeout = defaultJobTimeout
+	}
+	return &Queue{
+		generator: generator,
+		pipeline:  pipeline,
+		workers:   workers,
+		timeout:   timeout,
+		wake:      make(chan struct{}, workers),
+	}
+}
+
+// FromEnv builds the queue with AVATAR_WORKERS workers, each job limited
+// to AVATAR_JOB_TIMEOUT (e.g. "90s"). Unset values take the defaults; set
+// but invalid ones are an error rather than silently ignored.
+func FromEnv(generator imagegen.ImageGenerator, pipeline *imageproc.Pipeline) (*Queue, error) {
+	var workers int
+	if v := os.Getenv("AVATAR_WORKERS"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n <= 0 {
+			return nil, fmt.Errorf("invalid AVATAR_WORKERS %q", v)
+		}
+		workers = n
+	}
+
+	var timeout time.Duration
+	if v := os.Getenv("AVATAR_JOB_TIMEOUT"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			return nil, fmt.Errorf("invalid AVATAR_JOB_TIMEOUT %q", v)
+		}
+		timeout = d
+	}
+
+	return NewQueue(generator, pipeline, workers, timeout), nil
+}
+
+// Start requeues jobs that were running when the server last stopped and
+// starts the workers.
+func (q *Queue) Start(ctx context.Context) error {
+	result, err := collection().UpdateMany(ctx,
+		bson.M{"status": models.AvatarJobRunning},
+		bson.M{"$set": bson.M{"status": models.AvatarJobQueued}},
+	)
+	if err != nil {
+		return err
+	}
+	if result.ModifiedCount > 0 {
+		log.Printf("Avatar queue: requeued %d interrupted jobs", result.ModifiedCount)
+	}
+
+	for i := 0; i < q.workers; i++ {
+		go q.work(ctx)
+	}
+	return nil
+}
+
+// Enqueue records a new job and wakes a worker for it.
+func (q *Queue) Enqueue(ctx context.Context, name, prompt, ownerWallet string) (*models.AvatarJob, error) {
+	job := &models.AvatarJob{
+		ID:          primitive.NewObjectID(),
+		Name:        name,
+		Prompt:      prompt,
+		OwnerWallet: ownerWallet,
+		Status:      models.AvatarJobQueued,
+		Generator:   q.generator.Name(),
+		CreatedAt:   time.Now(),
+	}
+	if _, err := collection().InsertOne(ctx, job); err != nil {
+		return nil, err
+	}
+
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+	return job, nil
+}
+
+// Get returns the job with id, or nil if there is none.
+func Get(ctx context.Context, id primitive.ObjectID) (*models.AvatarJob, error) {
+	var job models.AvatarJob
+	err := collection().FindOne(ctx, bson.M{"_id": id}).Decode(&job)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+func (q *Queue) work(ctx context.Context) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		for {
+			job, err := q.claim(ctx)
+			if err != nil {
+				log.Printf("Avatar queue: failed to claim job: %v", err)
+				break
+			}
+			if job == nil {
+				break
+			}
+			q.run(ctx, job)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-q.wake:
+		case <-ticker.C:
+		}
+	}
+}
+
+// claim atomically takes the oldest queued job.
+func (q *Queue) claim(ctx context.Context) (*models.AvatarJob, error) {
+	now := time.Now()
+	var job models.AvatarJob
+	err := collection().FindOneAndUpdate(ctx,
+		bson.M{"status": models.AvatarJobQueued},
+		bson.M{
+			"$set": bson.M{"status": models.AvatarJobRunning, "started_at": now},
+			"$inc": bson.M{"attempts": 1},
+		},
+		options.FindOneAndUpdate().
+			SetSort(bson.D{{Key: "created_at", Value: 1}}).
+			SetReturnDocument(options.After),
+	).Decode(&job)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+func (q *Queue) run(ctx context.Context, job *models.AvatarJob) {
+	if job.Attempts > maxAttempts {
+		q.finish(ctx, job, "", fmt.Errorf("gave up after %d attempts", maxAttempts))
+		return
+	}
+
+	runCtx, cancel := context.WithTimeout(ctx, q.timeout)
+	defer cancel()
+
+	assetID, err := q.generate(runCtx, job)
+	if ctx.Err() != nil {
+		// Shutting down: leave the job running so Start requeues it.
+		return
+	}
+	q.finish(ctx, job, assetID, err)
+}
+
+func (q *Queue) generate(ctx context.Context, job *models.AvatarJob) (string, error) {
+	image, err := q.generator.Generate(ctx, job.Prompt)
+	if err != nil {
+		return "", fmt.Errorf("error generating image: %v", err)
+	}
+
+	outputs, err := q.pipeline.Process(image.Data)
+	if err != nil {
+		return "", fmt.Errorf("error processing image: %v", err)
+	}
+	contents := make([]assets.Content, 0, len(outputs))
+	for _, out := range outputs {
+		contents = append(contents, assets.Content{
+			Name:        out.Name,
+			Data:        out.Data,
+			ContentType: out.ContentType,
+			Width:       out.Width,
+			Height:      out.Height,
+		})
+	}
+
+	// The image stays temporary until it is published with
+	// /create-nft-pin-metadata.
+	asset, err := assets.CreateSet(ctx, imageproc.Full, contents, models.Asset{
+		Name:        job.Name,
+		OwnerWallet: job.OwnerWallet,
+		Temporary:   true,
+		Generator:   job.Generator,
+		Prompt:      job.Prompt,
+	})
+	if err != nil {
+		return "", fmt.Errorf("error saving image: %v", err)
+	}
+	return asset.ID.Hex(), nil
+}
+
+func (q *Queue) finish(ctx context.Context, job *models.AvatarJob, assetID string, jobErr error) {
+	set := bson.M{"finished_at": time.Now()}
+	if jobErr != nil {
+		set["status"] = models.AvatarJobFailed
+		set["error"] = jobErr.Error()
+		log.Printf("Avatar queue: job %s failed: %v", job.ID.Hex(), jobErr)
+	} else {
+		set["status"] = models.AvatarJobSucceeded
+		set["asset_id"] = assetID
+	}
+
+	// The job outcome is written even if the server is shutting down.
+	_, err := collection().UpdateOne(context.WithoutCancel(ctx), bson.M{"_id": job.ID}, bson.M{"$set": set})
+	if err != nil {
+		log.Printf("Avatar queue: failed to record result of job %s: %v", job.ID.Hex(), err)
+	}
+}
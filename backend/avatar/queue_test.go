@@ -0,0 +1,41 @@
+package avatar
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFromEnv(t *testing.T) {
+	tests := []struct {
+		workers, timeout string
+		wantWorkers      int
+		wantTimeout      time.Duration
+		wantErr          bool
+	}{
+		{"", "", defaultWorkers, defaultJobTimeout, false},
+		{"4", "90s", 4, 90 * time.Second, false},
+		{"four", "", 0, 0, true},
+		{"0", "", 0, 0, true},
+		{"-1", "", 0, 0, true},
+		{"", "90", 0, 0, true},
+		{"", "0s", 0, 0, true},
+		{"", "-1m", 0, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Setenv("AVATAR_WORKERS", tt.workers)
+		t.Setenv("AVATAR_JOB_TIMEOUT", tt.timeout)
+
+		q, err := FromEnv(nil, nil)
+		switch {
+		case tt.wantErr:
+			if err == nil {
+				t.Errorf("AVATAR_WORKERS=%q AVATAR_JOB_TIMEOUT=%q: no error", tt.workers, tt.timeout)
+			}
+		case err != nil:
+			t.Errorf("AVATAR_WORKERS=%q AVATAR_JOB_TIMEOUT=%q: %v", tt.workers, tt.timeout, err)
+		case q.workers != tt.wantWorkers || q.timeout != tt.wantTimeout:
+			t.Errorf("AVATAR_WORKERS=%q AVATAR_JOB_TIMEOUT=%q: %d workers, %s timeout, want %d and %s", tt.workers, tt.timeout, q.workers, q.timeout, tt.wantWorkers, tt.wantTimeout)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+// Package bench seeds scratch MongoDB databases for the query benchmarks
+// in its tests.
+package bench
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"arjunmal1311/fans_flow_on_chain/backend/models"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const seedBatch = 1000
+
+// ListingsOptions sizes the seeded listings.
+type ListingsOptions struct {
+	Listings int
+	Models   int
+	// Orphans are extra listings whose model does not exist.
+	Orphans int
+}
+
+// SeedListings inserts opts.Models models, one holder per listing and
+// opts.Listings listed subscriptions spread across the models, plus
+// opts.Orphans listed subscriptions to models that do not exist. The caller
+// owns database and should drop it afterwards.
+func SeedListings(ctx context.Context, database *mongo.Database, opts ListingsOptions) error {
+	if opts.Listings <= 0 || opts.Models <= 0 {
+		return fmt.Errorf("listings and models must be positive")
+	}
+
+	modelIDs := make([]primitive.ObjectID, opts.Models)
+	docs := make([]interface{}, 0, seedBatch)
+	flush := func(collection string) error {
+		if len(docs) == 0 {
+			return nil
+		}
+		_, err := database.Collection(collection).InsertMany(ctx, docs)
+		docs = docs[:0]
+		return err
+	}
+
+	for i := range modelIDs {
+		modelIDs[i] = primitive.NewObjectID()
+		docs = append(docs, models.Model{
+			ID:            modelIDs[i],
+			Name:          fmt.Sprintf("Bench model %d", i),
+			ModelID:       fmt.Sprint(i + 1),
+			Email:         fmt.Sprintf("model%d@bench.invalid", i),
+			WalletAddress: fmt.Sprintf("0x%040x", i+1),
+			Slug:          fmt.Sprintf("bench-model-%d", i),
+			AboutMe:       "Seeded by BenchmarkListings",
+		})
+		if len(docs) == seedBatch {
+			if err := flush("models"); err != nil {
+				return err
+			}
+		}
+	}
+	if err := flush("models"); err != nil {
+		return err
+	}
+
+	expiresAt := time.Now().Add(30 * 24 * time.Hour)
+	for i := 0; i < opts.Listings+opts.Orphans; i++ {
+		modelID := primitive.NewObjectID()
+		if i < opts.Listings {
+			modelID = modelIDs[i%len(modelIDs)]
+		}
+		docs = append(docs, models.Subscription{
+			ID:        primitive.NewObjectID(),
+			Chain:     "default",
+			UserID:    primitive.NewObjectID(),
+			ModelID:   modelID,
+			TokenID:   fmt.Sprint(i + 1),
+			Price:     "10",
+			IsListed:  true,
+			ExpiresAt: &expiresAt,
+		})
+		if len(docs) == seedBatch {
+			if err := flush("subscriptions"); err != nil {
+				return err
+			}
+		}
+	}
+	return flush("subscriptions")
+}
@@ -0,0 +1,94 @@
+package bench
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"arjunmal1311/fans_flow_on_chain/backend/db"
+	"arjunmal1311/fans_flow_on_chain/backend/models"
+	"arjunmal1311/fans_flow_on_chain/backend/storage"
+)
+
+var listingsOptions = ListingsOptions{Listings: 5000, Models: 200, Orphans: 10}
+
+// BenchmarkListings compares the two ways of fetching the listed
+// subscriptions with their models: a model lookup per listing, and one
+// $lookup aggregation. It writes to a scratch database on the DATABASE_URL
+// server, never the application's, and drops it afterwards.
+func BenchmarkListings(b *testing.B) {
+	if os.Getenv("DATABASE_URL") == "" {
+		b.Skip("DATABASE_URL is not set")
+	}
+	if err := db.Connect(); err != nil {
+		b.Fatal(err)
+	}
+	defer db.CloseDB()
+
+	ctx := context.Background()
+	scratch := db.Client.Database(fmt.Sprintf("ofoc_bench_%d", time.Now().UnixNano()))
+	defer scratch.Drop(ctx)
+
+	if _, err := db.ReconcileIndexes(ctx, scratch, db.IndexOptions{CreateMissing: true}); err != nil {
+		b.Fatal(err)
+	}
+	if err := SeedListings(ctx, scratch, listingsOptions); err != nil {
+		b.Fatalf("seeding: %v", err)
+	}
+
+	repos := storage.NewMongo(scratch)
+	listed := true
+	filter := storage.SubscriptionFilter{Chain: "default", Listed: &listed, Status: models.SubscriptionActive}
+
+	// check fails the benchmark unless every seeded listing was fetched,
+	// with the orphans told apart.
+	check := func(b *testing.B, joined, orphaned int) {
+		if joined != listingsOptions.Listings || orphaned != listingsOptions.Orphans {
+			b.Fatalf("%d joined and %d orphaned, want %d and %d", joined, orphaned, listingsOptions.Listings, listingsOptions.Orphans)
+		}
+	}
+
+	b.Run("n+1", func(b *testing.B) {
+		var roundTrips int
+		for i := 0; i < b.N; i++ {
+			subs, err := repos.Subscriptions.Find(ctx, filter)
+			if err != nil {
+				b.Fatal(err)
+			}
+			joined, orphaned := 0, 0
+			for _, sub := range subs {
+				if _, err := repos.Models.Get(ctx, sub.ModelID); err == storage.ErrNotFound {
+					orphaned++
+				} else if err != nil {
+					b.Fatal(err)
+				} else {
+					joined++
+				}
+			}
+			check(b, joined, orphaned)
+			roundTrips = 1 + len(subs)
+		}
+		b.ReportMetric(float64(roundTrips), "round-trips/op")
+	})
+
+	b.Run("lookup", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			subs, err := repos.Subscriptions.FindWithModels(ctx, filter)
+			if err != nil {
+				b.Fatal(err)
+			}
+			joined, orphaned := 0, 0
+			for _, sub := range subs {
+				if sub.Model == nil {
+					orphaned++
+				} else {
+					joined++
+				}
+			}
+			check(b, joined, orphaned)
+		}
+		b.ReportMetric(1, "round-trips/op")
+	})
+}
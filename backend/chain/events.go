@@ -0,0 +1,148 @@
+package chain
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"golang.org/x/crypto/sha3"
+)
+
+var (
+	SubscriptionPurchasedTopic = EventTopic("SubscriptionPurchased(address,uint256,uint256,uint256)")
+	NFTListedTopic             = EventTopic("NFTListed(address,uint256,uint256)")
+	NFTSoldTopic               = EventTopic("NFTSold(address,address,uint256,uint256)")
+)
+
+type SubscriptionPurchased struct {
+	Buyer          string
+	ModelID        *big.Int
+	SubscriptionID *big.Int
+	TokenID        *big.Int
+	BlockNumber    uint64
+}
+
+type NFTListed struct {
+	Seller  string
+	TokenID *big.Int
+	Price   *big.Int
+}
+
+type NFTSold struct {
+	Seller  string
+	Buyer   string
+	TokenID *big.Int
+	Price   *big.Int
+}
+
+func Keccak256(data []byte) []byte {
+	h := sha3.NewLegacyKeccak256()
+	h.Write(data)
+	return h.Sum(nil)
+}
+
+func EventTopic(signature string) string {
+	return "0x" + hex.EncodeToString(Keccak256([]byte(signature)))
+}
+
+func DecodeSubscriptionPurchased(l Log) (*SubscriptionPurchased, error) {
+	if err := checkLog(l, SubscriptionPurchasedTopic, 2, 3); err != nil {
+		return nil, err
+	}
+	words, err := dataWords(l.Data)
+	if err != nil {
+		return nil, err
+	}
+	return &SubscriptionPurchased{
+		Buyer:          topicAddress(l.Topics[1]),
+		ModelID:        new(big.Int).SetBytes(words[0]),
+		SubscriptionID: new(big.Int).SetBytes(words[1]),
+		TokenID:        new(big.Int).SetBytes(words[2]),
+		BlockNumber:    uint64(l.BlockNumber),
+	}, nil
+}
+
+func DecodeNFTListed(l Log) (*NFTListed, error) {
+	if err := checkLog(l, NFTListedTopic, 3, 1); err != nil {
+		return nil, err
+	}
+	words, err := dataWords(l.Data)
+	if err != nil {
+		return nil, err
+	}
+	return &NFTListed{
+		Seller:  topicAddress(l.Topics[1]),
+		TokenID: topicInt(l.Topics[2]),
+		Price:   new(big.Int).SetBytes(words[0]),
+	}, nil
+}
+
+func DecodeNFTSold(l Log) (*NFTSold, error) {
+	if err := checkLog(l, NFTSoldTopic, 4, 1); err != nil {
+		return nil, err
+	}
+	words, err := dataWords(l.Data)
+	if err != nil {
+		return nil, err
+	}
+	return &NFTSold{
+		Seller:  topicAddress(l.Topics[1]),
+		Buyer:   topicAddress(l.Topics[2]),
+		TokenID: topicInt(l.Topics[3]),
+		Price:   new(big.Int).SetBytes(words[0]),
+	}, nil
+}
+
+func checkLog(l Log, topic string, topics, words int) error {
+	if len(l.Topics) != topics || !strings.EqualFold(l.Topics[0], topic) {
+		return fmt.Errorf("log %s:%d is not the expected event", l.TxHash, l.LogIndex)
+	}
+	if len(strings.TrimPrefix(l.Data, "0x")) < words*64 {
+		return fmt.Errorf("log %s:%d has truncated data", l.TxHash, l.LogIndex)
+	}
+	return nil
+}
+
+func dataWords(data string) ([][]byte, error) {
+	raw, err := hex.DecodeString(strings.TrimPrefix(data, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid log data: %v", err)
+	}
+	var words [][]byte
+	for i := 0; i+32 <= len(raw); i += 32 {
+		words = append(words, raw[i:i+32])
+	}
+	return words, nil
+}
+
+func topicAddress(topic string) string {
+	t := strings.TrimPrefix(topic, "0x")
+	if len(t) < 40 {
+		return ""
+	}
+	return "0x" + strings.ToLower(t[len(t)-40:])
+}
+
+func topicInt(topic string) *big.Int {
+	v, ok := new(big.Int).SetString(strings.TrimPrefix(topic, "0x"), 16)
+	if !ok {
+		return new(big.Int)
+	}
+	return v
+}
+
+// FormatUnits renders an integer token amount with the given number of
+// decimals, e.g. 150000000 with 8 decimals becomes "1.5".
+func FormatUnits(amount *big.Int, decimals int) string {
+	if amount == nil {
+		return "0"
+	}
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)
+	whole, frac := new(big.Int).QuoRem(amount, scale, new(big.Int))
+	if frac.Sign() == 0 {
+		return whole.String()
+	}
+	fracStr := fmt.Sprintf("%0*s", decimals, frac.String())
+	return whole.String() + "." + strings.TrimRight(fracStr, "0")
+}
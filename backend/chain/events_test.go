@@ -0,0 +1,134 @@
+package chain
+
+import (
+	"encoding/json"
+	"math/big"
+	"os"
+	"strings"
+	"testing"
+)
+
+const (
+	aliceWallet = "0x70997970c51812dc3a010c7d01b50e0d17dc79c8"
+	bobWallet   = "0x3c44cdddb6a900fa2b585dd299e03d12fa4293bc"
+)
+
+// marketplaceLogs reads testdata/marketplace_logs.json, the logs eth_getLogs
+// returns for MarketPlace.purchaseSubscription(7, 1, ...) by alice, her
+// listNFT of the token for 25 (8 decimals) and bob's buyNFT of it.
+func marketplaceLogs(t *testing.T) (purchased, listed, sold Log) {
+	t.Helper()
+	data, err := os.ReadFile("testdata/marketplace_logs.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var logs []Log
+	if err := json.Unmarshal(data, &logs); err != nil {
+		t.Fatal(err)
+	}
+	return logs[0], logs[1], logs[2]
+}
+
+func TestEventTopic(t *testing.T) {
+	tests := []struct {
+		signature string
+		want      string
+	}{
+		// ERC-20 Transfer, as a check of the hashing itself.
+		{"Transfer(address,address,uint256)", "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"},
+		{"SubscriptionPurchased(address,uint256,uint256,uint256)", SubscriptionPurchasedTopic},
+		{"NFTListed(address,uint256,uint256)", NFTListedTopic},
+		{"NFTSold(address,address,uint256,uint256)", NFTSoldTopic},
+	}
+	for _, tt := range tests {
+		if got := EventTopic(tt.signature); got != tt.want {
+			t.Errorf("EventTopic(%s) = %s, want %s", tt.signature, got, tt.want)
+		}
+	}
+
+	purchased, listed, sold := marketplaceLogs(t)
+	for _, l := range []struct {
+		log   Log
+		topic string
+	}{{purchased, SubscriptionPurchasedTopic}, {listed, NFTListedTopic}, {sold, NFTSoldTopic}} {
+		if l.log.Topics[0] != l.topic {
+			t.Errorf("fixture topic %s, want %s", l.log.Topics[0], l.topic)
+		}
+	}
+}
+
+func TestDecodeEvents(t *testing.T) {
+	purchased, listed, sold := marketplaceLogs(t)
+	tokenID, _ := new(big.Int).SetString("7000000000000000001", 10)
+	price := big.NewInt(2500000000)
+
+	p, err := DecodeSubscriptionPurchased(purchased)
+	if err != nil {
+		t.Fatalf("DecodeSubscriptionPurchased: %v", err)
+	}
+	if p.Buyer != aliceWallet || p.ModelID.Int64() != 7 || p.SubscriptionID.Int64() != 1 || p.TokenID.Cmp(tokenID) != 0 || p.BlockNumber != 10 {
+		t.Errorf("DecodeSubscriptionPurchased = %+v", p)
+	}
+
+	l, err := DecodeNFTListed(listed)
+	if err != nil {
+		t.Fatalf("DecodeNFTListed: %v", err)
+	}
+	if l.Seller != aliceWallet || l.TokenID.Cmp(tokenID) != 0 || l.Price.Cmp(price) != 0 {
+		t.Errorf("DecodeNFTListed = %+v", l)
+	}
+	if got := FormatUnits(l.Price, 8); got != "25" {
+		t.Errorf("listing price %s, want 25", got)
+	}
+
+	s, err := DecodeNFTSold(sold)
+	if err != nil {
+		t.Fatalf("DecodeNFTSold: %v", err)
+	}
+	if s.Seller != aliceWallet || s.Buyer != bobWallet || s.TokenID.Cmp(tokenID) != 0 || s.Price.Cmp(price) != 0 {
+		t.Errorf("DecodeNFTSold = %+v", s)
+	}
+}
+
+func TestDecodeEventsRejects(t *testing.T) {
+	purchased, listed, sold := marketplaceLogs(t)
+
+	with := func(l Log, change func(*Log)) Log {
+		l.Topics = append([]string(nil), l.Topics...)
+		change(&l)
+		return l
+	}
+	truncate := func(words int) func(*Log) {
+		return func(l *Log) { l.Data = l.Data[:2+words*64] }
+	}
+	decoders := map[string]func(Log) error{
+		"SubscriptionPurchased": func(l Log) error { _, err := DecodeSubscriptionPurchased(l); return err },
+		"NFTListed":             func(l Log) error { _, err := DecodeNFTListed(l); return err },
+		"NFTSold":               func(l Log) error { _, err := DecodeNFTSold(l); return err },
+	}
+
+	tests := []struct {
+		name    string
+		decoder string
+		log     Log
+	}{
+		{"purchase missing the token id", "SubscriptionPurchased", with(purchased, truncate(2))},
+		{"purchase without data", "SubscriptionPurchased", with(purchased, truncate(0))},
+		{"purchase without the buyer topic", "SubscriptionPurchased", with(purchased, func(l *Log) { l.Topics = l.Topics[:1] })},
+		{"purchase with invalid data", "SubscriptionPurchased", with(purchased, func(l *Log) { l.Data = "0x" + strings.Repeat("zz", 96) })},
+		{"listing without the price", "NFTListed", with(listed, truncate(0))},
+		{"listing with half a word", "NFTListed", with(listed, func(l *Log) { l.Data = l.Data[:34] })},
+		{"listing without the token topic", "NFTListed", with(listed, func(l *Log) { l.Topics = l.Topics[:2] })},
+		{"sale without the price", "NFTSold", with(sold, truncate(0))},
+		{"sale without the token topic", "NFTSold", with(sold, func(l *Log) { l.Topics = l.Topics[:3] })},
+		{"listing decoded as a sale", "NFTSold", listed},
+		{"sale decoded as a listing", "NFTListed", sold},
+		{"sale decoded as a purchase", "SubscriptionPurchased", sold},
+	}
+
+	for _, tt := range tests {
+		if err := decoders[tt.decoder](tt.log); err == nil {
+			t.Errorf("%s: no error", tt.name)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package chain
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"regexp"
+	"sync"
+)
+
+const (
+	defaultRegistryPath    = "chains.json"
+	defaultPaymentDecimals = 8
+)
+
+var addressPattern = regexp.MustCompile(`^0x[0-9a-fA-F]{40}$`)
+
+// Network is one EVM chain from the registry. The Name is the slug used in
+// routes (/chains/{chain}/...) and stored on subscriptions.
+type Network struct {
+	Name          string `json:"name"`
+	ChainID       uint64 `json:"chainId"`
+	DisplayName   string `json:"displayName"`
+	RPCURL        string `json:"rpcUrl"`
+	Marketplace   string `json:"marketplaceAddress"`
+	NFT           string `json:"nftAddress"`
+	PaymentToken  string `json:"paymentTokenAddress"`
+	StartBlock    uint64 `json:"startBlock"`
+	Confirmations uint64 `json:"confirmations"`
+	ExplorerURL   string `json:"explorerUrl"`
+	// PaymentDecimals is the number of decimals of the marketplace payment
+	// token, used to render on-chain prices the way the frontend stores them.
+	PaymentDecimals int `json:"paymentDecimals"`
+}
+
+// HasRPC reports whether the network can be queried, which purchase
+// verification and the indexer require.
+func (n Network) HasRPC() bool {
+	return n.RPCURL != "" && n.Marketplace != ""
+}
+
+type registryFile struct {
+	Chains []Network `json:"chains"`
+}
+
+var (
+	registryMu sync.RWMutex
+	registry   []Network
+)
+
+// LoadRegistry reads the chain registry from the file named by CHAINS_CONFIG
+// (chains.json by default). ${VAR} references in the file are expanded from
+// the environment so RPC credentials can stay in .env.
+func LoadRegistry() error {
+	path := os.Getenv("CHAINS_CONFIG")
+	if path == "" {
+		path = defaultRegistryPath
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("error reading chain registry %s: %v", path, err)
+	}
+
+	networks, err := parseRegistry([]byte(os.ExpandEnv(string(data))))
+	if err != nil {
+		return fmt.Errorf("invalid chain registry %s: %v", path, err)
+	}
+
+	registryMu.Lock()
+	registry = networks
+	registryMu.Unlock()
+
+	log.Printf("Loaded %d chains from %s", len(networks), path)
+	return nil
+}
+
+func parseRegistry(data []byte) ([]Network, error) {
+	var file registryFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, err
+	}
+
+	seen := map[string]bool{}
+	for i := range file.Chains {
+		n := &file.Chains[i]
+		if n.Name == "" {
+			return nil, fmt.Errorf("chain %d has no name", i)
+		}
+		if seen[n.Name] {
+			return nil, fmt.Errorf("chain %q is defined twice", n.Name)
+		}
+		seen[n.Name] = true
+
+		if n.ChainID == 0 {
+			return nil, fmt.Errorf("chain %q has no chainId", n.Name)
+		}
+		for field, address := range map[string]string{
+			"marketplaceAddress":  n.Marketplace,
+			"nftAddress":          n.NFT,
+			"paymentTokenAddress": n.PaymentToken,
+		} {
+			if address != "" && !addressPattern.MatchString(address) {
+				return nil, fmt.Errorf("chain %q has an invalid %s %q", n.Name, field, address)
+			}
+		}
+
+		if n.DisplayName == "" {
+			n.DisplayName = n.Name
+		}
+		if n.PaymentDecimals == 0 {
+			n.PaymentDecimals = defaultPaymentDecimals
+		}
+	}
+
+	return file.Chains, nil
+}
+
+// Networks returns every chain in the registry, in file order.
+func Networks() []Network {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	return append([]Network(nil), registry...)
+}
+
+func NetworkByName(name string) (Network, bool) {
+	for _, network := range Networks() {
+		if network.Name == name {
+			return network, true
+		}
+	}
+	return Network{}, false
+}
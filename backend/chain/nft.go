@@ -0,0 +1,109 @@
+package chain
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+var expirationTimesSelector = Keccak256([]byte("expirationTimes(uint256)"))[:4]
+
+// ExpirationTime reads BlockTeaseNFTs.expirationTimes(tokenID) as of block
+// number. mint sets it to block.timestamp + duration, but the mapping is per
+// token rather than per holder, so later purchases of the token overwrite
+// it; read at the block a purchase was mined in, it is that purchase's
+// expiry. A zero time means the token was not minted by then.
+func ExpirationTime(ctx context.Context, network Network, tokenID *big.Int, number uint64) (time.Time, error) {
+	if network.RPCURL == "" || network.NFT == "" {
+		return time.Time{}, fmt.Errorf("network %s has no NFT contract configured", network.Name)
+	}
+
+	data := make([]byte, 4, 36)
+	copy(data, expirationTimesSelector)
+	data = append(data, tokenID.FillBytes(make([]byte, 32))...)
+
+	result, err := NewClient(network.RPCURL).CallAt(ctx, network.NFT, data, number)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if len(result) != 32 {
+		return time.Time{}, fmt.Errorf("unexpected expirationTimes result of %d bytes", len(result))
+	}
+
+	expiresAt := new(big.Int).SetBytes(result)
+	if expiresAt.Sign() == 0 {
+		return time.Time{}, nil
+	}
+	if !expiresAt.IsInt64() {
+		return time.Time{}, fmt.Errorf("expiration time %s out of range", expiresAt)
+	}
+	return time.Unix(expiresAt.Int64(), 0).UTC(), nil
+}
+
+// BlockTime returns the timestamp of a block on network.
+func BlockTime(ctx context.Context, network Network, blockNumber uint64) (time.Time, error) {
+	return NewClient(network.RPCURL).BlockTimestamp(ctx, blockNumber)
+}
+
+// PurchaseTerm returns when the subscription bought in ev started, i.e. its
+// block time, and when it expires according to the NFT contract as of that
+// block. expiresAt is zero when the network has no NFT contract configured.
+func PurchaseTerm(ctx context.Context, network Network, ev *SubscriptionPurchased) (startsAt, expiresAt time.Time, err error) {
+	startsAt, err = BlockTime(ctx, network, ev.BlockNumber)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	if network.NFT == "" {
+		return startsAt, time.Time{}, nil
+	}
+	expiresAt, err = ExpirationTime(ctx, network, ev.TokenID, ev.BlockNumber)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	return startsAt, expiresAt, nil
+}
+
+// PurchaseExpiry returns the expiry of the purchase of tokenID made in
+// txHash, reading expirationTimes at the block the transaction was mined in.
+func PurchaseExpiry(ctx context.Context, network Network, txHash string, tokenID *big.Int) (time.Time, error) {
+	receipt, err := NewClient(network.RPCURL).TransactionReceipt(ctx, txHash)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if receipt == nil {
+		return time.Time{}, fmt.Errorf("transaction %s not found", txHash)
+	}
+	return ExpirationTime(ctx, network, tokenID, uint64(receipt.BlockNumber))
+}
+
+var balanceOfSelector = Keccak256([]byte("balanceOf(address,uint256)"))[:4]
+
+// BalanceOf reads the ERC-1155 balance of tokenID held by owner.
+func BalanceOf(ctx context.Context, network Network, owner string, tokenID *big.Int) (*big.Int, error) {
+	if network.RPCURL == "" || network.NFT == "" {
+		return nil, fmt.Errorf("network %s has no NFT contract configured", network.Name)
+	}
+
+	ownerBytes, err := hex.DecodeString(strings.TrimPrefix(strings.ToLower(owner), "0x"))
+	if err != nil || len(ownerBytes) != 20 {
+		return nil, fmt.Errorf("invalid owner address %q", owner)
+	}
+
+	data := make([]byte, 4, 68)
+	copy(data, balanceOfSelector)
+	data = append(data, make([]byte, 12)...)
+	data = append(data, ownerBytes...)
+	data = append(data, tokenID.FillBytes(make([]byte, 32))...)
+
+	result, err := NewClient(network.RPCURL).Call(ctx, network.NFT, data)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) != 32 {
+		return nil, fmt.Errorf("unexpected balanceOf result of %d bytes", len(result))
+	}
+	return new(big.Int).SetBytes(result), nil
+}
@@ -0,0 +1,225 @@
+package chain
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+type Client struct {
+	url        string
+	httpClient *http.Client
+	nextID     uint64
+}
+
+func NewClient(url string) *Client {
+	return &Client{
+		url:        url,
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+type rpcRequest struct {
+	JSONRPC string        `json:"jsonrpc"`
+	ID      uint64        `json:"id"`
+	Method  string        `json:"method"`
+	Params  []interface{} `json:"params"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type rpcResponse struct {
+	ID     uint64          `json:"id"`
+	Result json.RawMessage `json:"result"`
+	Error  *rpcError       `json:"error"`
+}
+
+func (c *Client) call(ctx context.Context, method string, params []interface{}, result interface{}) error {
+	if params == nil {
+		params = []interface{}{}
+	}
+
+	reqBody := rpcRequest{
+		JSONRPC: "2.0",
+		ID:      atomic.AddUint64(&c.nextID, 1),
+		Method:  method,
+		Params:  params,
+	}
+
+	jsonData, err := json.Marshal(reqBody)
+	if err != nil {
+		return fmt.Errorf("error marshaling %s request: %v", method, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", c.url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return fmt.Errorf("error creating request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error making %s request: %v", method, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("rpc %s error: %s", method, string(body))
+	}
+
+	var rpcResp rpcResponse
+	if err := json.NewDecoder(resp.Body).Decode(&rpcResp); err != nil {
+		return fmt.Errorf("error decoding %s response: %v", method, err)
+	}
+
+	if rpcResp.Error != nil {
+		return fmt.Errorf("rpc %s error %d: %s", method, rpcResp.Error.Code, rpcResp.Error.Message)
+	}
+
+	if result == nil {
+		return nil
+	}
+
+	if err := json.Unmarshal(rpcResp.Result, result); err != nil {
+		return fmt.Errorf("error decoding %s result: %v", method, err)
+	}
+
+	return nil
+}
+
+// Quantity is a hex-encoded JSON-RPC integer such as "0x1b4".
+type Quantity uint64
+
+func (q *Quantity) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	v, err := ParseQuantity(s)
+	if err != nil {
+		return err
+	}
+	*q = Quantity(v)
+	return nil
+}
+
+func ParseQuantity(s string) (uint64, error) {
+	if !strings.HasPrefix(s, "0x") {
+		return 0, fmt.Errorf("invalid hex quantity %q", s)
+	}
+	return strconv.ParseUint(s[2:], 16, 64)
+}
+
+func EncodeQuantity(v uint64) string {
+	return "0x" + strconv.FormatUint(v, 16)
+}
+
+type Log struct {
+	Address     string   `json:"address"`
+	Topics      []string `json:"topics"`
+	Data        string   `json:"data"`
+	BlockNumber Quantity `json:"blockNumber"`
+	TxHash      string   `json:"transactionHash"`
+	LogIndex    Quantity `json:"logIndex"`
+	Removed     bool     `json:"removed"`
+}
+
+type LogFilter struct {
+	FromBlock uint64
+	ToBlock   uint64
+	Address   string
+	Topics    []string
+}
+
+func (c *Client) BlockNumber(ctx context.Context) (uint64, error) {
+	var result Quantity
+	if err := c.call(ctx, "eth_blockNumber", nil, &result); err != nil {
+		return 0, err
+	}
+	return uint64(result), nil
+}
+
+func (c *Client) GetLogs(ctx context.Context, filter LogFilter) ([]Log, error) {
+	params := map[string]interface{}{
+		"fromBlock": EncodeQuantity(filter.FromBlock),
+		"toBlock":   EncodeQuantity(filter.ToBlock),
+		"address":   filter.Address,
+	}
+	if len(filter.Topics) > 0 {
+		// A single topic position holding a list matches any of the events.
+		params["topics"] = []interface{}{filter.Topics}
+	}
+
+	var logs []Log
+	if err := c.call(ctx, "eth_getLogs", []interface{}{params}, &logs); err != nil {
+		return nil, err
+	}
+	return logs, nil
+}
+
+type Receipt struct {
+	TxHash      string   `json:"transactionHash"`
+	BlockNumber Quantity `json:"blockNumber"`
+	Status      Quantity `json:"status"`
+	Logs        []Log    `json:"logs"`
+}
+
+// TransactionReceipt returns nil without an error when the node does not
+// know the transaction (yet).
+func (c *Client) TransactionReceipt(ctx context.Context, txHash string) (*Receipt, error) {
+	var receipt *Receipt
+	if err := c.call(ctx, "eth_getTransactionReceipt", []interface{}{txHash}, &receipt); err != nil {
+		return nil, err
+	}
+	return receipt, nil
+}
+
+// Call executes a read-only contract call against the latest block and
+// returns the raw return data.
+func (c *Client) Call(ctx context.Context, to string, data []byte) ([]byte, error) {
+	return c.ethCall(ctx, to, data, "latest")
+}
+
+// CallAt executes a read-only contract call against the state after block
+// number, which needs an archive node for blocks the node has pruned.
+func (c *Client) CallAt(ctx context.Context, to string, data []byte, number uint64) ([]byte, error) {
+	return c.ethCall(ctx, to, data, EncodeQuantity(number))
+}
+
+func (c *Client) ethCall(ctx context.Context, to string, data []byte, block string) ([]byte, error) {
+	var result string
+	params := []interface{}{
+		map[string]string{"to": to, "data": "0x" + hex.EncodeToString(data)},
+		block,
+	}
+	if err := c.call(ctx, "eth_call", params, &result); err != nil {
+		return nil, err
+	}
+	return hex.DecodeString(strings.TrimPrefix(result, "0x"))
+}
+
+// BlockTimestamp returns the timestamp of block number.
+func (c *Client) BlockTimestamp(ctx context.Context, number uint64) (time.Time, error) {
+	var block *struct {
+		Timestamp Quantity `json:"timestamp"`
+	}
+	if err := c.call(ctx, "eth_getBlockByNumber", []interface{}{EncodeQuantity(number), false}, &block); err != nil {
+		return time.Time{}, err
+	}
+	if block == nil {
+		return time.Time{}, fmt.Errorf("block %d not found", number)
+	}
+	return time.Unix(int64(block.Timestamp), 0).UTC(), nil
+}
@@ -0,0 +1,157 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"arjunmal1311/fans_flow_on_chain/backend/tokenid"
+)
+
+const (
+	CodeInvalidTxHash    = "INVALID_TX_HASH"
+	CodeTxNotFound       = "TX_NOT_FOUND"
+	CodeTxFailed         = "TX_FAILED"
+	CodeTxNotConfirmed   = "TX_NOT_CONFIRMED"
+	CodePurchaseNotFound = "PURCHASE_EVENT_NOT_FOUND"
+	CodeBuyerMismatch    = "BUYER_MISMATCH"
+	CodeModelMismatch    = "MODEL_MISMATCH"
+	CodeTokenMismatch    = "TOKEN_MISMATCH"
+	CodeSaleNotFound     = "SALE_EVENT_NOT_FOUND"
+	CodeSellerMismatch   = "SELLER_MISMATCH"
+)
+
+var txHashPattern = regexp.MustCompile(`^0x[0-9a-fA-F]{64}$`)
+
+// VerificationError explains why a transaction does not prove the claimed
+// purchase. Code is stable and meant to be returned to clients.
+type VerificationError struct {
+	Code    string
+	Message string
+}
+
+func (e *VerificationError) Error() string {
+	return e.Message
+}
+
+// SaleClaim is what a sale transaction must show. Seller is only checked
+// when set; buyers claiming a subscription learn it from the sale itself.
+type SaleClaim struct {
+	Seller  string
+	Buyer   string
+	TokenID string
+}
+
+type PurchaseClaim struct {
+	Buyer   string
+	ModelID string
+	TokenID string
+}
+
+// VerifyPurchase fetches the receipt of txHash and checks that it holds a
+// SubscriptionPurchased event from the network's marketplace matching claim.
+// Failures caused by the transaction itself are returned as
+// *VerificationError; anything else is an RPC error.
+func VerifyPurchase(ctx context.Context, network Network, txHash string, claim PurchaseClaim) (*SubscriptionPurchased, error) {
+	receipt, err := confirmedReceipt(ctx, network, txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	var purchase *SubscriptionPurchased
+	for _, l := range receipt.Logs {
+		if !strings.EqualFold(l.Address, network.Marketplace) || len(l.Topics) == 0 || !strings.EqualFold(l.Topics[0], SubscriptionPurchasedTopic) {
+			continue
+		}
+		purchase, err = DecodeSubscriptionPurchased(l)
+		if err != nil {
+			return nil, &VerificationError{CodePurchaseNotFound, err.Error()}
+		}
+		break
+	}
+	if purchase == nil {
+		return nil, &VerificationError{CodePurchaseNotFound, "Transaction did not emit SubscriptionPurchased from the marketplace"}
+	}
+
+	if !strings.EqualFold(purchase.Buyer, claim.Buyer) {
+		return nil, &VerificationError{CodeBuyerMismatch, "Transaction buyer does not match the user's wallet address"}
+	}
+	if !tokenid.SameModel(purchase.ModelID, claim.ModelID) {
+		return nil, &VerificationError{CodeModelMismatch, "Transaction modelId does not match the requested modelId"}
+	}
+	if purchase.TokenID.String() != claim.TokenID {
+		return nil, &VerificationError{CodeTokenMismatch, "Transaction tokenId does not match the requested tokenId"}
+	}
+
+	return purchase, nil
+}
+
+// VerifySale checks that txHash holds an NFTSold event from the network's
+// marketplace matching claim, i.e. that a listed subscription changed hands.
+func VerifySale(ctx context.Context, network Network, txHash string, claim SaleClaim) (*NFTSold, error) {
+	receipt, err := confirmedReceipt(ctx, network, txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	var sale *NFTSold
+	for _, l := range receipt.Logs {
+		if !strings.EqualFold(l.Address, network.Marketplace) || len(l.Topics) == 0 || !strings.EqualFold(l.Topics[0], NFTSoldTopic) {
+			continue
+		}
+		sale, err = DecodeNFTSold(l)
+		if err != nil {
+			return nil, &VerificationError{CodeSaleNotFound, err.Error()}
+		}
+		break
+	}
+	if sale == nil {
+		return nil, &VerificationError{CodeSaleNotFound, "Transaction did not emit NFTSold from the marketplace"}
+	}
+
+	if claim.Seller != "" && !strings.EqualFold(sale.Seller, claim.Seller) {
+		return nil, &VerificationError{CodeSellerMismatch, "Transaction seller does not match the subscription holder"}
+	}
+	if !strings.EqualFold(sale.Buyer, claim.Buyer) {
+		return nil, &VerificationError{CodeBuyerMismatch, "Transaction buyer does not match the user's wallet address"}
+	}
+	if sale.TokenID.String() != claim.TokenID {
+		return nil, &VerificationError{CodeTokenMismatch, "Transaction tokenId does not match the requested tokenId"}
+	}
+
+	return sale, nil
+}
+
+// confirmedReceipt fetches the receipt of a successful transaction that has
+// the network's required confirmations.
+func confirmedReceipt(ctx context.Context, network Network, txHash string) (*Receipt, error) {
+	if !txHashPattern.MatchString(txHash) {
+		return nil, &VerificationError{CodeInvalidTxHash, "txHash must be a 32-byte hex string"}
+	}
+
+	client := NewClient(network.RPCURL)
+
+	receipt, err := client.TransactionReceipt(ctx, txHash)
+	if err != nil {
+		return nil, err
+	}
+	if receipt == nil {
+		return nil, &VerificationError{CodeTxNotFound, "Transaction not found or still pending"}
+	}
+	if receipt.Status != 1 {
+		return nil, &VerificationError{CodeTxFailed, "Transaction reverted"}
+	}
+
+	if network.Confirmations > 0 {
+		head, err := client.BlockNumber(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if head < uint64(receipt.BlockNumber)+network.Confirmations {
+			return nil, &VerificationError{CodeTxNotConfirmed, fmt.Sprintf("Transaction needs %d confirmations", network.Confirmations)}
+		}
+	}
+
+	return receipt, nil
+}
@@ -0,0 +1,175 @@
+package chain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const (
+	testMarketplace = "0xe7f1725e7734ce288f8367e1bb143e90bb3f0512"
+	testTokenID     = "7000000000000000001"
+)
+
+// receiptStub is a JSON-RPC node that knows the receipts in receipts, keyed
+// by transaction hash, and is at block head.
+func receiptStub(t *testing.T, head uint64, receipts map[string]interface{}) Network {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     uint64   `json:"id"`
+			Method string   `json:"method"`
+			Params []string `json:"params"`
+		}
+		json.NewDecoder(r.Body).Decode(&req)
+
+		var result interface{}
+		switch req.Method {
+		case "eth_getTransactionReceipt":
+			result = receipts[req.Params[0]]
+		case "eth_blockNumber":
+			result = EncodeQuantity(head)
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": req.ID, "result": result})
+	}))
+	t.Cleanup(server.Close)
+	return Network{Name: "testnet", RPCURL: server.URL, Marketplace: testMarketplace, Confirmations: 2}
+}
+
+// receipt renders a receipt as eth_getTransactionReceipt returns it.
+func receipt(status uint64, logs ...Log) map[string]interface{} {
+	rendered := []map[string]interface{}{}
+	for _, l := range logs {
+		rendered = append(rendered, map[string]interface{}{
+			"address":         l.Address,
+			"topics":          l.Topics,
+			"data":            l.Data,
+			"blockNumber":     EncodeQuantity(uint64(l.BlockNumber)),
+			"transactionHash": l.TxHash,
+			"logIndex":        EncodeQuantity(uint64(l.LogIndex)),
+			"removed":         false,
+		})
+	}
+	return map[string]interface{}{
+		"transactionHash": "0x" + strings.Repeat("ab", 32),
+		"blockNumber":     EncodeQuantity(10),
+		"status":          EncodeQuantity(status),
+		"logs":            rendered,
+	}
+}
+
+func txHash(b string) string {
+	return "0x" + strings.Repeat(b, 32)
+}
+
+func verificationCode(err error) string {
+	var verr *VerificationError
+	if errors.As(err, &verr) {
+		return verr.Code
+	}
+	return ""
+}
+
+func TestVerifyPurchase(t *testing.T) {
+	purchased, listed, _ := marketplaceLogs(t)
+	elsewhere := purchased
+	elsewhere.Address = "0x9fe46736679d2d9a65f0992f2272de9f3c7fa6e0"
+
+	network := receiptStub(t, 20, map[string]interface{}{
+		txHash("01"): receipt(1, listed, purchased),
+		txHash("02"): receipt(0, purchased),
+		txHash("03"): receipt(1, elsewhere),
+		txHash("04"): receipt(1, listed),
+	})
+	claim := PurchaseClaim{Buyer: "0x70997970C51812dc3A010C7d01b50e0d17dc79C8", ModelID: "7", TokenID: testTokenID}
+	with := func(change func(*PurchaseClaim)) PurchaseClaim {
+		c := claim
+		change(&c)
+		return c
+	}
+
+	tests := []struct {
+		name   string
+		txHash string
+		claim  PurchaseClaim
+		code   string
+	}{
+		{"valid", txHash("01"), claim, ""},
+		{"model id with leading zeros", txHash("01"), with(func(c *PurchaseClaim) { c.ModelID = "007" }), ""},
+		{"another buyer", txHash("01"), with(func(c *PurchaseClaim) { c.Buyer = bobWallet }), CodeBuyerMismatch},
+		{"another model", txHash("01"), with(func(c *PurchaseClaim) { c.ModelID = "8" }), CodeModelMismatch},
+		{"another token", txHash("01"), with(func(c *PurchaseClaim) { c.TokenID = "7000000000000000002" }), CodeTokenMismatch},
+		{"reverted", txHash("02"), claim, CodeTxFailed},
+		{"another contract", txHash("03"), claim, CodePurchaseNotFound},
+		{"no purchase event", txHash("04"), claim, CodePurchaseNotFound},
+		{"missing receipt", txHash("05"), claim, CodeTxNotFound},
+		{"malformed hash", "0x1234", claim, CodeInvalidTxHash},
+	}
+
+	for _, tt := range tests {
+		purchase, err := VerifyPurchase(context.Background(), network, tt.txHash, tt.claim)
+		if got := verificationCode(err); got != tt.code || (tt.code == "" && err != nil) {
+			t.Errorf("%s: error %v (code %q), want %q", tt.name, err, got, tt.code)
+		}
+		if tt.code == "" && err == nil && purchase.TokenID.String() != testTokenID {
+			t.Errorf("%s: purchase %+v", tt.name, purchase)
+		}
+	}
+
+	// The receipt is at block 10, which needs block 12 for 2 confirmations.
+	early := receiptStub(t, 11, map[string]interface{}{txHash("01"): receipt(1, purchased)})
+	if _, err := VerifyPurchase(context.Background(), early, txHash("01"), claim); verificationCode(err) != CodeTxNotConfirmed {
+		t.Errorf("unconfirmed: error %v, want %s", err, CodeTxNotConfirmed)
+	}
+}
+
+func TestVerifySale(t *testing.T) {
+	_, listed, sold := marketplaceLogs(t)
+	elsewhere := sold
+	elsewhere.Address = "0x9fe46736679d2d9a65f0992f2272de9f3c7fa6e0"
+	truncated := sold
+	truncated.Data = "0x"
+
+	network := receiptStub(t, 20, map[string]interface{}{
+		txHash("01"): receipt(1, sold),
+		txHash("02"): receipt(0, sold),
+		txHash("03"): receipt(1, elsewhere),
+		txHash("04"): receipt(1, listed),
+		txHash("06"): receipt(1, truncated),
+	})
+	claim := SaleClaim{Seller: aliceWallet, Buyer: "0x3C44CdDdB6a900fa2b585dd299e03d12FA4293BC", TokenID: testTokenID}
+	with := func(change func(*SaleClaim)) SaleClaim {
+		c := claim
+		change(&c)
+		return c
+	}
+
+	tests := []struct {
+		name   string
+		txHash string
+		claim  SaleClaim
+		code   string
+	}{
+		{"valid", txHash("01"), claim, ""},
+		{"seller not claimed", txHash("01"), with(func(c *SaleClaim) { c.Seller = "" }), ""},
+		{"another seller", txHash("01"), with(func(c *SaleClaim) { c.Seller = bobWallet }), CodeSellerMismatch},
+		{"another buyer", txHash("01"), with(func(c *SaleClaim) { c.Buyer = aliceWallet }), CodeBuyerMismatch},
+		{"another token", txHash("01"), with(func(c *SaleClaim) { c.TokenID = "8000000000000000001" }), CodeTokenMismatch},
+		{"reverted", txHash("02"), claim, CodeTxFailed},
+		{"another contract", txHash("03"), claim, CodeSaleNotFound},
+		{"no sale event", txHash("04"), claim, CodeSaleNotFound},
+		{"missing receipt", txHash("05"), claim, CodeTxNotFound},
+		{"truncated sale event", txHash("06"), claim, CodeSaleNotFound},
+	}
+
+	for _, tt := range tests {
+		_, err := VerifySale(context.Background(), network, tt.txHash, tt.claim)
+		if got := verificationCode(err); got != tt.code || (tt.code == "" && err != nil) {
+			t.Errorf("%s: error %v (code %q), want %q", tt.name, err, got, tt.code)
+		}
+	}
+}
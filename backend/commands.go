@@ -0,0 +1,179 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"arjunmal1311/fans_flow_on_chain/backend/db"
+	"arjunmal1311/fans_flow_on_chain/backend/ipfs"
+	"arjunmal1311/fans_flow_on_chain/backend/migrations"
+	"arjunmal1311/fans_flow_on_chain/backend/posts"
+	"arjunmal1311/fans_flow_on_chain/backend/storage"
+)
+
+// runCommand runs a one-off maintenance command instead of the HTTP server,
+// e.g. `go run . migrate up -dry-run`.
+func runCommand(name string, args []string) error {
+	switch name {
+	case "migrate":
+		return migrateCommand(args)
+	case "indexes":
+		return indexesCommand(args)
+	case "repin":
+		return repinCommand(args)
+	case "recount-posts":
+		return recountPostsCommand(args)
+	default:
+		return fmt.Errorf("unknown command %q", name)
+	}
+}
+
+func migrateCommand(args []string) error {
+	if len(args) == 0 {
+		return errors.New("usage: migrate up|down|status [flags]")
+	}
+	action := args[0]
+
+	flags := flag.NewFlagSet("migrate "+action, flag.ExitOnError)
+	to := flags.Int("to", -1, "up: apply up to this version; down: roll back every migration above it")
+	dryRun := flags.Bool("dry-run", false, "report what would change without writing")
+	flags.Parse(args[1:])
+
+	if action != "status" && action != "up" && action != "down" {
+		return fmt.Errorf("unknown migrate action %q", action)
+	}
+
+	if err := db.InitDB(); err != nil {
+		return err
+	}
+	defer db.CloseDB()
+
+	ctx := context.Background()
+	switch action {
+	case "status":
+		states, err := migrations.Status(ctx, db.DB)
+		if err != nil {
+			return fmt.Errorf("migration status failed: %v", err)
+		}
+		return printJSON(states)
+	case "up":
+		target := *to
+		if target < 0 {
+			target = 0
+		}
+		results, err := migrations.Up(ctx, db.DB, target, *dryRun)
+		printJSON(results)
+		if err != nil {
+			return fmt.Errorf("migration failed: %v", err)
+		}
+	default:
+		results, err := migrations.Down(ctx, db.DB, *to, *dryRun)
+		printJSON(results)
+		if err != nil {
+			return fmt.Errorf("rollback failed: %v", err)
+		}
+	}
+	return nil
+}
+
+func indexesCommand(args []string) error {
+	flags := flag.NewFlagSet("indexes", flag.ExitOnError)
+	create := flags.Bool("create", false, "create missing indexes")
+	rebuild := flags.Bool("rebuild", false, "drop and recreate indexes that differ from their spec")
+	dropExtra := flags.Bool("drop-extra", false, "drop indexes that have no spec")
+	failOnDrift := flags.Bool("fail-on-drift", false, "exit with status 1 if missing or mismatched indexes remain")
+	flags.Parse(args)
+
+	if err := db.Connect(); err != nil {
+		return err
+	}
+	defer db.CloseDB()
+
+	report, err := db.ReconcileIndexes(context.Background(), db.DB, db.IndexOptions{
+		CreateMissing:     *create,
+		DropExtra:         *dropExtra,
+		RebuildMismatched: *rebuild,
+	})
+	if err != nil {
+		return fmt.Errorf("index check failed: %v", err)
+	}
+
+	if err := printJSON(report); err != nil {
+		return err
+	}
+	if left := report.Unresolved(); *failOnDrift && len(left) > 0 {
+		return fmt.Errorf("%d indexes do not match their specs", len(left))
+	}
+	return nil
+}
+
+func repinCommand(args []string) error {
+	flags := flag.NewFlagSet("repin", flag.ExitOnError)
+	to := flags.String("to", "", "provider to move pins to (pinata or kubo)")
+	from := flags.String("from", "", "only move pins held by this provider")
+	unpin := flags.Bool("unpin-source", false, "unpin from the old provider once re-pinned")
+	dryRun := flags.Bool("dry-run", false, "report what would be moved without pinning")
+	flags.Parse(args)
+
+	if *to == "" {
+		return errors.New("repin: -to is required")
+	}
+
+	if err := db.InitDB(); err != nil {
+		return err
+	}
+	defer db.CloseDB()
+
+	repos, closeStorage, err := storage.FromEnv(context.Background())
+	if err != nil {
+		return err
+	}
+	defer closeStorage()
+
+	if err := ipfs.Init(repos); err != nil {
+		return err
+	}
+
+	report, err := ipfs.Repin(context.Background(), *from, *to, *unpin, *dryRun)
+	if err != nil {
+		return fmt.Errorf("re-pin failed: %v", err)
+	}
+
+	return printJSON(report)
+}
+
+func recountPostsCommand(args []string) error {
+	flags := flag.NewFlagSet("recount-posts", flag.ExitOnError)
+	flags.Parse(args)
+
+	if err := db.InitDB(); err != nil {
+		return err
+	}
+	defer db.CloseDB()
+
+	repos, closeStorage, err := storage.FromEnv(context.Background())
+	if err != nil {
+		return err
+	}
+	defer closeStorage()
+
+	changed, err := posts.RecountAll(context.Background(), repos.Models)
+	if err != nil {
+		return fmt.Errorf("recounting posts failed: %v", err)
+	}
+
+	return printJSON(map[string]int{"changed": changed})
+}
+
+func printJSON(v interface{}) error {
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(v); err != nil {
+		return fmt.Errorf("failed to print result: %v", err)
+	}
+	return nil
+}
@@ -2,10 +2,13 @@ package db
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
 	"os"
-	"time"
+	"strings"
 
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
@@ -15,70 +18,54 @@ var (
 	DB     *mongo.Database
 )
 
-func InitDB() {
+// InitDB connects to DATABASE_URL and reconciles the declared indexes. On
+// error nothing is left connected.
+func InitDB() error {
+	if err := Connect(); err != nil {
+		return err
+	}
+	if err := ensureIndexes(); err != nil {
+		CloseDB()
+		return err
+	}
+	return nil
+}
+
+// Connect connects to DATABASE_URL without touching indexes.
+func Connect() error {
 	mongoURI := os.Getenv("DATABASE_URL")
 	if mongoURI == "" {
-		log.Fatal("DATABASE_URL environment variable is not set")
+		return errors.New("DATABASE_URL environment variable is not set")
 	}
 
-	var err error
-	Client, err = mongo.Connect(context.Background(), options.Client().ApplyURI(mongoURI))
+	client, err := mongo.Connect(context.Background(), options.Client().ApplyURI(mongoURI))
 	if err != nil {
-		log.Fatalf("Failed to connect to MongoDB: %v", err)
+		return fmt.Errorf("failed to connect to MongoDB: %v", err)
 	}
 
-	err = Client.Ping(context.Background(), nil)
-	if err != nil {
-		log.Fatalf("Failed to ping MongoDB: %v", err)
+	if err := client.Ping(context.Background(), nil); err != nil {
+		client.Disconnect(context.Background())
+		return fmt.Errorf("failed to ping MongoDB: %v", err)
 	}
 
+	Client = client
 	DB = Client.Database("ofoc")
 
-	createIndexes()
-
 	log.Println("Successfully connected to MongoDB")
-}
-
-func createIndexes() {
-	usersCollection := GetCollection("users")
-
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
-
-	_, err := usersCollection.Indexes().CreateMany(ctx, []mongo.IndexModel{
-		{
-			Keys:    map[string]interface{}{"username": 1},
-			Options: options.Index().SetUnique(true),
-		},
-		{
-			Keys:    map[string]interface{}{"email": 1},
-			Options: options.Index().SetUnique(true),
-		},
-		{
-			Keys:    map[string]interface{}{"wallet_address": 1},
-			Options: options.Index().SetUnique(true),
-		},
-	})
-
-	if err != nil {
-		log.Printf("Warning: Failed to create indexes: %v", err)
-	}
-
-	modelsCollection := GetCollection("models")
-	_, err = modelsCollection.Indexes().CreateOne(ctx, mongo.IndexModel{
-		Keys:    map[string]interface{}{"model_id": 1},
-		Options: options.Index().SetUnique(true),
-	})
-
-	if err != nil {
-		log.Printf("Warning: Failed to create model index: %v", err)
-	}
+	return nil
 }
 
 func GetCollection(collectionName string) *mongo.Collection {
 	return DB.Collection(collectionName)
 }
 
+// WalletAddressFilter matches wallet_address case-insensitively. Addresses
+// arrive both checksummed and lower-cased but are stored lower-cased, so
+// the lookup can use the unique index.
+func WalletAddressFilter(address string) bson.M {
+	return bson.M{"wallet_address": strings.ToLower(address)}
+}
+
 func CloseDB() {
 	if Client != nil {
 		if err := Client.Disconnect(context.Background()); err != nil {
@@ -0,0 +1,354 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// IndexSpec is an index a collection should have. Indexes are matched to
+// existing ones by name, which defaults to MongoDB's own naming
+// ("field_1_other_-1") so indexes created before the specs existed are
+// recognised.
+type IndexSpec struct {
+	Keys   bson.D
+	Unique bool
+	// Partial limits the index to matching documents, letting a unique
+	// index ignore documents without the field.
+	Partial bson.D
+	// TTL expires documents this long after the indexed date; nil for
+	// none.
+	TTL *time.Duration
+}
+
+// Name returns the index's MongoDB default name.
+func (s IndexSpec) Name() string {
+	parts := make([]string, 0, len(s.Keys))
+	for _, k := range s.Keys {
+		parts = append(parts, fmt.Sprintf("%s_%v", k.Key, k.Value))
+	}
+	return strings.Join(parts, "_")
+}
+
+func (s IndexSpec) model() mongo.IndexModel {
+	opts := options.Index().SetName(s.Name())
+	if s.Unique {
+		opts.SetUnique(true)
+	}
+	if s.Partial != nil {
+		opts.SetPartialFilterExpression(s.Partial)
+	}
+	if s.TTL != nil {
+		opts.SetExpireAfterSeconds(int32(s.TTL.Seconds()))
+	}
+	return mongo.IndexModel{Keys: s.Keys, Options: opts}
+}
+
+// describe renders the parts of an index that specs declare, in one form
+// for specs and existing indexes alike.
+func describe(keys bson.D, unique bool, partial bson.D, ttl *int64) string {
+	var b strings.Builder
+	for i, k := range keys {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		fmt.Fprintf(&b, "%s: %v", k.Key, normalizeNumber(k.Value))
+	}
+	if unique {
+		b.WriteString(" unique")
+	}
+	if partial != nil {
+		filter, _ := bson.MarshalExtJSON(partial, false, false)
+		fmt.Fprintf(&b, " partial %s", filter)
+	}
+	if ttl != nil {
+		fmt.Fprintf(&b, " ttl %ds", *ttl)
+	}
+	return b.String()
+}
+
+// normalizeNumber maps the int32, int64 and double key directions the
+// server may return to one type.
+func normalizeNumber(v interface{}) interface{} {
+	switch n := v.(type) {
+	case int32:
+		return int64(n)
+	case int:
+		return int64(n)
+	case float64:
+		return int64(n)
+	}
+	return v
+}
+
+func (s IndexSpec) describe() string {
+	var ttl *int64
+	if s.TTL != nil {
+		seconds := int64(s.TTL.Seconds())
+		ttl = &seconds
+	}
+	return describe(s.Keys, s.Unique, s.Partial, ttl)
+}
+
+func ttl(d time.Duration) *time.Duration {
+	return &d
+}
+
+// CollectionIndexes declares every index of one collection besides _id.
+type CollectionIndexes struct {
+	Collection string
+	Indexes    []IndexSpec
+}
+
+// Indexes declares the indexes each collection should have. Collections
+// not listed here are left alone.
+var Indexes = []CollectionIndexes{
+	{"users", []IndexSpec{
+		{Keys: bson.D{{Key: "username", Value: 1}}, Unique: true},
+		{Keys: bson.D{{Key: "email", Value: 1}}, Unique: true},
+		{Keys: bson.D{{Key: "wallet_address", Value: 1}}, Unique: true},
+	}},
+	{"models", []IndexSpec{
+		{Keys: bson.D{{Key: "model_id", Value: 1}}, Unique: true},
+		// Models registered without a slug share "", so only the ones with
+		// a slug are kept unique.
+		{
+			Keys:    bson.D{{Key: "slug", Value: 1}},
+			Unique:  true,
+			Partial: bson.D{{Key: "slug", Value: bson.D{{Key: "$gt", Value: ""}}}},
+		},
+	}},
+	{"subscriptions", []IndexSpec{
+		// Token lookups, with or without a chain, and the purchase upsert,
+		// which relies on one subscription per holder of a token.
+		{Keys: bson.D{{Key: "token_id", Value: 1}, {Key: "chain", Value: 1}, {Key: "user_id", Value: 1}}, Unique: true},
+		{Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "chain", Value: 1}}},
+		{Keys: bson.D{{Key: "is_listed", Value: 1}, {Key: "chain", Value: 1}}},
+		{Keys: bson.D{{Key: "tx_hash", Value: 1}}},
+		{Keys: bson.D{{Key: "expires_at", Value: 1}}},
+	}},
+	{"subscription_options", []IndexSpec{
+		{Keys: bson.D{{Key: "model_id", Value: 1}}},
+	}},
+	{"auth_nonces", []IndexSpec{
+		{Keys: bson.D{{Key: "expires_at", Value: 1}}, TTL: ttl(0)},
+	}},
+	{"avatar_jobs", []IndexSpec{
+		{Keys: bson.D{{Key: "status", Value: 1}, {Key: "created_at", Value: 1}}},
+	}},
+	{"assets", []IndexSpec{
+		{Keys: bson.D{{Key: "hash", Value: 1}}},
+		{Keys: bson.D{{Key: "temporary", Value: 1}, {Key: "created_at", Value: 1}}},
+	}},
+	{"pins", []IndexSpec{
+		{Keys: bson.D{{Key: "cid", Value: 1}}},
+		{Keys: bson.D{{Key: "owner_wallet", Value: 1}, {Key: "created_at", Value: -1}}},
+		{Keys: bson.D{{Key: "parent_cid", Value: 1}}},
+		{Keys: bson.D{{Key: "status", Value: 1}, {Key: "created_at", Value: 1}}},
+	}},
+	{"posts", []IndexSpec{
+		{Keys: bson.D{{Key: "model_id", Value: 1}, {Key: "_id", Value: -1}}},
+	}},
+}
+
+// namespaceNotFound is the server's error code for listing the indexes of
+// a collection that has not been created.
+const namespaceNotFound = 26
+
+const (
+	IndexMissing    = "missing"
+	IndexExtra      = "extra"
+	IndexMismatched = "mismatched"
+)
+
+// IndexDrift is one difference between the specs and the database.
+type IndexDrift struct {
+	Collection string `json:"collection"`
+	Name       string `json:"name"`
+	Kind       string `json:"kind"`
+	Want       string `json:"want,omitempty"`
+	Have       string `json:"have,omitempty"`
+	// Fixed is set once the index was created, dropped or rebuilt to match.
+	Fixed bool   `json:"fixed"`
+	Error string `json:"error,omitempty"`
+}
+
+// IndexReport lists the drift found by ReconcileIndexes.
+type IndexReport struct {
+	Checked int          `json:"checked"`
+	Drift   []IndexDrift `json:"drift"`
+}
+
+// Unresolved returns the missing and mismatched indexes left unfixed.
+// Extra indexes only cost writes, so they are not counted.
+func (r *IndexReport) Unresolved() []IndexDrift {
+	var left []IndexDrift
+	for _, d := range r.Drift {
+		if !d.Fixed && d.Kind != IndexExtra {
+			left = append(left, d)
+		}
+	}
+	return left
+}
+
+// IndexOptions says which kinds of drift ReconcileIndexes repairs; with
+// none set it only reports.
+type IndexOptions struct {
+	CreateMissing bool
+	DropExtra     bool
+	// RebuildMismatched drops and recreates indexes whose definition
+	// changed. Building an index blocks nothing on current MongoDB, but
+	// queries go without it meanwhile.
+	RebuildMismatched bool
+}
+
+// existingIndex is what listIndexes returns for one index.
+type existingIndex struct {
+	Name               string `bson:"name"`
+	Key                bson.D `bson:"key"`
+	Unique             bool   `bson:"unique"`
+	PartialFilter      bson.D `bson:"partialFilterExpression"`
+	ExpireAfterSeconds *int64 `bson:"expireAfterSeconds"`
+}
+
+func (e existingIndex) describe() string {
+	return describe(e.Key, e.Unique, e.PartialFilter, e.ExpireAfterSeconds)
+}
+
+// ReconcileIndexes compares Indexes with database and repairs the drift
+// opts allows. Failures to repair are recorded in the report rather than
+// returned; the error is for failing to read the indexes at all.
+func ReconcileIndexes(ctx context.Context, database *mongo.Database, opts IndexOptions) (*IndexReport, error) {
+	report := &IndexReport{Drift: []IndexDrift{}}
+
+	for _, c := range Indexes {
+		collection := database.Collection(c.Collection)
+
+		list, err := listIndexes(ctx, collection)
+		if err != nil {
+			return nil, fmt.Errorf("listing %s indexes: %v", c.Collection, err)
+		}
+		existing := map[string]existingIndex{}
+		for _, index := range list {
+			if index.Name != "_id_" {
+				existing[index.Name] = index
+			}
+		}
+
+		for _, spec := range c.Indexes {
+			report.Checked++
+			name := spec.Name()
+			have, ok := existing[name]
+			delete(existing, name)
+
+			switch {
+			case !ok:
+				drift := IndexDrift{Collection: c.Collection, Name: name, Kind: IndexMissing, Want: spec.describe()}
+				if opts.CreateMissing {
+					drift.Fixed, drift.Error = fix(collection.Indexes().CreateOne(ctx, spec.model()))
+				}
+				report.Drift = append(report.Drift, drift)
+			case have.describe() != spec.describe():
+				drift := IndexDrift{Collection: c.Collection, Name: name, Kind: IndexMismatched, Want: spec.describe(), Have: have.describe()}
+				if opts.RebuildMismatched {
+					drift.Fixed, drift.Error = fix(collection.Indexes().DropOne(ctx, name))
+					if drift.Fixed {
+						drift.Fixed, drift.Error = fix(collection.Indexes().CreateOne(ctx, spec.model()))
+					}
+				}
+				report.Drift = append(report.Drift, drift)
+			}
+		}
+
+		for name, have := range existing {
+			drift := IndexDrift{Collection: c.Collection, Name: name, Kind: IndexExtra, Have: have.describe()}
+			if opts.DropExtra {
+				drift.Fixed, drift.Error = fix(collection.Indexes().DropOne(ctx, name))
+			}
+			report.Drift = append(report.Drift, drift)
+		}
+	}
+	return report, nil
+}
+
+// listIndexes returns collection's indexes; none if it does not exist yet.
+func listIndexes(ctx context.Context, collection *mongo.Collection) ([]existingIndex, error) {
+	cursor, err := collection.Indexes().List(ctx)
+	var commandErr mongo.CommandError
+	if errors.As(err, &commandErr) && commandErr.Code == namespaceNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var list []existingIndex
+	if err := cursor.All(ctx, &list); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// fix turns the result of an index operation into IndexDrift's Fixed and
+// Error.
+func fix[T any](_ T, err error) (bool, string) {
+	if err != nil {
+		return false, err.Error()
+	}
+	return true, ""
+}
+
+// ensureIndexes reconciles the indexes at startup as INDEXES_ON_STARTUP
+// says: "create" (the default) creates missing ones, "check" only reports
+// and "off" skips the check. Drift is logged; with INDEXES_FAIL_FAST=true
+// any missing or mismatched index left is an error.
+func ensureIndexes() error {
+	mode := os.Getenv("INDEXES_ON_STARTUP")
+	if mode == "" {
+		mode = "create"
+	}
+	if mode == "off" {
+		return nil
+	}
+	if mode != "create" && mode != "check" {
+		return fmt.Errorf("unknown INDEXES_ON_STARTUP %q", mode)
+	}
+	failFast := os.Getenv("INDEXES_FAIL_FAST") == "true"
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	report, err := ReconcileIndexes(ctx, DB, IndexOptions{CreateMissing: mode == "create"})
+	if err != nil {
+		if failFast {
+			return fmt.Errorf("failed to check indexes: %v", err)
+		}
+		log.Printf("Warning: Failed to check indexes: %v", err)
+		return nil
+	}
+
+	for _, d := range report.Drift {
+		switch {
+		case d.Fixed:
+			log.Printf("Indexes: created %s.%s", d.Collection, d.Name)
+		case d.Error != "":
+			log.Printf("Warning: index %s.%s is %s and could not be fixed: %s", d.Collection, d.Name, d.Kind, d.Error)
+		case d.Kind == IndexMismatched:
+			log.Printf("Warning: index %s.%s is %s: want %s, have %s", d.Collection, d.Name, d.Kind, d.Want, d.Have)
+		default:
+			log.Printf("Warning: index %s.%s is %s", d.Collection, d.Name, d.Kind)
+		}
+	}
+
+	if left := report.Unresolved(); failFast && len(left) > 0 {
+		return fmt.Errorf("%d indexes do not match their specs; run `go run . indexes` for a report", len(left))
+	}
+	return nil
+}
@@ -13,14 +13,13 @@ import (
 
 	"arjunmal1311/fans_flow_on_chain/backend/ent/model"
 	"arjunmal1311/fans_flow_on_chain/backend/ent/subscription"
-	"arjunmal1311/fans_flow_on_chain/backend/ent/subscriptionmetis"
-	"arjunmal1311/fans_flow_on_chain/backend/ent/subscriptionmoonbeam"
-	"arjunmal1311/fans_flow_on_chain/backend/ent/subscriptionzkevm"
+	"arjunmal1311/fans_flow_on_chain/backend/ent/subscriptionoption"
 	"arjunmal1311/fans_flow_on_chain/backend/ent/user"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 )
 
 // Client is the client that holds all ent builders.
@@ -32,12 +31,8 @@ type Client struct {
 	Model *ModelClient
 	// Subscription is the client for interacting with the Subscription builders.
 	Subscription *SubscriptionClient
-	// SubscriptionMetis is the client for interacting with the SubscriptionMetis builders.
-	SubscriptionMetis *SubscriptionMetisClient
-	// SubscriptionMoonbeam is the client for interacting with the SubscriptionMoonbeam builders.
-	SubscriptionMoonbeam *SubscriptionMoonbeamClient
-	// SubscriptionZkEVM is the client for interacting with the SubscriptionZkEVM builders.
-	SubscriptionZkEVM *SubscriptionZkEVMClient
+	// SubscriptionOption is the client for interacting with the SubscriptionOption builders.
+	SubscriptionOption *SubscriptionOptionClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 }
@@ -53,9 +48,7 @@ func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.Model = NewModelClient(c.config)
 	c.Subscription = NewSubscriptionClient(c.config)
-	c.SubscriptionMetis = NewSubscriptionMetisClient(c.config)
-	c.SubscriptionMoonbeam = NewSubscriptionMoonbeamClient(c.config)
-	c.SubscriptionZkEVM = NewSubscriptionZkEVMClient(c.config)
+	c.SubscriptionOption = NewSubscriptionOptionClient(c.config)
 	c.User = NewUserClient(c.config)
 }
 
@@ -147,14 +140,12 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:                  ctx,
-		config:               cfg,
-		Model:                NewModelClient(cfg),
-		Subscription:         NewSubscriptionClient(cfg),
-		SubscriptionMetis:    NewSubscriptionMetisClient(cfg),
-		SubscriptionMoonbeam: NewSubscriptionMoonbeamClient(cfg),
-		SubscriptionZkEVM:    NewSubscriptionZkEVMClient(cfg),
-		User:                 NewUserClient(cfg),
+		ctx:                ctx,
+		config:             cfg,
+		Model:              NewModelClient(cfg),
+		Subscription:       NewSubscriptionClient(cfg),
+		SubscriptionOption: NewSubscriptionOptionClient(cfg),
+		User:               NewUserClient(cfg),
 	}, nil
 }
 
@@ -172,14 +163,12 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:                  ctx,
-		config:               cfg,
-		Model:                NewModelClient(cfg),
-		Subscription:         NewSubscriptionClient(cfg),
-		SubscriptionMetis:    NewSubscriptionMetisClient(cfg),
-		SubscriptionMoonbeam: NewSubscriptionMoonbeamClient(cfg),
-		SubscriptionZkEVM:    NewSubscriptionZkEVMClient(cfg),
-		User:                 NewUserClient(cfg),
+		ctx:                ctx,
+		config:             cfg,
+		Model:              NewModelClient(cfg),
+		Subscription:       NewSubscriptionClient(cfg),
+		SubscriptionOption: NewSubscriptionOptionClient(cfg),
+		User:               NewUserClient(cfg),
 	}, nil
 }
 
@@ -208,23 +197,19 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	for _, n := range []interface{ Use(...Hook) }{
-		c.Model, c.Subscription, c.SubscriptionMetis, c.SubscriptionMoonbeam,
-		c.SubscriptionZkEVM, c.User,
-	} {
-		n.Use(hooks...)
-	}
+	c.Model.Use(hooks...)
+	c.Subscription.Use(hooks...)
+	c.SubscriptionOption.Use(hooks...)
+	c.User.Use(hooks...)
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Model, c.Subscription, c.SubscriptionMetis, c.SubscriptionMoonbeam,
-		c.SubscriptionZkEVM, c.User,
-	} {
-		n.Intercept(interceptors...)
-	}
+	c.Model.Intercept(interceptors...)
+	c.Subscription.Intercept(interceptors...)
+	c.SubscriptionOption.Intercept(interceptors...)
+	c.User.Intercept(interceptors...)
 }
 
 // Mutate implements the ent.Mutator interface.
@@ -234,12 +219,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Model.mutate(ctx, m)
 	case *SubscriptionMutation:
 		return c.Subscription.mutate(ctx, m)
-	case *SubscriptionMetisMutation:
-		return c.SubscriptionMetis.mutate(ctx, m)
-	case *SubscriptionMoonbeamMutation:
-		return c.SubscriptionMoonbeam.mutate(ctx, m)
-	case *SubscriptionZkEVMMutation:
-		return c.SubscriptionZkEVM.mutate(ctx, m)
+	case *SubscriptionOptionMutation:
+		return c.SubscriptionOption.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
 	default:
@@ -308,7 +289,7 @@ func (c *ModelClient) UpdateOne(m *Model) *ModelUpdateOne {
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ModelClient) UpdateOneID(id int) *ModelUpdateOne {
+func (c *ModelClient) UpdateOneID(id string) *ModelUpdateOne {
 	mutation := newModelMutation(c.config, OpUpdateOne, withModelID(id))
 	return &ModelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
@@ -325,7 +306,7 @@ func (c *ModelClient) DeleteOne(m *Model) *ModelDeleteOne {
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ModelClient) DeleteOneID(id int) *ModelDeleteOne {
+func (c *ModelClient) DeleteOneID(id string) *ModelDeleteOne {
 	builder := c.Delete().Where(model.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
@@ -342,12 +323,12 @@ func (c *ModelClient) Query() *ModelQuery {
 }
 
 // Get returns a Model entity by its id.
-func (c *ModelClient) Get(ctx context.Context, id int) (*Model, error) {
+func (c *ModelClient) Get(ctx context.Context, id string) (*Model, error) {
 	return c.Query().Where(model.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ModelClient) GetX(ctx context.Context, id int) *Model {
+func (c *ModelClient) GetX(ctx context.Context, id string) *Model {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -355,6 +336,38 @@ func (c *ModelClient) GetX(ctx context.Context, id int) *Model {
 	return obj
 }
 
+// QueryOptions queries the options edge of a Model.
+func (c *ModelClient) QueryOptions(m *Model) *SubscriptionOptionQuery {
+	query := (&SubscriptionOptionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(model.Table, model.FieldID, id),
+			sqlgraph.To(subscriptionoption.Table, subscriptionoption.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, model.OptionsTable, model.OptionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySubscriptions queries the subscriptions edge of a Model.
+func (c *ModelClient) QuerySubscriptions(m *Model) *SubscriptionQuery {
+	query := (&SubscriptionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(model.Table, model.FieldID, id),
+			sqlgraph.To(subscription.Table, subscription.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, model.SubscriptionsTable, model.SubscriptionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *ModelClient) Hooks() []Hook {
 	return c.hooks.Model
@@ -441,7 +454,7 @@ func (c *SubscriptionClient) UpdateOne(s *Subscription) *SubscriptionUpdateOne {
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *SubscriptionClient) UpdateOneID(id int) *SubscriptionUpdateOne {
+func (c *SubscriptionClient) UpdateOneID(id string) *SubscriptionUpdateOne {
 	mutation := newSubscriptionMutation(c.config, OpUpdateOne, withSubscriptionID(id))
 	return &SubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
@@ -458,7 +471,7 @@ func (c *SubscriptionClient) DeleteOne(s *Subscription) *SubscriptionDeleteOne {
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *SubscriptionClient) DeleteOneID(id int) *SubscriptionDeleteOne {
+func (c *SubscriptionClient) DeleteOneID(id string) *SubscriptionDeleteOne {
 	builder := c.Delete().Where(subscription.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
@@ -475,12 +488,12 @@ func (c *SubscriptionClient) Query() *SubscriptionQuery {
 }
 
 // Get returns a Subscription entity by its id.
-func (c *SubscriptionClient) Get(ctx context.Context, id int) (*Subscription, error) {
+func (c *SubscriptionClient) Get(ctx context.Context, id string) (*Subscription, error) {
 	return c.Query().Where(subscription.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *SubscriptionClient) GetX(ctx context.Context, id int) *Subscription {
+func (c *SubscriptionClient) GetX(ctx context.Context, id string) *Subscription {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -488,6 +501,38 @@ func (c *SubscriptionClient) GetX(ctx context.Context, id int) *Subscription {
 	return obj
 }
 
+// QueryUser queries the user edge of a Subscription.
+func (c *SubscriptionClient) QueryUser(s *Subscription) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(subscription.Table, subscription.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, subscription.UserTable, subscription.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryModel queries the model edge of a Subscription.
+func (c *SubscriptionClient) QueryModel(s *Subscription) *ModelQuery {
+	query := (&ModelClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(subscription.Table, subscription.FieldID, id),
+			sqlgraph.To(model.Table, model.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, subscription.ModelTable, subscription.ModelColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *SubscriptionClient) Hooks() []Hook {
 	return c.hooks.Subscription
@@ -513,240 +558,107 @@ func (c *SubscriptionClient) mutate(ctx context.Context, m *SubscriptionMutation
 	}
 }
 
-// SubscriptionMetisClient is a client for the SubscriptionMetis schema.
-type SubscriptionMetisClient struct {
-	config
-}
-
-// NewSubscriptionMetisClient returns a client for the SubscriptionMetis from the given config.
-func NewSubscriptionMetisClient(c config) *SubscriptionMetisClient {
-	return &SubscriptionMetisClient{config: c}
-}
-
-// Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `subscriptionmetis.Hooks(f(g(h())))`.
-func (c *SubscriptionMetisClient) Use(hooks ...Hook) {
-	c.hooks.SubscriptionMetis = append(c.hooks.SubscriptionMetis, hooks...)
-}
-
-// Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `subscriptionmetis.Intercept(f(g(h())))`.
-func (c *SubscriptionMetisClient) Intercept(interceptors ...Interceptor) {
-	c.inters.SubscriptionMetis = append(c.inters.SubscriptionMetis, interceptors...)
-}
-
-// Create returns a builder for creating a SubscriptionMetis entity.
-func (c *SubscriptionMetisClient) Create() *SubscriptionMetisCreate {
-	mutation := newSubscriptionMetisMutation(c.config, OpCreate)
-	return &SubscriptionMetisCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
-}
-
-// CreateBulk returns a builder for creating a bulk of SubscriptionMetis entities.
-func (c *SubscriptionMetisClient) CreateBulk(builders ...*SubscriptionMetisCreate) *SubscriptionMetisCreateBulk {
-	return &SubscriptionMetisCreateBulk{config: c.config, builders: builders}
-}
-
-// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
-// a builder and applies setFunc on it.
-func (c *SubscriptionMetisClient) MapCreateBulk(slice any, setFunc func(*SubscriptionMetisCreate, int)) *SubscriptionMetisCreateBulk {
-	rv := reflect.ValueOf(slice)
-	if rv.Kind() != reflect.Slice {
-		return &SubscriptionMetisCreateBulk{err: fmt.Errorf("calling to SubscriptionMetisClient.MapCreateBulk with wrong type %T, need slice", slice)}
-	}
-	builders := make([]*SubscriptionMetisCreate, rv.Len())
-	for i := 0; i < rv.Len(); i++ {
-		builders[i] = c.Create()
-		setFunc(builders[i], i)
-	}
-	return &SubscriptionMetisCreateBulk{config: c.config, builders: builders}
-}
-
-// Update returns an update builder for SubscriptionMetis.
-func (c *SubscriptionMetisClient) Update() *SubscriptionMetisUpdate {
-	mutation := newSubscriptionMetisMutation(c.config, OpUpdate)
-	return &SubscriptionMetisUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
-}
-
-// UpdateOne returns an update builder for the given entity.
-func (c *SubscriptionMetisClient) UpdateOne(sm *SubscriptionMetis) *SubscriptionMetisUpdateOne {
-	mutation := newSubscriptionMetisMutation(c.config, OpUpdateOne, withSubscriptionMetis(sm))
-	return &SubscriptionMetisUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
-}
-
-// UpdateOneID returns an update builder for the given id.
-func (c *SubscriptionMetisClient) UpdateOneID(id int) *SubscriptionMetisUpdateOne {
-	mutation := newSubscriptionMetisMutation(c.config, OpUpdateOne, withSubscriptionMetisID(id))
-	return &SubscriptionMetisUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
-}
-
-// Delete returns a delete builder for SubscriptionMetis.
-func (c *SubscriptionMetisClient) Delete() *SubscriptionMetisDelete {
-	mutation := newSubscriptionMetisMutation(c.config, OpDelete)
-	return &SubscriptionMetisDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
-}
-
-// DeleteOne returns a builder for deleting the given entity.
-func (c *SubscriptionMetisClient) DeleteOne(sm *SubscriptionMetis) *SubscriptionMetisDeleteOne {
-	return c.DeleteOneID(sm.ID)
-}
-
-// DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *SubscriptionMetisClient) DeleteOneID(id int) *SubscriptionMetisDeleteOne {
-	builder := c.Delete().Where(subscriptionmetis.ID(id))
-	builder.mutation.id = &id
-	builder.mutation.op = OpDeleteOne
-	return &SubscriptionMetisDeleteOne{builder}
-}
-
-// Query returns a query builder for SubscriptionMetis.
-func (c *SubscriptionMetisClient) Query() *SubscriptionMetisQuery {
-	return &SubscriptionMetisQuery{
-		config: c.config,
-		ctx:    &QueryContext{Type: TypeSubscriptionMetis},
-		inters: c.Interceptors(),
-	}
-}
-
-// Get returns a SubscriptionMetis entity by its id.
-func (c *SubscriptionMetisClient) Get(ctx context.Context, id int) (*SubscriptionMetis, error) {
-	return c.Query().Where(subscriptionmetis.ID(id)).Only(ctx)
-}
-
-// GetX is like Get, but panics if an error occurs.
-func (c *SubscriptionMetisClient) GetX(ctx context.Context, id int) *SubscriptionMetis {
-	obj, err := c.Get(ctx, id)
-	if err != nil {
-		panic(err)
-	}
-	return obj
-}
-
-// Hooks returns the client hooks.
-func (c *SubscriptionMetisClient) Hooks() []Hook {
-	return c.hooks.SubscriptionMetis
-}
-
-// Interceptors returns the client interceptors.
-func (c *SubscriptionMetisClient) Interceptors() []Interceptor {
-	return c.inters.SubscriptionMetis
-}
-
-func (c *SubscriptionMetisClient) mutate(ctx context.Context, m *SubscriptionMetisMutation) (Value, error) {
-	switch m.Op() {
-	case OpCreate:
-		return (&SubscriptionMetisCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
-	case OpUpdate:
-		return (&SubscriptionMetisUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
-	case OpUpdateOne:
-		return (&SubscriptionMetisUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
-	case OpDelete, OpDeleteOne:
-		return (&SubscriptionMetisDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
-	default:
-		return nil, fmt.Errorf("ent: unknown SubscriptionMetis mutation op: %q", m.Op())
-	}
-}
-
-// SubscriptionMoonbeamClient is a client for the SubscriptionMoonbeam schema.
-type SubscriptionMoonbeamClient struct {
+// SubscriptionOptionClient is a client for the SubscriptionOption schema.
+type SubscriptionOptionClient struct {
 	config
 }
 
-// NewSubscriptionMoonbeamClient returns a client for the SubscriptionMoonbeam from the given config.
-func NewSubscriptionMoonbeamClient(c config) *SubscriptionMoonbeamClient {
-	return &SubscriptionMoonbeamClient{config: c}
+// NewSubscriptionOptionClient returns a client for the SubscriptionOption from the given config.
+func NewSubscriptionOptionClient(c config) *SubscriptionOptionClient {
+	return &SubscriptionOptionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `subscriptionmoonbeam.Hooks(f(g(h())))`.
-func (c *SubscriptionMoonbeamClient) Use(hooks ...Hook) {
-	c.hooks.SubscriptionMoonbeam = append(c.hooks.SubscriptionMoonbeam, hooks...)
+// A call to `Use(f, g, h)` equals to `subscriptionoption.Hooks(f(g(h())))`.
+func (c *SubscriptionOptionClient) Use(hooks ...Hook) {
+	c.hooks.SubscriptionOption = append(c.hooks.SubscriptionOption, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `subscriptionmoonbeam.Intercept(f(g(h())))`.
-func (c *SubscriptionMoonbeamClient) Intercept(interceptors ...Interceptor) {
-	c.inters.SubscriptionMoonbeam = append(c.inters.SubscriptionMoonbeam, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `subscriptionoption.Intercept(f(g(h())))`.
+func (c *SubscriptionOptionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SubscriptionOption = append(c.inters.SubscriptionOption, interceptors...)
 }
 
-// Create returns a builder for creating a SubscriptionMoonbeam entity.
-func (c *SubscriptionMoonbeamClient) Create() *SubscriptionMoonbeamCreate {
-	mutation := newSubscriptionMoonbeamMutation(c.config, OpCreate)
-	return &SubscriptionMoonbeamCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a SubscriptionOption entity.
+func (c *SubscriptionOptionClient) Create() *SubscriptionOptionCreate {
+	mutation := newSubscriptionOptionMutation(c.config, OpCreate)
+	return &SubscriptionOptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of SubscriptionMoonbeam entities.
-func (c *SubscriptionMoonbeamClient) CreateBulk(builders ...*SubscriptionMoonbeamCreate) *SubscriptionMoonbeamCreateBulk {
-	return &SubscriptionMoonbeamCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of SubscriptionOption entities.
+func (c *SubscriptionOptionClient) CreateBulk(builders ...*SubscriptionOptionCreate) *SubscriptionOptionCreateBulk {
+	return &SubscriptionOptionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *SubscriptionMoonbeamClient) MapCreateBulk(slice any, setFunc func(*SubscriptionMoonbeamCreate, int)) *SubscriptionMoonbeamCreateBulk {
+func (c *SubscriptionOptionClient) MapCreateBulk(slice any, setFunc func(*SubscriptionOptionCreate, int)) *SubscriptionOptionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &SubscriptionMoonbeamCreateBulk{err: fmt.Errorf("calling to SubscriptionMoonbeamClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &SubscriptionOptionCreateBulk{err: fmt.Errorf("calling to SubscriptionOptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*SubscriptionMoonbeamCreate, rv.Len())
+	builders := make([]*SubscriptionOptionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &SubscriptionMoonbeamCreateBulk{config: c.config, builders: builders}
+	return &SubscriptionOptionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for SubscriptionMoonbeam.
-func (c *SubscriptionMoonbeamClient) Update() *SubscriptionMoonbeamUpdate {
-	mutation := newSubscriptionMoonbeamMutation(c.config, OpUpdate)
-	return &SubscriptionMoonbeamUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for SubscriptionOption.
+func (c *SubscriptionOptionClient) Update() *SubscriptionOptionUpdate {
+	mutation := newSubscriptionOptionMutation(c.config, OpUpdate)
+	return &SubscriptionOptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *SubscriptionMoonbeamClient) UpdateOne(sm *SubscriptionMoonbeam) *SubscriptionMoonbeamUpdateOne {
-	mutation := newSubscriptionMoonbeamMutation(c.config, OpUpdateOne, withSubscriptionMoonbeam(sm))
-	return &SubscriptionMoonbeamUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SubscriptionOptionClient) UpdateOne(so *SubscriptionOption) *SubscriptionOptionUpdateOne {
+	mutation := newSubscriptionOptionMutation(c.config, OpUpdateOne, withSubscriptionOption(so))
+	return &SubscriptionOptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *SubscriptionMoonbeamClient) UpdateOneID(id int) *SubscriptionMoonbeamUpdateOne {
-	mutation := newSubscriptionMoonbeamMutation(c.config, OpUpdateOne, withSubscriptionMoonbeamID(id))
-	return &SubscriptionMoonbeamUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SubscriptionOptionClient) UpdateOneID(id string) *SubscriptionOptionUpdateOne {
+	mutation := newSubscriptionOptionMutation(c.config, OpUpdateOne, withSubscriptionOptionID(id))
+	return &SubscriptionOptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for SubscriptionMoonbeam.
-func (c *SubscriptionMoonbeamClient) Delete() *SubscriptionMoonbeamDelete {
-	mutation := newSubscriptionMoonbeamMutation(c.config, OpDelete)
-	return &SubscriptionMoonbeamDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for SubscriptionOption.
+func (c *SubscriptionOptionClient) Delete() *SubscriptionOptionDelete {
+	mutation := newSubscriptionOptionMutation(c.config, OpDelete)
+	return &SubscriptionOptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *SubscriptionMoonbeamClient) DeleteOne(sm *SubscriptionMoonbeam) *SubscriptionMoonbeamDeleteOne {
-	return c.DeleteOneID(sm.ID)
+func (c *SubscriptionOptionClient) DeleteOne(so *SubscriptionOption) *SubscriptionOptionDeleteOne {
+	return c.DeleteOneID(so.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *SubscriptionMoonbeamClient) DeleteOneID(id int) *SubscriptionMoonbeamDeleteOne {
-	builder := c.Delete().Where(subscriptionmoonbeam.ID(id))
+func (c *SubscriptionOptionClient) DeleteOneID(id string) *SubscriptionOptionDeleteOne {
+	builder := c.Delete().Where(subscriptionoption.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &SubscriptionMoonbeamDeleteOne{builder}
+	return &SubscriptionOptionDeleteOne{builder}
 }
 
-// Query returns a query builder for SubscriptionMoonbeam.
-func (c *SubscriptionMoonbeamClient) Query() *SubscriptionMoonbeamQuery {
-	return &SubscriptionMoonbeamQuery{
+// Query returns a query builder for SubscriptionOption.
+func (c *SubscriptionOptionClient) Query() *SubscriptionOptionQuery {
+	return &SubscriptionOptionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeSubscriptionMoonbeam},
+		ctx:    &QueryContext{Type: TypeSubscriptionOption},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a SubscriptionMoonbeam entity by its id.
-func (c *SubscriptionMoonbeamClient) Get(ctx context.Context, id int) (*SubscriptionMoonbeam, error) {
-	return c.Query().Where(subscriptionmoonbeam.ID(id)).Only(ctx)
+// Get returns a SubscriptionOption entity by its id.
+func (c *SubscriptionOptionClient) Get(ctx context.Context, id string) (*SubscriptionOption, error) {
+	return c.Query().Where(subscriptionoption.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *SubscriptionMoonbeamClient) GetX(ctx context.Context, id int) *SubscriptionMoonbeam {
+func (c *SubscriptionOptionClient) GetX(ctx context.Context, id string) *SubscriptionOption {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -754,161 +666,44 @@ func (c *SubscriptionMoonbeamClient) GetX(ctx context.Context, id int) *Subscrip
 	return obj
 }
 
-// Hooks returns the client hooks.
-func (c *SubscriptionMoonbeamClient) Hooks() []Hook {
-	return c.hooks.SubscriptionMoonbeam
-}
-
-// Interceptors returns the client interceptors.
-func (c *SubscriptionMoonbeamClient) Interceptors() []Interceptor {
-	return c.inters.SubscriptionMoonbeam
-}
-
-func (c *SubscriptionMoonbeamClient) mutate(ctx context.Context, m *SubscriptionMoonbeamMutation) (Value, error) {
-	switch m.Op() {
-	case OpCreate:
-		return (&SubscriptionMoonbeamCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
-	case OpUpdate:
-		return (&SubscriptionMoonbeamUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
-	case OpUpdateOne:
-		return (&SubscriptionMoonbeamUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
-	case OpDelete, OpDeleteOne:
-		return (&SubscriptionMoonbeamDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
-	default:
-		return nil, fmt.Errorf("ent: unknown SubscriptionMoonbeam mutation op: %q", m.Op())
+// QueryModel queries the model edge of a SubscriptionOption.
+func (c *SubscriptionOptionClient) QueryModel(so *SubscriptionOption) *ModelQuery {
+	query := (&ModelClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := so.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(subscriptionoption.Table, subscriptionoption.FieldID, id),
+			sqlgraph.To(model.Table, model.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, subscriptionoption.ModelTable, subscriptionoption.ModelColumn),
+		)
+		fromV = sqlgraph.Neighbors(so.driver.Dialect(), step)
+		return fromV, nil
 	}
-}
-
-// SubscriptionZkEVMClient is a client for the SubscriptionZkEVM schema.
-type SubscriptionZkEVMClient struct {
-	config
-}
-
-// NewSubscriptionZkEVMClient returns a client for the SubscriptionZkEVM from the given config.
-func NewSubscriptionZkEVMClient(c config) *SubscriptionZkEVMClient {
-	return &SubscriptionZkEVMClient{config: c}
-}
-
-// Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `subscriptionzkevm.Hooks(f(g(h())))`.
-func (c *SubscriptionZkEVMClient) Use(hooks ...Hook) {
-	c.hooks.SubscriptionZkEVM = append(c.hooks.SubscriptionZkEVM, hooks...)
-}
-
-// Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `subscriptionzkevm.Intercept(f(g(h())))`.
-func (c *SubscriptionZkEVMClient) Intercept(interceptors ...Interceptor) {
-	c.inters.SubscriptionZkEVM = append(c.inters.SubscriptionZkEVM, interceptors...)
-}
-
-// Create returns a builder for creating a SubscriptionZkEVM entity.
-func (c *SubscriptionZkEVMClient) Create() *SubscriptionZkEVMCreate {
-	mutation := newSubscriptionZkEVMMutation(c.config, OpCreate)
-	return &SubscriptionZkEVMCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
-}
-
-// CreateBulk returns a builder for creating a bulk of SubscriptionZkEVM entities.
-func (c *SubscriptionZkEVMClient) CreateBulk(builders ...*SubscriptionZkEVMCreate) *SubscriptionZkEVMCreateBulk {
-	return &SubscriptionZkEVMCreateBulk{config: c.config, builders: builders}
-}
-
-// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
-// a builder and applies setFunc on it.
-func (c *SubscriptionZkEVMClient) MapCreateBulk(slice any, setFunc func(*SubscriptionZkEVMCreate, int)) *SubscriptionZkEVMCreateBulk {
-	rv := reflect.ValueOf(slice)
-	if rv.Kind() != reflect.Slice {
-		return &SubscriptionZkEVMCreateBulk{err: fmt.Errorf("calling to SubscriptionZkEVMClient.MapCreateBulk with wrong type %T, need slice", slice)}
-	}
-	builders := make([]*SubscriptionZkEVMCreate, rv.Len())
-	for i := 0; i < rv.Len(); i++ {
-		builders[i] = c.Create()
-		setFunc(builders[i], i)
-	}
-	return &SubscriptionZkEVMCreateBulk{config: c.config, builders: builders}
-}
-
-// Update returns an update builder for SubscriptionZkEVM.
-func (c *SubscriptionZkEVMClient) Update() *SubscriptionZkEVMUpdate {
-	mutation := newSubscriptionZkEVMMutation(c.config, OpUpdate)
-	return &SubscriptionZkEVMUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
-}
-
-// UpdateOne returns an update builder for the given entity.
-func (c *SubscriptionZkEVMClient) UpdateOne(sze *SubscriptionZkEVM) *SubscriptionZkEVMUpdateOne {
-	mutation := newSubscriptionZkEVMMutation(c.config, OpUpdateOne, withSubscriptionZkEVM(sze))
-	return &SubscriptionZkEVMUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
-}
-
-// UpdateOneID returns an update builder for the given id.
-func (c *SubscriptionZkEVMClient) UpdateOneID(id int) *SubscriptionZkEVMUpdateOne {
-	mutation := newSubscriptionZkEVMMutation(c.config, OpUpdateOne, withSubscriptionZkEVMID(id))
-	return &SubscriptionZkEVMUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
-}
-
-// Delete returns a delete builder for SubscriptionZkEVM.
-func (c *SubscriptionZkEVMClient) Delete() *SubscriptionZkEVMDelete {
-	mutation := newSubscriptionZkEVMMutation(c.config, OpDelete)
-	return &SubscriptionZkEVMDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
-}
-
-// DeleteOne returns a builder for deleting the given entity.
-func (c *SubscriptionZkEVMClient) DeleteOne(sze *SubscriptionZkEVM) *SubscriptionZkEVMDeleteOne {
-	return c.DeleteOneID(sze.ID)
-}
-
-// DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *SubscriptionZkEVMClient) DeleteOneID(id int) *SubscriptionZkEVMDeleteOne {
-	builder := c.Delete().Where(subscriptionzkevm.ID(id))
-	builder.mutation.id = &id
-	builder.mutation.op = OpDeleteOne
-	return &SubscriptionZkEVMDeleteOne{builder}
-}
-
-// Query returns a query builder for SubscriptionZkEVM.
-func (c *SubscriptionZkEVMClient) Query() *SubscriptionZkEVMQuery {
-	return &SubscriptionZkEVMQuery{
-		config: c.config,
-		ctx:    &QueryContext{Type: TypeSubscriptionZkEVM},
-		inters: c.Interceptors(),
-	}
-}
-
-// Get returns a SubscriptionZkEVM entity by its id.
-func (c *SubscriptionZkEVMClient) Get(ctx context.Context, id int) (*SubscriptionZkEVM, error) {
-	return c.Query().Where(subscriptionzkevm.ID(id)).Only(ctx)
-}
-
-// GetX is like Get, but panics if an error occurs.
-func (c *SubscriptionZkEVMClient) GetX(ctx context.Context, id int) *SubscriptionZkEVM {
-	obj, err := c.Get(ctx, id)
-	if err != nil {
-		panic(err)
-	}
-	return obj
+	return query
 }
 
 // Hooks returns the client hooks.
-func (c *SubscriptionZkEVMClient) Hooks() []Hook {
-	return c.hooks.SubscriptionZkEVM
+func (c *SubscriptionOptionClient) Hooks() []Hook {
+	return c.hooks.SubscriptionOption
 }
 
 // Interceptors returns the client interceptors.
-func (c *SubscriptionZkEVMClient) Interceptors() []Interceptor {
-	return c.inters.SubscriptionZkEVM
+func (c *SubscriptionOptionClient) Interceptors() []Interceptor {
+	return c.inters.SubscriptionOption
 }
 
-func (c *SubscriptionZkEVMClient) mutate(ctx context.Context, m *SubscriptionZkEVMMutation) (Value, error) {
+func (c *SubscriptionOptionClient) mutate(ctx context.Context, m *SubscriptionOptionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&SubscriptionZkEVMCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SubscriptionOptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&SubscriptionZkEVMUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SubscriptionOptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&SubscriptionZkEVMUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SubscriptionOptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&SubscriptionZkEVMDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SubscriptionOptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown SubscriptionZkEVM mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown SubscriptionOption mutation op: %q", m.Op())
 	}
 }
 
@@ -973,7 +768,7 @@ func (c *UserClient) UpdateOne(u *User) *UserUpdateOne {
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *UserClient) UpdateOneID(id int) *UserUpdateOne {
+func (c *UserClient) UpdateOneID(id string) *UserUpdateOne {
 	mutation := newUserMutation(c.config, OpUpdateOne, withUserID(id))
 	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
@@ -990,7 +785,7 @@ func (c *UserClient) DeleteOne(u *User) *UserDeleteOne {
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *UserClient) DeleteOneID(id int) *UserDeleteOne {
+func (c *UserClient) DeleteOneID(id string) *UserDeleteOne {
 	builder := c.Delete().Where(user.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
@@ -1007,12 +802,12 @@ func (c *UserClient) Query() *UserQuery {
 }
 
 // Get returns a User entity by its id.
-func (c *UserClient) Get(ctx context.Context, id int) (*User, error) {
+func (c *UserClient) Get(ctx context.Context, id string) (*User, error) {
 	return c.Query().Where(user.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *UserClient) GetX(ctx context.Context, id int) *User {
+func (c *UserClient) GetX(ctx context.Context, id string) *User {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1020,6 +815,22 @@ func (c *UserClient) GetX(ctx context.Context, id int) *User {
 	return obj
 }
 
+// QuerySubscriptions queries the subscriptions edge of a User.
+func (c *UserClient) QuerySubscriptions(u *User) *SubscriptionQuery {
+	query := (&SubscriptionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(subscription.Table, subscription.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.SubscriptionsTable, user.SubscriptionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
 	return c.hooks.User
@@ -1048,11 +859,9 @@ func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error)
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Model, Subscription, SubscriptionMetis, SubscriptionMoonbeam, SubscriptionZkEVM,
-		User []ent.Hook
+		Model, Subscription, SubscriptionOption, User []ent.Hook
 	}
 	inters struct {
-		Model, Subscription, SubscriptionMetis, SubscriptionMoonbeam, SubscriptionZkEVM,
-		User []ent.Interceptor
+		Model, Subscription, SubscriptionOption, User []ent.Interceptor
 	}
 )
@@ -5,9 +5,7 @@ package ent
 import (
 	"arjunmal1311/fans_flow_on_chain/backend/ent/model"
 	"arjunmal1311/fans_flow_on_chain/backend/ent/subscription"
-	"arjunmal1311/fans_flow_on_chain/backend/ent/subscriptionmetis"
-	"arjunmal1311/fans_flow_on_chain/backend/ent/subscriptionmoonbeam"
-	"arjunmal1311/fans_flow_on_chain/backend/ent/subscriptionzkevm"
+	"arjunmal1311/fans_flow_on_chain/backend/ent/subscriptionoption"
 	"arjunmal1311/fans_flow_on_chain/backend/ent/user"
 	"context"
 	"errors"
@@ -78,12 +76,10 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			model.Table:                model.ValidColumn,
-			subscription.Table:         subscription.ValidColumn,
-			subscriptionmetis.Table:    subscriptionmetis.ValidColumn,
-			subscriptionmoonbeam.Table: subscriptionmoonbeam.ValidColumn,
-			subscriptionzkevm.Table:    subscriptionzkevm.ValidColumn,
-			user.Table:                 user.ValidColumn,
+			model.Table:              model.ValidColumn,
+			subscription.Table:       subscription.ValidColumn,
+			subscriptionoption.Table: subscriptionoption.ValidColumn,
+			user.Table:               user.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
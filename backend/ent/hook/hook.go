@@ -32,40 +32,16 @@ func (f SubscriptionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SubscriptionMutation", m)
 }
 
-// The SubscriptionMetisFunc type is an adapter to allow the use of ordinary
-// function as SubscriptionMetis mutator.
-type SubscriptionMetisFunc func(context.Context, *ent.SubscriptionMetisMutation) (ent.Value, error)
+// The SubscriptionOptionFunc type is an adapter to allow the use of ordinary
+// function as SubscriptionOption mutator.
+type SubscriptionOptionFunc func(context.Context, *ent.SubscriptionOptionMutation) (ent.Value, error)
 
 // Mutate calls f(ctx, m).
-func (f SubscriptionMetisFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
-	if mv, ok := m.(*ent.SubscriptionMetisMutation); ok {
+func (f SubscriptionOptionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SubscriptionOptionMutation); ok {
 		return f(ctx, mv)
 	}
-	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SubscriptionMetisMutation", m)
-}
-
-// The SubscriptionMoonbeamFunc type is an adapter to allow the use of ordinary
-// function as SubscriptionMoonbeam mutator.
-type SubscriptionMoonbeamFunc func(context.Context, *ent.SubscriptionMoonbeamMutation) (ent.Value, error)
-
-// Mutate calls f(ctx, m).
-func (f SubscriptionMoonbeamFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
-	if mv, ok := m.(*ent.SubscriptionMoonbeamMutation); ok {
-		return f(ctx, mv)
-	}
-	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SubscriptionMoonbeamMutation", m)
-}
-
-// The SubscriptionZkEVMFunc type is an adapter to allow the use of ordinary
-// function as SubscriptionZkEVM mutator.
-type SubscriptionZkEVMFunc func(context.Context, *ent.SubscriptionZkEVMMutation) (ent.Value, error)
-
-// Mutate calls f(ctx, m).
-func (f SubscriptionZkEVMFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
-	if mv, ok := m.(*ent.SubscriptionZkEVMMutation); ok {
-		return f(ctx, mv)
-	}
-	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SubscriptionZkEVMMutation", m)
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SubscriptionOptionMutation", m)
 }
 
 // The UserFunc type is an adapter to allow the use of ordinary
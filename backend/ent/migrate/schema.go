@@ -10,57 +10,122 @@ import (
 var (
 	// ModelsColumns holds the columns for the "models" table.
 	ModelsColumns = []*schema.Column{
-		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "id", Type: field.TypeString, Unique: true, Size: 24},
+		{Name: "name", Type: field.TypeString},
+		{Name: "model_id", Type: field.TypeString, Unique: true},
+		{Name: "email", Type: field.TypeString},
+		{Name: "wallet_address", Type: field.TypeString},
+		{Name: "ipfs_url", Type: field.TypeString, Nullable: true},
+		{Name: "openai_token_id", Type: field.TypeString, Nullable: true},
+		{Name: "slug", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "location", Type: field.TypeString, Nullable: true},
+		{Name: "about_me", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "value", Type: field.TypeFloat64, Default: 0},
+		{Name: "views", Type: field.TypeInt64, Default: 0},
+		{Name: "tease", Type: field.TypeInt64, Default: 0},
+		{Name: "posts", Type: field.TypeInt64, Default: 0},
+		{Name: "image_src", Type: field.TypeString, Nullable: true},
+		{Name: "icon_src", Type: field.TypeString, Nullable: true},
 	}
 	// ModelsTable holds the schema information for the "models" table.
 	ModelsTable = &schema.Table{
 		Name:       "models",
 		Columns:    ModelsColumns,
 		PrimaryKey: []*schema.Column{ModelsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "model_wallet_address",
+				Unique:  false,
+				Columns: []*schema.Column{ModelsColumns[4]},
+			},
+		},
 	}
 	// SubscriptionsColumns holds the columns for the "subscriptions" table.
 	SubscriptionsColumns = []*schema.Column{
-		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "id", Type: field.TypeString, Unique: true, Size: 24},
+		{Name: "chain", Type: field.TypeString},
+		{Name: "token_id", Type: field.TypeString},
+		{Name: "tx_hash", Type: field.TypeString, Nullable: true},
+		{Name: "listing_id", Type: field.TypeString, Nullable: true},
+		{Name: "price", Type: field.TypeString, Nullable: true},
+		{Name: "is_listed", Type: field.TypeBool, Default: false},
+		{Name: "starts_at", Type: field.TypeTime, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "expired", Type: field.TypeBool, Default: false},
+		{Name: "held_on_chain", Type: field.TypeBool, Nullable: true},
+		{Name: "reconciled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "subscription_option_id", Type: field.TypeString, Nullable: true},
+		{Name: "model_id", Type: field.TypeString, Size: 24},
+		{Name: "user_id", Type: field.TypeString, Size: 24},
 	}
 	// SubscriptionsTable holds the schema information for the "subscriptions" table.
 	SubscriptionsTable = &schema.Table{
 		Name:       "subscriptions",
 		Columns:    SubscriptionsColumns,
 		PrimaryKey: []*schema.Column{SubscriptionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "subscriptions_models_subscriptions",
+				Columns:    []*schema.Column{SubscriptionsColumns[13]},
+				RefColumns: []*schema.Column{ModelsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "subscriptions_users_subscriptions",
+				Columns:    []*schema.Column{SubscriptionsColumns[14]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "subscription_chain_token_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{SubscriptionsColumns[1], SubscriptionsColumns[2], SubscriptionsColumns[14]},
+			},
+			{
+				Name:    "subscription_chain_is_listed",
+				Unique:  false,
+				Columns: []*schema.Column{SubscriptionsColumns[1], SubscriptionsColumns[6]},
+			},
+			{
+				Name:    "subscription_tx_hash",
+				Unique:  false,
+				Columns: []*schema.Column{SubscriptionsColumns[3]},
+			},
+		},
 	}
-	// SubscriptionMetisColumns holds the columns for the "subscription_metis" table.
-	SubscriptionMetisColumns = []*schema.Column{
-		{Name: "id", Type: field.TypeInt, Increment: true},
+	// SubscriptionOptionsColumns holds the columns for the "subscription_options" table.
+	SubscriptionOptionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Size: 24},
+		{Name: "price", Type: field.TypeString},
+		{Name: "duration", Type: field.TypeInt},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "model_id", Type: field.TypeString, Size: 24},
 	}
-	// SubscriptionMetisTable holds the schema information for the "subscription_metis" table.
-	SubscriptionMetisTable = &schema.Table{
-		Name:       "subscription_metis",
-		Columns:    SubscriptionMetisColumns,
-		PrimaryKey: []*schema.Column{SubscriptionMetisColumns[0]},
-	}
-	// SubscriptionMoonbeamsColumns holds the columns for the "subscription_moonbeams" table.
-	SubscriptionMoonbeamsColumns = []*schema.Column{
-		{Name: "id", Type: field.TypeInt, Increment: true},
-	}
-	// SubscriptionMoonbeamsTable holds the schema information for the "subscription_moonbeams" table.
-	SubscriptionMoonbeamsTable = &schema.Table{
-		Name:       "subscription_moonbeams",
-		Columns:    SubscriptionMoonbeamsColumns,
-		PrimaryKey: []*schema.Column{SubscriptionMoonbeamsColumns[0]},
-	}
-	// SubscriptionZkEvMsColumns holds the columns for the "subscription_zk_ev_ms" table.
-	SubscriptionZkEvMsColumns = []*schema.Column{
-		{Name: "id", Type: field.TypeInt, Increment: true},
-	}
-	// SubscriptionZkEvMsTable holds the schema information for the "subscription_zk_ev_ms" table.
-	SubscriptionZkEvMsTable = &schema.Table{
-		Name:       "subscription_zk_ev_ms",
-		Columns:    SubscriptionZkEvMsColumns,
-		PrimaryKey: []*schema.Column{SubscriptionZkEvMsColumns[0]},
+	// SubscriptionOptionsTable holds the schema information for the "subscription_options" table.
+	SubscriptionOptionsTable = &schema.Table{
+		Name:       "subscription_options",
+		Columns:    SubscriptionOptionsColumns,
+		PrimaryKey: []*schema.Column{SubscriptionOptionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "subscription_options_models_options",
+				Columns:    []*schema.Column{SubscriptionOptionsColumns[5]},
+				RefColumns: []*schema.Column{ModelsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
 	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
-		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "id", Type: field.TypeString, Unique: true, Size: 24},
+		{Name: "username", Type: field.TypeString, Unique: true},
+		{Name: "email", Type: field.TypeString, Unique: true},
+		{Name: "wallet_address", Type: field.TypeString, Unique: true},
+		{Name: "ipfs_url", Type: field.TypeString, Nullable: true},
+		{Name: "openai_token_id", Type: field.TypeString, Nullable: true},
 	}
 	// UsersTable holds the schema information for the "users" table.
 	UsersTable = &schema.Table{
@@ -72,12 +137,13 @@ var (
 	Tables = []*schema.Table{
 		ModelsTable,
 		SubscriptionsTable,
-		SubscriptionMetisTable,
-		SubscriptionMoonbeamsTable,
-		SubscriptionZkEvMsTable,
+		SubscriptionOptionsTable,
 		UsersTable,
 	}
 )
 
 func init() {
+	SubscriptionsTable.ForeignKeys[0].RefTable = ModelsTable
+	SubscriptionsTable.ForeignKeys[1].RefTable = UsersTable
+	SubscriptionOptionsTable.ForeignKeys[0].RefTable = ModelsTable
 }
@@ -13,19 +13,85 @@ import (
 
 // Model is the model entity for the Model schema.
 type Model struct {
-	config
+	config `json:"-"`
 	// ID of the ent.
-	ID           int `json:"id,omitempty"`
+	ID string `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// ModelID holds the value of the "model_id" field.
+	ModelID string `json:"model_id,omitempty"`
+	// Email holds the value of the "email" field.
+	Email string `json:"email,omitempty"`
+	// WalletAddress holds the value of the "wallet_address" field.
+	WalletAddress string `json:"wallet_address,omitempty"`
+	// IpfsURL holds the value of the "ipfs_url" field.
+	IpfsURL string `json:"ipfs_url,omitempty"`
+	// OpenaiTokenID holds the value of the "openai_token_id" field.
+	OpenaiTokenID string `json:"openai_token_id,omitempty"`
+	// Slug holds the value of the "slug" field.
+	Slug *string `json:"slug,omitempty"`
+	// Location holds the value of the "location" field.
+	Location string `json:"location,omitempty"`
+	// AboutMe holds the value of the "about_me" field.
+	AboutMe string `json:"about_me,omitempty"`
+	// Value holds the value of the "value" field.
+	Value float64 `json:"value,omitempty"`
+	// Views holds the value of the "views" field.
+	Views int64 `json:"views,omitempty"`
+	// Tease holds the value of the "tease" field.
+	Tease int64 `json:"tease,omitempty"`
+	// Posts holds the value of the "posts" field.
+	Posts int64 `json:"posts,omitempty"`
+	// ImageSrc holds the value of the "image_src" field.
+	ImageSrc string `json:"image_src,omitempty"`
+	// IconSrc holds the value of the "icon_src" field.
+	IconSrc string `json:"icon_src,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ModelQuery when eager-loading is set.
+	Edges        ModelEdges `json:"edges"`
 	selectValues sql.SelectValues
 }
 
+// ModelEdges holds the relations/edges for other nodes in the graph.
+type ModelEdges struct {
+	// Options holds the value of the options edge.
+	Options []*SubscriptionOption `json:"options,omitempty"`
+	// Subscriptions holds the value of the subscriptions edge.
+	Subscriptions []*Subscription `json:"subscriptions,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// OptionsOrErr returns the Options value or an error if the edge
+// was not loaded in eager-loading.
+func (e ModelEdges) OptionsOrErr() ([]*SubscriptionOption, error) {
+	if e.loadedTypes[0] {
+		return e.Options, nil
+	}
+	return nil, &NotLoadedError{edge: "options"}
+}
+
+// SubscriptionsOrErr returns the Subscriptions value or an error if the edge
+// was not loaded in eager-loading.
+func (e ModelEdges) SubscriptionsOrErr() ([]*Subscription, error) {
+	if e.loadedTypes[1] {
+		return e.Subscriptions, nil
+	}
+	return nil, &NotLoadedError{edge: "subscriptions"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Model) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case model.FieldID:
+		case model.FieldValue:
+			values[i] = new(sql.NullFloat64)
+		case model.FieldViews, model.FieldTease, model.FieldPosts:
 			values[i] = new(sql.NullInt64)
+		case model.FieldID, model.FieldName, model.FieldModelID, model.FieldEmail, model.FieldWalletAddress, model.FieldIpfsURL, model.FieldOpenaiTokenID, model.FieldSlug, model.FieldLocation, model.FieldAboutMe, model.FieldImageSrc, model.FieldIconSrc:
+			values[i] = new(sql.NullString)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -42,11 +108,102 @@ func (m *Model) assignValues(columns []string, values []any) error {
 	for i := range columns {
 		switch columns[i] {
 		case model.FieldID:
-			value, ok := values[i].(*sql.NullInt64)
-			if !ok {
-				return fmt.Errorf("unexpected type %T for field id", value)
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				m.ID = value.String
+			}
+		case model.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				m.Name = value.String
+			}
+		case model.FieldModelID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field model_id", values[i])
+			} else if value.Valid {
+				m.ModelID = value.String
+			}
+		case model.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				m.Email = value.String
+			}
+		case model.FieldWalletAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field wallet_address", values[i])
+			} else if value.Valid {
+				m.WalletAddress = value.String
+			}
+		case model.FieldIpfsURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ipfs_url", values[i])
+			} else if value.Valid {
+				m.IpfsURL = value.String
+			}
+		case model.FieldOpenaiTokenID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field openai_token_id", values[i])
+			} else if value.Valid {
+				m.OpenaiTokenID = value.String
+			}
+		case model.FieldSlug:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field slug", values[i])
+			} else if value.Valid {
+				m.Slug = new(string)
+				*m.Slug = value.String
+			}
+		case model.FieldLocation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field location", values[i])
+			} else if value.Valid {
+				m.Location = value.String
+			}
+		case model.FieldAboutMe:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field about_me", values[i])
+			} else if value.Valid {
+				m.AboutMe = value.String
+			}
+		case model.FieldValue:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field value", values[i])
+			} else if value.Valid {
+				m.Value = value.Float64
+			}
+		case model.FieldViews:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field views", values[i])
+			} else if value.Valid {
+				m.Views = value.Int64
+			}
+		case model.FieldTease:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tease", values[i])
+			} else if value.Valid {
+				m.Tease = value.Int64
+			}
+		case model.FieldPosts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field posts", values[i])
+			} else if value.Valid {
+				m.Posts = value.Int64
+			}
+		case model.FieldImageSrc:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field image_src", values[i])
+			} else if value.Valid {
+				m.ImageSrc = value.String
+			}
+		case model.FieldIconSrc:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field icon_src", values[i])
+			} else if value.Valid {
+				m.IconSrc = value.String
 			}
-			m.ID = int(value.Int64)
 		default:
 			m.selectValues.Set(columns[i], values[i])
 		}
@@ -54,12 +211,22 @@ func (m *Model) assignValues(columns []string, values []any) error {
 	return nil
 }
 
-// Value returns the ent.Value that was dynamically selected and assigned to the Model.
+// GetValue returns the ent.Value that was dynamically selected and assigned to the Model.
 // This includes values selected through modifiers, order, etc.
-func (m *Model) Value(name string) (ent.Value, error) {
+func (m *Model) GetValue(name string) (ent.Value, error) {
 	return m.selectValues.Get(name)
 }
 
+// QueryOptions queries the "options" edge of the Model entity.
+func (m *Model) QueryOptions() *SubscriptionOptionQuery {
+	return NewModelClient(m.config).QueryOptions(m)
+}
+
+// QuerySubscriptions queries the "subscriptions" edge of the Model entity.
+func (m *Model) QuerySubscriptions() *SubscriptionQuery {
+	return NewModelClient(m.config).QuerySubscriptions(m)
+}
+
 // Update returns a builder for updating this Model.
 // Note that you need to call Model.Unwrap() before calling this method if this Model
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -82,7 +249,53 @@ func (m *Model) Unwrap() *Model {
 func (m *Model) String() string {
 	var builder strings.Builder
 	builder.WriteString("Model(")
-	builder.WriteString(fmt.Sprintf("id=%v", m.ID))
+	builder.WriteString(fmt.Sprintf("id=%v, ", m.ID))
+	builder.WriteString("name=")
+	builder.WriteString(m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("model_id=")
+	builder.WriteString(m.ModelID)
+	builder.WriteString(", ")
+	builder.WriteString("email=")
+	builder.WriteString(m.Email)
+	builder.WriteString(", ")
+	builder.WriteString("wallet_address=")
+	builder.WriteString(m.WalletAddress)
+	builder.WriteString(", ")
+	builder.WriteString("ipfs_url=")
+	builder.WriteString(m.IpfsURL)
+	builder.WriteString(", ")
+	builder.WriteString("openai_token_id=")
+	builder.WriteString(m.OpenaiTokenID)
+	builder.WriteString(", ")
+	if v := m.Slug; v != nil {
+		builder.WriteString("slug=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("location=")
+	builder.WriteString(m.Location)
+	builder.WriteString(", ")
+	builder.WriteString("about_me=")
+	builder.WriteString(m.AboutMe)
+	builder.WriteString(", ")
+	builder.WriteString("value=")
+	builder.WriteString(fmt.Sprintf("%v", m.Value))
+	builder.WriteString(", ")
+	builder.WriteString("views=")
+	builder.WriteString(fmt.Sprintf("%v", m.Views))
+	builder.WriteString(", ")
+	builder.WriteString("tease=")
+	builder.WriteString(fmt.Sprintf("%v", m.Tease))
+	builder.WriteString(", ")
+	builder.WriteString("posts=")
+	builder.WriteString(fmt.Sprintf("%v", m.Posts))
+	builder.WriteString(", ")
+	builder.WriteString("image_src=")
+	builder.WriteString(m.ImageSrc)
+	builder.WriteString(", ")
+	builder.WriteString("icon_src=")
+	builder.WriteString(m.IconSrc)
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -4,6 +4,7 @@ package model
 
 import (
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 )
 
 const (
@@ -11,13 +12,76 @@ const (
 	Label = "model"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldModelID holds the string denoting the model_id field in the database.
+	FieldModelID = "model_id"
+	// FieldEmail holds the string denoting the email field in the database.
+	FieldEmail = "email"
+	// FieldWalletAddress holds the string denoting the wallet_address field in the database.
+	FieldWalletAddress = "wallet_address"
+	// FieldIpfsURL holds the string denoting the ipfs_url field in the database.
+	FieldIpfsURL = "ipfs_url"
+	// FieldOpenaiTokenID holds the string denoting the openai_token_id field in the database.
+	FieldOpenaiTokenID = "openai_token_id"
+	// FieldSlug holds the string denoting the slug field in the database.
+	FieldSlug = "slug"
+	// FieldLocation holds the string denoting the location field in the database.
+	FieldLocation = "location"
+	// FieldAboutMe holds the string denoting the about_me field in the database.
+	FieldAboutMe = "about_me"
+	// FieldValue holds the string denoting the value field in the database.
+	FieldValue = "value"
+	// FieldViews holds the string denoting the views field in the database.
+	FieldViews = "views"
+	// FieldTease holds the string denoting the tease field in the database.
+	FieldTease = "tease"
+	// FieldPosts holds the string denoting the posts field in the database.
+	FieldPosts = "posts"
+	// FieldImageSrc holds the string denoting the image_src field in the database.
+	FieldImageSrc = "image_src"
+	// FieldIconSrc holds the string denoting the icon_src field in the database.
+	FieldIconSrc = "icon_src"
+	// EdgeOptions holds the string denoting the options edge name in mutations.
+	EdgeOptions = "options"
+	// EdgeSubscriptions holds the string denoting the subscriptions edge name in mutations.
+	EdgeSubscriptions = "subscriptions"
 	// Table holds the table name of the model in the database.
 	Table = "models"
+	// OptionsTable is the table that holds the options relation/edge.
+	OptionsTable = "subscription_options"
+	// OptionsInverseTable is the table name for the SubscriptionOption entity.
+	// It exists in this package in order to avoid circular dependency with the "subscriptionoption" package.
+	OptionsInverseTable = "subscription_options"
+	// OptionsColumn is the table column denoting the options relation/edge.
+	OptionsColumn = "model_id"
+	// SubscriptionsTable is the table that holds the subscriptions relation/edge.
+	SubscriptionsTable = "subscriptions"
+	// SubscriptionsInverseTable is the table name for the Subscription entity.
+	// It exists in this package in order to avoid circular dependency with the "subscription" package.
+	SubscriptionsInverseTable = "subscriptions"
+	// SubscriptionsColumn is the table column denoting the subscriptions relation/edge.
+	SubscriptionsColumn = "model_id"
 )
 
 // Columns holds all SQL columns for model fields.
 var Columns = []string{
 	FieldID,
+	FieldName,
+	FieldModelID,
+	FieldEmail,
+	FieldWalletAddress,
+	FieldIpfsURL,
+	FieldOpenaiTokenID,
+	FieldSlug,
+	FieldLocation,
+	FieldAboutMe,
+	FieldValue,
+	FieldViews,
+	FieldTease,
+	FieldPosts,
+	FieldImageSrc,
+	FieldIconSrc,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -30,6 +94,19 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+var (
+	// DefaultValue holds the default value on creation for the "value" field.
+	DefaultValue float64
+	// DefaultViews holds the default value on creation for the "views" field.
+	DefaultViews int64
+	// DefaultTease holds the default value on creation for the "tease" field.
+	DefaultTease int64
+	// DefaultPosts holds the default value on creation for the "posts" field.
+	DefaultPosts int64
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
 // OrderOption defines the ordering options for the Model queries.
 type OrderOption func(*sql.Selector)
 
@@ -37,3 +114,120 @@ type OrderOption func(*sql.Selector)
 func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByModelID orders the results by the model_id field.
+func ByModelID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModelID, opts...).ToFunc()
+}
+
+// ByEmail orders the results by the email field.
+func ByEmail(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmail, opts...).ToFunc()
+}
+
+// ByWalletAddress orders the results by the wallet_address field.
+func ByWalletAddress(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWalletAddress, opts...).ToFunc()
+}
+
+// ByIpfsURL orders the results by the ipfs_url field.
+func ByIpfsURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIpfsURL, opts...).ToFunc()
+}
+
+// ByOpenaiTokenID orders the results by the openai_token_id field.
+func ByOpenaiTokenID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOpenaiTokenID, opts...).ToFunc()
+}
+
+// BySlug orders the results by the slug field.
+func BySlug(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSlug, opts...).ToFunc()
+}
+
+// ByLocation orders the results by the location field.
+func ByLocation(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLocation, opts...).ToFunc()
+}
+
+// ByAboutMe orders the results by the about_me field.
+func ByAboutMe(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAboutMe, opts...).ToFunc()
+}
+
+// ByValue orders the results by the value field.
+func ByValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValue, opts...).ToFunc()
+}
+
+// ByViews orders the results by the views field.
+func ByViews(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldViews, opts...).ToFunc()
+}
+
+// ByTease orders the results by the tease field.
+func ByTease(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTease, opts...).ToFunc()
+}
+
+// ByPosts orders the results by the posts field.
+func ByPosts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPosts, opts...).ToFunc()
+}
+
+// ByImageSrc orders the results by the image_src field.
+func ByImageSrc(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldImageSrc, opts...).ToFunc()
+}
+
+// ByIconSrc orders the results by the icon_src field.
+func ByIconSrc(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIconSrc, opts...).ToFunc()
+}
+
+// ByOptionsCount orders the results by options count.
+func ByOptionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newOptionsStep(), opts...)
+	}
+}
+
+// ByOptions orders the results by options terms.
+func ByOptions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOptionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySubscriptionsCount orders the results by subscriptions count.
+func BySubscriptionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSubscriptionsStep(), opts...)
+	}
+}
+
+// BySubscriptions orders the results by subscriptions terms.
+func BySubscriptions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSubscriptionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newOptionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OptionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, OptionsTable, OptionsColumn),
+	)
+}
+func newSubscriptionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SubscriptionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SubscriptionsTable, SubscriptionsColumn),
+	)
+}
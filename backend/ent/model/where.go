@@ -6,53 +6,1130 @@ import (
 	"arjunmal1311/fans_flow_on_chain/backend/ent/predicate"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 )
 
 // ID filters vertices based on their ID field.
-func ID(id int) predicate.Model {
+func ID(id string) predicate.Model {
 	return predicate.Model(sql.FieldEQ(FieldID, id))
 }
 
 // IDEQ applies the EQ predicate on the ID field.
-func IDEQ(id int) predicate.Model {
+func IDEQ(id string) predicate.Model {
 	return predicate.Model(sql.FieldEQ(FieldID, id))
 }
 
 // IDNEQ applies the NEQ predicate on the ID field.
-func IDNEQ(id int) predicate.Model {
+func IDNEQ(id string) predicate.Model {
 	return predicate.Model(sql.FieldNEQ(FieldID, id))
 }
 
 // IDIn applies the In predicate on the ID field.
-func IDIn(ids ...int) predicate.Model {
+func IDIn(ids ...string) predicate.Model {
 	return predicate.Model(sql.FieldIn(FieldID, ids...))
 }
 
 // IDNotIn applies the NotIn predicate on the ID field.
-func IDNotIn(ids ...int) predicate.Model {
+func IDNotIn(ids ...string) predicate.Model {
 	return predicate.Model(sql.FieldNotIn(FieldID, ids...))
 }
 
 // IDGT applies the GT predicate on the ID field.
-func IDGT(id int) predicate.Model {
+func IDGT(id string) predicate.Model {
 	return predicate.Model(sql.FieldGT(FieldID, id))
 }
 
 // IDGTE applies the GTE predicate on the ID field.
-func IDGTE(id int) predicate.Model {
+func IDGTE(id string) predicate.Model {
 	return predicate.Model(sql.FieldGTE(FieldID, id))
 }
 
 // IDLT applies the LT predicate on the ID field.
-func IDLT(id int) predicate.Model {
+func IDLT(id string) predicate.Model {
 	return predicate.Model(sql.FieldLT(FieldID, id))
 }
 
 // IDLTE applies the LTE predicate on the ID field.
-func IDLTE(id int) predicate.Model {
+func IDLTE(id string) predicate.Model {
 	return predicate.Model(sql.FieldLTE(FieldID, id))
 }
 
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.Model {
+	return predicate.Model(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.Model {
+	return predicate.Model(sql.FieldContainsFold(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Model {
+	return predicate.Model(sql.FieldEQ(FieldName, v))
+}
+
+// ModelID applies equality check predicate on the "model_id" field. It's identical to ModelIDEQ.
+func ModelID(v string) predicate.Model {
+	return predicate.Model(sql.FieldEQ(FieldModelID, v))
+}
+
+// Email applies equality check predicate on the "email" field. It's identical to EmailEQ.
+func Email(v string) predicate.Model {
+	return predicate.Model(sql.FieldEQ(FieldEmail, v))
+}
+
+// WalletAddress applies equality check predicate on the "wallet_address" field. It's identical to WalletAddressEQ.
+func WalletAddress(v string) predicate.Model {
+	return predicate.Model(sql.FieldEQ(FieldWalletAddress, v))
+}
+
+// IpfsURL applies equality check predicate on the "ipfs_url" field. It's identical to IpfsURLEQ.
+func IpfsURL(v string) predicate.Model {
+	return predicate.Model(sql.FieldEQ(FieldIpfsURL, v))
+}
+
+// OpenaiTokenID applies equality check predicate on the "openai_token_id" field. It's identical to OpenaiTokenIDEQ.
+func OpenaiTokenID(v string) predicate.Model {
+	return predicate.Model(sql.FieldEQ(FieldOpenaiTokenID, v))
+}
+
+// Slug applies equality check predicate on the "slug" field. It's identical to SlugEQ.
+func Slug(v string) predicate.Model {
+	return predicate.Model(sql.FieldEQ(FieldSlug, v))
+}
+
+// Location applies equality check predicate on the "location" field. It's identical to LocationEQ.
+func Location(v string) predicate.Model {
+	return predicate.Model(sql.FieldEQ(FieldLocation, v))
+}
+
+// AboutMe applies equality check predicate on the "about_me" field. It's identical to AboutMeEQ.
+func AboutMe(v string) predicate.Model {
+	return predicate.Model(sql.FieldEQ(FieldAboutMe, v))
+}
+
+// Value applies equality check predicate on the "value" field. It's identical to ValueEQ.
+func Value(v float64) predicate.Model {
+	return predicate.Model(sql.FieldEQ(FieldValue, v))
+}
+
+// Views applies equality check predicate on the "views" field. It's identical to ViewsEQ.
+func Views(v int64) predicate.Model {
+	return predicate.Model(sql.FieldEQ(FieldViews, v))
+}
+
+// Tease applies equality check predicate on the "tease" field. It's identical to TeaseEQ.
+func Tease(v int64) predicate.Model {
+	return predicate.Model(sql.FieldEQ(FieldTease, v))
+}
+
+// Posts applies equality check predicate on the "posts" field. It's identical to PostsEQ.
+func Posts(v int64) predicate.Model {
+	return predicate.Model(sql.FieldEQ(FieldPosts, v))
+}
+
+// ImageSrc applies equality check predicate on the "image_src" field. It's identical to ImageSrcEQ.
+func ImageSrc(v string) predicate.Model {
+	return predicate.Model(sql.FieldEQ(FieldImageSrc, v))
+}
+
+// IconSrc applies equality check predicate on the "icon_src" field. It's identical to IconSrcEQ.
+func IconSrc(v string) predicate.Model {
+	return predicate.Model(sql.FieldEQ(FieldIconSrc, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Model {
+	return predicate.Model(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Model {
+	return predicate.Model(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Model {
+	return predicate.Model(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Model {
+	return predicate.Model(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Model {
+	return predicate.Model(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Model {
+	return predicate.Model(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Model {
+	return predicate.Model(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Model {
+	return predicate.Model(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Model {
+	return predicate.Model(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Model {
+	return predicate.Model(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Model {
+	return predicate.Model(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Model {
+	return predicate.Model(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Model {
+	return predicate.Model(sql.FieldContainsFold(FieldName, v))
+}
+
+// ModelIDEQ applies the EQ predicate on the "model_id" field.
+func ModelIDEQ(v string) predicate.Model {
+	return predicate.Model(sql.FieldEQ(FieldModelID, v))
+}
+
+// ModelIDNEQ applies the NEQ predicate on the "model_id" field.
+func ModelIDNEQ(v string) predicate.Model {
+	return predicate.Model(sql.FieldNEQ(FieldModelID, v))
+}
+
+// ModelIDIn applies the In predicate on the "model_id" field.
+func ModelIDIn(vs ...string) predicate.Model {
+	return predicate.Model(sql.FieldIn(FieldModelID, vs...))
+}
+
+// ModelIDNotIn applies the NotIn predicate on the "model_id" field.
+func ModelIDNotIn(vs ...string) predicate.Model {
+	return predicate.Model(sql.FieldNotIn(FieldModelID, vs...))
+}
+
+// ModelIDGT applies the GT predicate on the "model_id" field.
+func ModelIDGT(v string) predicate.Model {
+	return predicate.Model(sql.FieldGT(FieldModelID, v))
+}
+
+// ModelIDGTE applies the GTE predicate on the "model_id" field.
+func ModelIDGTE(v string) predicate.Model {
+	return predicate.Model(sql.FieldGTE(FieldModelID, v))
+}
+
+// ModelIDLT applies the LT predicate on the "model_id" field.
+func ModelIDLT(v string) predicate.Model {
+	return predicate.Model(sql.FieldLT(FieldModelID, v))
+}
+
+// ModelIDLTE applies the LTE predicate on the "model_id" field.
+func ModelIDLTE(v string) predicate.Model {
+	return predicate.Model(sql.FieldLTE(FieldModelID, v))
+}
+
+// ModelIDContains applies the Contains predicate on the "model_id" field.
+func ModelIDContains(v string) predicate.Model {
+	return predicate.Model(sql.FieldContains(FieldModelID, v))
+}
+
+// ModelIDHasPrefix applies the HasPrefix predicate on the "model_id" field.
+func ModelIDHasPrefix(v string) predicate.Model {
+	return predicate.Model(sql.FieldHasPrefix(FieldModelID, v))
+}
+
+// ModelIDHasSuffix applies the HasSuffix predicate on the "model_id" field.
+func ModelIDHasSuffix(v string) predicate.Model {
+	return predicate.Model(sql.FieldHasSuffix(FieldModelID, v))
+}
+
+// ModelIDEqualFold applies the EqualFold predicate on the "model_id" field.
+func ModelIDEqualFold(v string) predicate.Model {
+	return predicate.Model(sql.FieldEqualFold(FieldModelID, v))
+}
+
+// ModelIDContainsFold applies the ContainsFold predicate on the "model_id" field.
+func ModelIDContainsFold(v string) predicate.Model {
+	return predicate.Model(sql.FieldContainsFold(FieldModelID, v))
+}
+
+// EmailEQ applies the EQ predicate on the "email" field.
+func EmailEQ(v string) predicate.Model {
+	return predicate.Model(sql.FieldEQ(FieldEmail, v))
+}
+
+// EmailNEQ applies the NEQ predicate on the "email" field.
+func EmailNEQ(v string) predicate.Model {
+	return predicate.Model(sql.FieldNEQ(FieldEmail, v))
+}
+
+// EmailIn applies the In predicate on the "email" field.
+func EmailIn(vs ...string) predicate.Model {
+	return predicate.Model(sql.FieldIn(FieldEmail, vs...))
+}
+
+// EmailNotIn applies the NotIn predicate on the "email" field.
+func EmailNotIn(vs ...string) predicate.Model {
+	return predicate.Model(sql.FieldNotIn(FieldEmail, vs...))
+}
+
+// EmailGT applies the GT predicate on the "email" field.
+func EmailGT(v string) predicate.Model {
+	return predicate.Model(sql.FieldGT(FieldEmail, v))
+}
+
+// EmailGTE applies the GTE predicate on the "email" field.
+func EmailGTE(v string) predicate.Model {
+	return predicate.Model(sql.FieldGTE(FieldEmail, v))
+}
+
+// EmailLT applies the LT predicate on the "email" field.
+func EmailLT(v string) predicate.Model {
+	return predicate.Model(sql.FieldLT(FieldEmail, v))
+}
+
+// EmailLTE applies the LTE predicate on the "email" field.
+func EmailLTE(v string) predicate.Model {
+	return predicate.Model(sql.FieldLTE(FieldEmail, v))
+}
+
+// EmailContains applies the Contains predicate on the "email" field.
+func EmailContains(v string) predicate.Model {
+	return predicate.Model(sql.FieldContains(FieldEmail, v))
+}
+
+// EmailHasPrefix applies the HasPrefix predicate on the "email" field.
+func EmailHasPrefix(v string) predicate.Model {
+	return predicate.Model(sql.FieldHasPrefix(FieldEmail, v))
+}
+
+// EmailHasSuffix applies the HasSuffix predicate on the "email" field.
+func EmailHasSuffix(v string) predicate.Model {
+	return predicate.Model(sql.FieldHasSuffix(FieldEmail, v))
+}
+
+// EmailEqualFold applies the EqualFold predicate on the "email" field.
+func EmailEqualFold(v string) predicate.Model {
+	return predicate.Model(sql.FieldEqualFold(FieldEmail, v))
+}
+
+// EmailContainsFold applies the ContainsFold predicate on the "email" field.
+func EmailContainsFold(v string) predicate.Model {
+	return predicate.Model(sql.FieldContainsFold(FieldEmail, v))
+}
+
+// WalletAddressEQ applies the EQ predicate on the "wallet_address" field.
+func WalletAddressEQ(v string) predicate.Model {
+	return predicate.Model(sql.FieldEQ(FieldWalletAddress, v))
+}
+
+// WalletAddressNEQ applies the NEQ predicate on the "wallet_address" field.
+func WalletAddressNEQ(v string) predicate.Model {
+	return predicate.Model(sql.FieldNEQ(FieldWalletAddress, v))
+}
+
+// WalletAddressIn applies the In predicate on the "wallet_address" field.
+func WalletAddressIn(vs ...string) predicate.Model {
+	return predicate.Model(sql.FieldIn(FieldWalletAddress, vs...))
+}
+
+// WalletAddressNotIn applies the NotIn predicate on the "wallet_address" field.
+func WalletAddressNotIn(vs ...string) predicate.Model {
+	return predicate.Model(sql.FieldNotIn(FieldWalletAddress, vs...))
+}
+
+// WalletAddressGT applies the GT predicate on the "wallet_address" field.
+func WalletAddressGT(v string) predicate.Model {
+	return predicate.Model(sql.FieldGT(FieldWalletAddress, v))
+}
+
+// WalletAddressGTE applies the GTE predicate on the "wallet_address" field.
+func WalletAddressGTE(v string) predicate.Model {
+	return predicate.Model(sql.FieldGTE(FieldWalletAddress, v))
+}
+
+// WalletAddressLT applies the LT predicate on the "wallet_address" field.
+func WalletAddressLT(v string) predicate.Model {
+	return predicate.Model(sql.FieldLT(FieldWalletAddress, v))
+}
+
+// WalletAddressLTE applies the LTE predicate on the "wallet_address" field.
+func WalletAddressLTE(v string) predicate.Model {
+	return predicate.Model(sql.FieldLTE(FieldWalletAddress, v))
+}
+
+// WalletAddressContains applies the Contains predicate on the "wallet_address" field.
+func WalletAddressContains(v string) predicate.Model {
+	return predicate.Model(sql.FieldContains(FieldWalletAddress, v))
+}
+
+// WalletAddressHasPrefix applies the HasPrefix predicate on the "wallet_address" field.
+func WalletAddressHasPrefix(v string) predicate.Model {
+	return predicate.Model(sql.FieldHasPrefix(FieldWalletAddress, v))
+}
+
+// WalletAddressHasSuffix applies the HasSuffix predicate on the "wallet_address" field.
+func WalletAddressHasSuffix(v string) predicate.Model {
+	return predicate.Model(sql.FieldHasSuffix(FieldWalletAddress, v))
+}
+
+// WalletAddressEqualFold applies the EqualFold predicate on the "wallet_address" field.
+func WalletAddressEqualFold(v string) predicate.Model {
+	return predicate.Model(sql.FieldEqualFold(FieldWalletAddress, v))
+}
+
+// WalletAddressContainsFold applies the ContainsFold predicate on the "wallet_address" field.
+func WalletAddressContainsFold(v string) predicate.Model {
+	return predicate.Model(sql.FieldContainsFold(FieldWalletAddress, v))
+}
+
+// IpfsURLEQ applies the EQ predicate on the "ipfs_url" field.
+func IpfsURLEQ(v string) predicate.Model {
+	return predicate.Model(sql.FieldEQ(FieldIpfsURL, v))
+}
+
+// IpfsURLNEQ applies the NEQ predicate on the "ipfs_url" field.
+func IpfsURLNEQ(v string) predicate.Model {
+	return predicate.Model(sql.FieldNEQ(FieldIpfsURL, v))
+}
+
+// IpfsURLIn applies the In predicate on the "ipfs_url" field.
+func IpfsURLIn(vs ...string) predicate.Model {
+	return predicate.Model(sql.FieldIn(FieldIpfsURL, vs...))
+}
+
+// IpfsURLNotIn applies the NotIn predicate on the "ipfs_url" field.
+func IpfsURLNotIn(vs ...string) predicate.Model {
+	return predicate.Model(sql.FieldNotIn(FieldIpfsURL, vs...))
+}
+
+// IpfsURLGT applies the GT predicate on the "ipfs_url" field.
+func IpfsURLGT(v string) predicate.Model {
+	return predicate.Model(sql.FieldGT(FieldIpfsURL, v))
+}
+
+// IpfsURLGTE applies the GTE predicate on the "ipfs_url" field.
+func IpfsURLGTE(v string) predicate.Model {
+	return predicate.Model(sql.FieldGTE(FieldIpfsURL, v))
+}
+
+// IpfsURLLT applies the LT predicate on the "ipfs_url" field.
+func IpfsURLLT(v string) predicate.Model {
+	return predicate.Model(sql.FieldLT(FieldIpfsURL, v))
+}
+
+// IpfsURLLTE applies the LTE predicate on the "ipfs_url" field.
+func IpfsURLLTE(v string) predicate.Model {
+	return predicate.Model(sql.FieldLTE(FieldIpfsURL, v))
+}
+
+// IpfsURLContains applies the Contains predicate on the "ipfs_url" field.
+func IpfsURLContains(v string) predicate.Model {
+	return predicate.Model(sql.FieldContains(FieldIpfsURL, v))
+}
+
+// IpfsURLHasPrefix applies the HasPrefix predicate on the "ipfs_url" field.
+func IpfsURLHasPrefix(v string) predicate.Model {
+	return predicate.Model(sql.FieldHasPrefix(FieldIpfsURL, v))
+}
+
+// IpfsURLHasSuffix applies the HasSuffix predicate on the "ipfs_url" field.
+func IpfsURLHasSuffix(v string) predicate.Model {
+	return predicate.Model(sql.FieldHasSuffix(FieldIpfsURL, v))
+}
+
+// IpfsURLIsNil applies the IsNil predicate on the "ipfs_url" field.
+func IpfsURLIsNil() predicate.Model {
+	return predicate.Model(sql.FieldIsNull(FieldIpfsURL))
+}
+
+// IpfsURLNotNil applies the NotNil predicate on the "ipfs_url" field.
+func IpfsURLNotNil() predicate.Model {
+	return predicate.Model(sql.FieldNotNull(FieldIpfsURL))
+}
+
+// IpfsURLEqualFold applies the EqualFold predicate on the "ipfs_url" field.
+func IpfsURLEqualFold(v string) predicate.Model {
+	return predicate.Model(sql.FieldEqualFold(FieldIpfsURL, v))
+}
+
+// IpfsURLContainsFold applies the ContainsFold predicate on the "ipfs_url" field.
+func IpfsURLContainsFold(v string) predicate.Model {
+	return predicate.Model(sql.FieldContainsFold(FieldIpfsURL, v))
+}
+
+// OpenaiTokenIDEQ applies the EQ predicate on the "openai_token_id" field.
+func OpenaiTokenIDEQ(v string) predicate.Model {
+	return predicate.Model(sql.FieldEQ(FieldOpenaiTokenID, v))
+}
+
+// OpenaiTokenIDNEQ applies the NEQ predicate on the "openai_token_id" field.
+func OpenaiTokenIDNEQ(v string) predicate.Model {
+	return predicate.Model(sql.FieldNEQ(FieldOpenaiTokenID, v))
+}
+
+// OpenaiTokenIDIn applies the In predicate on the "openai_token_id" field.
+func OpenaiTokenIDIn(vs ...string) predicate.Model {
+	return predicate.Model(sql.FieldIn(FieldOpenaiTokenID, vs...))
+}
+
+// OpenaiTokenIDNotIn applies the NotIn predicate on the "openai_token_id" field.
+func OpenaiTokenIDNotIn(vs ...string) predicate.Model {
+	return predicate.Model(sql.FieldNotIn(FieldOpenaiTokenID, vs...))
+}
+
+// OpenaiTokenIDGT applies the GT predicate on the "openai_token_id" field.
+func OpenaiTokenIDGT(v string) predicate.Model {
+	return predicate.Model(sql.FieldGT(FieldOpenaiTokenID, v))
+}
+
+// OpenaiTokenIDGTE applies the GTE predicate on the "openai_token_id" field.
+func OpenaiTokenIDGTE(v string) predicate.Model {
+	return predicate.Model(sql.FieldGTE(FieldOpenaiTokenID, v))
+}
+
+// OpenaiTokenIDLT applies the LT predicate on the "openai_token_id" field.
+func OpenaiTokenIDLT(v string) predicate.Model {
+	return predicate.Model(sql.FieldLT(FieldOpenaiTokenID, v))
+}
+
+// OpenaiTokenIDLTE applies the LTE predicate on the "openai_token_id" field.
+func OpenaiTokenIDLTE(v string) predicate.Model {
+	return predicate.Model(sql.FieldLTE(FieldOpenaiTokenID, v))
+}
+
+// OpenaiTokenIDContains applies the Contains predicate on the "openai_token_id" field.
+func OpenaiTokenIDContains(v string) predicate.Model {
+	return predicate.Model(sql.FieldContains(FieldOpenaiTokenID, v))
+}
+
+// OpenaiTokenIDHasPrefix applies the HasPrefix predicate on the "openai_token_id" field.
+func OpenaiTokenIDHasPrefix(v string) predicate.Model {
+	return predicate.Model(sql.FieldHasPrefix(FieldOpenaiTokenID, v))
+}
+
+// OpenaiTokenIDHasSuffix applies the HasSuffix predicate on the "openai_token_id" field.
+func OpenaiTokenIDHasSuffix(v string) predicate.Model {
+	return predicate.Model(sql.FieldHasSuffix(FieldOpenaiTokenID, v))
+}
+
+// OpenaiTokenIDIsNil applies the IsNil predicate on the "openai_token_id" field.
+func OpenaiTokenIDIsNil() predicate.Model {
+	return predicate.Model(sql.FieldIsNull(FieldOpenaiTokenID))
+}
+
+// OpenaiTokenIDNotNil applies the NotNil predicate on the "openai_token_id" field.
+func OpenaiTokenIDNotNil() predicate.Model {
+	return predicate.Model(sql.FieldNotNull(FieldOpenaiTokenID))
+}
+
+// OpenaiTokenIDEqualFold applies the EqualFold predicate on the "openai_token_id" field.
+func OpenaiTokenIDEqualFold(v string) predicate.Model {
+	return predicate.Model(sql.FieldEqualFold(FieldOpenaiTokenID, v))
+}
+
+// OpenaiTokenIDContainsFold applies the ContainsFold predicate on the "openai_token_id" field.
+func OpenaiTokenIDContainsFold(v string) predicate.Model {
+	return predicate.Model(sql.FieldContainsFold(FieldOpenaiTokenID, v))
+}
+
+// SlugEQ applies the EQ predicate on the "slug" field.
+func SlugEQ(v string) predicate.Model {
+	return predicate.Model(sql.FieldEQ(FieldSlug, v))
+}
+
+// SlugNEQ applies the NEQ predicate on the "slug" field.
+func SlugNEQ(v string) predicate.Model {
+	return predicate.Model(sql.FieldNEQ(FieldSlug, v))
+}
+
+// SlugIn applies the In predicate on the "slug" field.
+func SlugIn(vs ...string) predicate.Model {
+	return predicate.Model(sql.FieldIn(FieldSlug, vs...))
+}
+
+// SlugNotIn applies the NotIn predicate on the "slug" field.
+func SlugNotIn(vs ...string) predicate.Model {
+	return predicate.Model(sql.FieldNotIn(FieldSlug, vs...))
+}
+
+// SlugGT applies the GT predicate on the "slug" field.
+func SlugGT(v string) predicate.Model {
+	return predicate.Model(sql.FieldGT(FieldSlug, v))
+}
+
+// SlugGTE applies the GTE predicate on the "slug" field.
+func SlugGTE(v string) predicate.Model {
+	return predicate.Model(sql.FieldGTE(FieldSlug, v))
+}
+
+// SlugLT applies the LT predicate on the "slug" field.
+func SlugLT(v string) predicate.Model {
+	return predicate.Model(sql.FieldLT(FieldSlug, v))
+}
+
+// SlugLTE applies the LTE predicate on the "slug" field.
+func SlugLTE(v string) predicate.Model {
+	return predicate.Model(sql.FieldLTE(FieldSlug, v))
+}
+
+// SlugContains applies the Contains predicate on the "slug" field.
+func SlugContains(v string) predicate.Model {
+	return predicate.Model(sql.FieldContains(FieldSlug, v))
+}
+
+// SlugHasPrefix applies the HasPrefix predicate on the "slug" field.
+func SlugHasPrefix(v string) predicate.Model {
+	return predicate.Model(sql.FieldHasPrefix(FieldSlug, v))
+}
+
+// SlugHasSuffix applies the HasSuffix predicate on the "slug" field.
+func SlugHasSuffix(v string) predicate.Model {
+	return predicate.Model(sql.FieldHasSuffix(FieldSlug, v))
+}
+
+// SlugIsNil applies the IsNil predicate on the "slug" field.
+func SlugIsNil() predicate.Model {
+	return predicate.Model(sql.FieldIsNull(FieldSlug))
+}
+
+// SlugNotNil applies the NotNil predicate on the "slug" field.
+func SlugNotNil() predicate.Model {
+	return predicate.Model(sql.FieldNotNull(FieldSlug))
+}
+
+// SlugEqualFold applies the EqualFold predicate on the "slug" field.
+func SlugEqualFold(v string) predicate.Model {
+	return predicate.Model(sql.FieldEqualFold(FieldSlug, v))
+}
+
+// SlugContainsFold applies the ContainsFold predicate on the "slug" field.
+func SlugContainsFold(v string) predicate.Model {
+	return predicate.Model(sql.FieldContainsFold(FieldSlug, v))
+}
+
+// LocationEQ applies the EQ predicate on the "location" field.
+func LocationEQ(v string) predicate.Model {
+	return predicate.Model(sql.FieldEQ(FieldLocation, v))
+}
+
+// LocationNEQ applies the NEQ predicate on the "location" field.
+func LocationNEQ(v string) predicate.Model {
+	return predicate.Model(sql.FieldNEQ(FieldLocation, v))
+}
+
+// LocationIn applies the In predicate on the "location" field.
+func LocationIn(vs ...string) predicate.Model {
+	return predicate.Model(sql.FieldIn(FieldLocation, vs...))
+}
+
+// LocationNotIn applies the NotIn predicate on the "location" field.
+func LocationNotIn(vs ...string) predicate.Model {
+	return predicate.Model(sql.FieldNotIn(FieldLocation, vs...))
+}
+
+// LocationGT applies the GT predicate on the "location" field.
+func LocationGT(v string) predicate.Model {
+	return predicate.Model(sql.FieldGT(FieldLocation, v))
+}
+
+// LocationGTE applies the GTE predicate on the "location" field.
+func LocationGTE(v string) predicate.Model {
+	return predicate.Model(sql.FieldGTE(FieldLocation, v))
+}
+
+// LocationLT applies the LT predicate on the "location" field.
+func LocationLT(v string) predicate.Model {
+	return predicate.Model(sql.FieldLT(FieldLocation, v))
+}
+
+// LocationLTE applies the LTE predicate on the "location" field.
+func LocationLTE(v string) predicate.Model {
+	return predicate.Model(sql.FieldLTE(FieldLocation, v))
+}
+
+// LocationContains applies the Contains predicate on the "location" field.
+func LocationContains(v string) predicate.Model {
+	return predicate.Model(sql.FieldContains(FieldLocation, v))
+}
+
+// LocationHasPrefix applies the HasPrefix predicate on the "location" field.
+func LocationHasPrefix(v string) predicate.Model {
+	return predicate.Model(sql.FieldHasPrefix(FieldLocation, v))
+}
+
+// LocationHasSuffix applies the HasSuffix predicate on the "location" field.
+func LocationHasSuffix(v string) predicate.Model {
+	return predicate.Model(sql.FieldHasSuffix(FieldLocation, v))
+}
+
+// LocationIsNil applies the IsNil predicate on the "location" field.
+func LocationIsNil() predicate.Model {
+	return predicate.Model(sql.FieldIsNull(FieldLocation))
+}
+
+// LocationNotNil applies the NotNil predicate on the "location" field.
+func LocationNotNil() predicate.Model {
+	return predicate.Model(sql.FieldNotNull(FieldLocation))
+}
+
+// LocationEqualFold applies the EqualFold predicate on the "location" field.
+func LocationEqualFold(v string) predicate.Model {
+	return predicate.Model(sql.FieldEqualFold(FieldLocation, v))
+}
+
+// LocationContainsFold applies the ContainsFold predicate on the "location" field.
+func LocationContainsFold(v string) predicate.Model {
+	return predicate.Model(sql.FieldContainsFold(FieldLocation, v))
+}
+
+// AboutMeEQ applies the EQ predicate on the "about_me" field.
+func AboutMeEQ(v string) predicate.Model {
+	return predicate.Model(sql.FieldEQ(FieldAboutMe, v))
+}
+
+// AboutMeNEQ applies the NEQ predicate on the "about_me" field.
+func AboutMeNEQ(v string) predicate.Model {
+	return predicate.Model(sql.FieldNEQ(FieldAboutMe, v))
+}
+
+// AboutMeIn applies the In predicate on the "about_me" field.
+func AboutMeIn(vs ...string) predicate.Model {
+	return predicate.Model(sql.FieldIn(FieldAboutMe, vs...))
+}
+
+// AboutMeNotIn applies the NotIn predicate on the "about_me" field.
+func AboutMeNotIn(vs ...string) predicate.Model {
+	return predicate.Model(sql.FieldNotIn(FieldAboutMe, vs...))
+}
+
+// AboutMeGT applies the GT predicate on the "about_me" field.
+func AboutMeGT(v string) predicate.Model {
+	return predicate.Model(sql.FieldGT(FieldAboutMe, v))
+}
+
+// AboutMeGTE applies the GTE predicate on the "about_me" field.
+func AboutMeGTE(v string) predicate.Model {
+	return predicate.Model(sql.FieldGTE(FieldAboutMe, v))
+}
+
+// AboutMeLT applies the LT predicate on the "about_me" field.
+func AboutMeLT(v string) predicate.Model {
+	return predicate.Model(sql.FieldLT(FieldAboutMe, v))
+}
+
+// AboutMeLTE applies the LTE predicate on the "about_me" field.
+func AboutMeLTE(v string) predicate.Model {
+	return predicate.Model(sql.FieldLTE(FieldAboutMe, v))
+}
+
+// AboutMeContains applies the Contains predicate on the "about_me" field.
+func AboutMeContains(v string) predicate.Model {
+	return predicate.Model(sql.FieldContains(FieldAboutMe, v))
+}
+
+// AboutMeHasPrefix applies the HasPrefix predicate on the "about_me" field.
+func AboutMeHasPrefix(v string) predicate.Model {
+	return predicate.Model(sql.FieldHasPrefix(FieldAboutMe, v))
+}
+
+// AboutMeHasSuffix applies the HasSuffix predicate on the "about_me" field.
+func AboutMeHasSuffix(v string) predicate.Model {
+	return predicate.Model(sql.FieldHasSuffix(FieldAboutMe, v))
+}
+
+// AboutMeIsNil applies the IsNil predicate on the "about_me" field.
+func AboutMeIsNil() predicate.Model {
+	return predicate.Model(sql.FieldIsNull(FieldAboutMe))
+}
+
+// AboutMeNotNil applies the NotNil predicate on the "about_me" field.
+func AboutMeNotNil() predicate.Model {
+	return predicate.Model(sql.FieldNotNull(FieldAboutMe))
+}
+
+// AboutMeEqualFold applies the EqualFold predicate on the "about_me" field.
+func AboutMeEqualFold(v string) predicate.Model {
+	return predicate.Model(sql.FieldEqualFold(FieldAboutMe, v))
+}
+
+// AboutMeContainsFold applies the ContainsFold predicate on the "about_me" field.
+func AboutMeContainsFold(v string) predicate.Model {
+	return predicate.Model(sql.FieldContainsFold(FieldAboutMe, v))
+}
+
+// ValueEQ applies the EQ predicate on the "value" field.
+func ValueEQ(v float64) predicate.Model {
+	return predicate.Model(sql.FieldEQ(FieldValue, v))
+}
+
+// ValueNEQ applies the NEQ predicate on the "value" field.
+func ValueNEQ(v float64) predicate.Model {
+	return predicate.Model(sql.FieldNEQ(FieldValue, v))
+}
+
+// ValueIn applies the In predicate on the "value" field.
+func ValueIn(vs ...float64) predicate.Model {
+	return predicate.Model(sql.FieldIn(FieldValue, vs...))
+}
+
+// ValueNotIn applies the NotIn predicate on the "value" field.
+func ValueNotIn(vs ...float64) predicate.Model {
+	return predicate.Model(sql.FieldNotIn(FieldValue, vs...))
+}
+
+// ValueGT applies the GT predicate on the "value" field.
+func ValueGT(v float64) predicate.Model {
+	return predicate.Model(sql.FieldGT(FieldValue, v))
+}
+
+// ValueGTE applies the GTE predicate on the "value" field.
+func ValueGTE(v float64) predicate.Model {
+	return predicate.Model(sql.FieldGTE(FieldValue, v))
+}
+
+// ValueLT applies the LT predicate on the "value" field.
+func ValueLT(v float64) predicate.Model {
+	return predicate.Model(sql.FieldLT(FieldValue, v))
+}
+
+// ValueLTE applies the LTE predicate on the "value" field.
+func ValueLTE(v float64) predicate.Model {
+	return predicate.Model(sql.FieldLTE(FieldValue, v))
+}
+
+// ViewsEQ applies the EQ predicate on the "views" field.
+func ViewsEQ(v int64) predicate.Model {
+	return predicate.Model(sql.FieldEQ(FieldViews, v))
+}
+
+// ViewsNEQ applies the NEQ predicate on the "views" field.
+func ViewsNEQ(v int64) predicate.Model {
+	return predicate.Model(sql.FieldNEQ(FieldViews, v))
+}
+
+// ViewsIn applies the In predicate on the "views" field.
+func ViewsIn(vs ...int64) predicate.Model {
+	return predicate.Model(sql.FieldIn(FieldViews, vs...))
+}
+
+// ViewsNotIn applies the NotIn predicate on the "views" field.
+func ViewsNotIn(vs ...int64) predicate.Model {
+	return predicate.Model(sql.FieldNotIn(FieldViews, vs...))
+}
+
+// ViewsGT applies the GT predicate on the "views" field.
+func ViewsGT(v int64) predicate.Model {
+	return predicate.Model(sql.FieldGT(FieldViews, v))
+}
+
+// ViewsGTE applies the GTE predicate on the "views" field.
+func ViewsGTE(v int64) predicate.Model {
+	return predicate.Model(sql.FieldGTE(FieldViews, v))
+}
+
+// ViewsLT applies the LT predicate on the "views" field.
+func ViewsLT(v int64) predicate.Model {
+	return predicate.Model(sql.FieldLT(FieldViews, v))
+}
+
+// ViewsLTE applies the LTE predicate on the "views" field.
+func ViewsLTE(v int64) predicate.Model {
+	return predicate.Model(sql.FieldLTE(FieldViews, v))
+}
+
+// TeaseEQ applies the EQ predicate on the "tease" field.
+func TeaseEQ(v int64) predicate.Model {
+	return predicate.Model(sql.FieldEQ(FieldTease, v))
+}
+
+// TeaseNEQ applies the NEQ predicate on the "tease" field.
+func TeaseNEQ(v int64) predicate.Model {
+	return predicate.Model(sql.FieldNEQ(FieldTease, v))
+}
+
+// TeaseIn applies the In predicate on the "tease" field.
+func TeaseIn(vs ...int64) predicate.Model {
+	return predicate.Model(sql.FieldIn(FieldTease, vs...))
+}
+
+// TeaseNotIn applies the NotIn predicate on the "tease" field.
+func TeaseNotIn(vs ...int64) predicate.Model {
+	return predicate.Model(sql.FieldNotIn(FieldTease, vs...))
+}
+
+// TeaseGT applies the GT predicate on the "tease" field.
+func TeaseGT(v int64) predicate.Model {
+	return predicate.Model(sql.FieldGT(FieldTease, v))
+}
+
+// TeaseGTE applies the GTE predicate on the "tease" field.
+func TeaseGTE(v int64) predicate.Model {
+	return predicate.Model(sql.FieldGTE(FieldTease, v))
+}
+
+// TeaseLT applies the LT predicate on the "tease" field.
+func TeaseLT(v int64) predicate.Model {
+	return predicate.Model(sql.FieldLT(FieldTease, v))
+}
+
+// TeaseLTE applies the LTE predicate on the "tease" field.
+func TeaseLTE(v int64) predicate.Model {
+	return predicate.Model(sql.FieldLTE(FieldTease, v))
+}
+
+// PostsEQ applies the EQ predicate on the "posts" field.
+func PostsEQ(v int64) predicate.Model {
+	return predicate.Model(sql.FieldEQ(FieldPosts, v))
+}
+
+// PostsNEQ applies the NEQ predicate on the "posts" field.
+func PostsNEQ(v int64) predicate.Model {
+	return predicate.Model(sql.FieldNEQ(FieldPosts, v))
+}
+
+// PostsIn applies the In predicate on the "posts" field.
+func PostsIn(vs ...int64) predicate.Model {
+	return predicate.Model(sql.FieldIn(FieldPosts, vs...))
+}
+
+// PostsNotIn applies the NotIn predicate on the "posts" field.
+func PostsNotIn(vs ...int64) predicate.Model {
+	return predicate.Model(sql.FieldNotIn(FieldPosts, vs...))
+}
+
+// PostsGT applies the GT predicate on the "posts" field.
+func PostsGT(v int64) predicate.Model {
+	return predicate.Model(sql.FieldGT(FieldPosts, v))
+}
+
+// PostsGTE applies the GTE predicate on the "posts" field.
+func PostsGTE(v int64) predicate.Model {
+	return predicate.Model(sql.FieldGTE(FieldPosts, v))
+}
+
+// PostsLT applies the LT predicate on the "posts" field.
+func PostsLT(v int64) predicate.Model {
+	return predicate.Model(sql.FieldLT(FieldPosts, v))
+}
+
+// PostsLTE applies the LTE predicate on the "posts" field.
+func PostsLTE(v int64) predicate.Model {
+	return predicate.Model(sql.FieldLTE(FieldPosts, v))
+}
+
+// ImageSrcEQ applies the EQ predicate on the "image_src" field.
+func ImageSrcEQ(v string) predicate.Model {
+	return predicate.Model(sql.FieldEQ(FieldImageSrc, v))
+}
+
+// ImageSrcNEQ applies the NEQ predicate on the "image_src" field.
+func ImageSrcNEQ(v string) predicate.Model {
+	return predicate.Model(sql.FieldNEQ(FieldImageSrc, v))
+}
+
+// ImageSrcIn applies the In predicate on the "image_src" field.
+func ImageSrcIn(vs ...string) predicate.Model {
+	return predicate.Model(sql.FieldIn(FieldImageSrc, vs...))
+}
+
+// ImageSrcNotIn applies the NotIn predicate on the "image_src" field.
+func ImageSrcNotIn(vs ...string) predicate.Model {
+	return predicate.Model(sql.FieldNotIn(FieldImageSrc, vs...))
+}
+
+// ImageSrcGT applies the GT predicate on the "image_src" field.
+func ImageSrcGT(v string) predicate.Model {
+	return predicate.Model(sql.FieldGT(FieldImageSrc, v))
+}
+
+// ImageSrcGTE applies the GTE predicate on the "image_src" field.
+func ImageSrcGTE(v string) predicate.Model {
+	return predicate.Model(sql.FieldGTE(FieldImageSrc, v))
+}
+
+// ImageSrcLT applies the LT predicate on the "image_src" field.
+func ImageSrcLT(v string) predicate.Model {
+	return predicate.Model(sql.FieldLT(FieldImageSrc, v))
+}
+
+// ImageSrcLTE applies the LTE predicate on the "image_src" field.
+func ImageSrcLTE(v string) predicate.Model {
+	return predicate.Model(sql.FieldLTE(FieldImageSrc, v))
+}
+
+// ImageSrcContains applies the Contains predicate on the "image_src" field.
+func ImageSrcContains(v string) predicate.Model {
+	return predicate.Model(sql.FieldContains(FieldImageSrc, v))
+}
+
+// ImageSrcHasPrefix applies the HasPrefix predicate on the "image_src" field.
+func ImageSrcHasPrefix(v string) predicate.Model {
+	return predicate.Model(sql.FieldHasPrefix(FieldImageSrc, v))
+}
+
+// ImageSrcHasSuffix applies the HasSuffix predicate on the "image_src" field.
+func ImageSrcHasSuffix(v string) predicate.Model {
+	return predicate.Model(sql.FieldHasSuffix(FieldImageSrc, v))
+}
+
+// ImageSrcIsNil applies the IsNil predicate on the "image_src" field.
+func ImageSrcIsNil() predicate.Model {
+	return predicate.Model(sql.FieldIsNull(FieldImageSrc))
+}
+
+// ImageSrcNotNil applies the NotNil predicate on the "image_src" field.
+func ImageSrcNotNil() predicate.Model {
+	return predicate.Model(sql.FieldNotNull(FieldImageSrc))
+}
+
+// ImageSrcEqualFold applies the EqualFold predicate on the "image_src" field.
+func ImageSrcEqualFold(v string) predicate.Model {
+	return predicate.Model(sql.FieldEqualFold(FieldImageSrc, v))
+}
+
+// ImageSrcContainsFold applies the ContainsFold predicate on the "image_src" field.
+func ImageSrcContainsFold(v string) predicate.Model {
+	return predicate.Model(sql.FieldContainsFold(FieldImageSrc, v))
+}
+
+// IconSrcEQ applies the EQ predicate on the "icon_src" field.
+func IconSrcEQ(v string) predicate.Model {
+	return predicate.Model(sql.FieldEQ(FieldIconSrc, v))
+}
+
+// IconSrcNEQ applies the NEQ predicate on the "icon_src" field.
+func IconSrcNEQ(v string) predicate.Model {
+	return predicate.Model(sql.FieldNEQ(FieldIconSrc, v))
+}
+
+// IconSrcIn applies the In predicate on the "icon_src" field.
+func IconSrcIn(vs ...string) predicate.Model {
+	return predicate.Model(sql.FieldIn(FieldIconSrc, vs...))
+}
+
+// IconSrcNotIn applies the NotIn predicate on the "icon_src" field.
+func IconSrcNotIn(vs ...string) predicate.Model {
+	return predicate.Model(sql.FieldNotIn(FieldIconSrc, vs...))
+}
+
+// IconSrcGT applies the GT predicate on the "icon_src" field.
+func IconSrcGT(v string) predicate.Model {
+	return predicate.Model(sql.FieldGT(FieldIconSrc, v))
+}
+
+// IconSrcGTE applies the GTE predicate on the "icon_src" field.
+func IconSrcGTE(v string) predicate.Model {
+	return predicate.Model(sql.FieldGTE(FieldIconSrc, v))
+}
+
+// IconSrcLT applies the LT predicate on the "icon_src" field.
+func IconSrcLT(v string) predicate.Model {
+	return predicate.Model(sql.FieldLT(FieldIconSrc, v))
+}
+
+// IconSrcLTE applies the LTE predicate on the "icon_src" field.
+func IconSrcLTE(v string) predicate.Model {
+	return predicate.Model(sql.FieldLTE(FieldIconSrc, v))
+}
+
+// IconSrcContains applies the Contains predicate on the "icon_src" field.
+func IconSrcContains(v string) predicate.Model {
+	return predicate.Model(sql.FieldContains(FieldIconSrc, v))
+}
+
+// IconSrcHasPrefix applies the HasPrefix predicate on the "icon_src" field.
+func IconSrcHasPrefix(v string) predicate.Model {
+	return predicate.Model(sql.FieldHasPrefix(FieldIconSrc, v))
+}
+
+// IconSrcHasSuffix applies the HasSuffix predicate on the "icon_src" field.
+func IconSrcHasSuffix(v string) predicate.Model {
+	return predicate.Model(sql.FieldHasSuffix(FieldIconSrc, v))
+}
+
+// IconSrcIsNil applies the IsNil predicate on the "icon_src" field.
+func IconSrcIsNil() predicate.Model {
+	return predicate.Model(sql.FieldIsNull(FieldIconSrc))
+}
+
+// IconSrcNotNil applies the NotNil predicate on the "icon_src" field.
+func IconSrcNotNil() predicate.Model {
+	return predicate.Model(sql.FieldNotNull(FieldIconSrc))
+}
+
+// IconSrcEqualFold applies the EqualFold predicate on the "icon_src" field.
+func IconSrcEqualFold(v string) predicate.Model {
+	return predicate.Model(sql.FieldEqualFold(FieldIconSrc, v))
+}
+
+// IconSrcContainsFold applies the ContainsFold predicate on the "icon_src" field.
+func IconSrcContainsFold(v string) predicate.Model {
+	return predicate.Model(sql.FieldContainsFold(FieldIconSrc, v))
+}
+
+// HasOptions applies the HasEdge predicate on the "options" edge.
+func HasOptions() predicate.Model {
+	return predicate.Model(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, OptionsTable, OptionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOptionsWith applies the HasEdge predicate on the "options" edge with a given conditions (other predicates).
+func HasOptionsWith(preds ...predicate.SubscriptionOption) predicate.Model {
+	return predicate.Model(func(s *sql.Selector) {
+		step := newOptionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSubscriptions applies the HasEdge predicate on the "subscriptions" edge.
+func HasSubscriptions() predicate.Model {
+	return predicate.Model(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SubscriptionsTable, SubscriptionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSubscriptionsWith applies the HasEdge predicate on the "subscriptions" edge with a given conditions (other predicates).
+func HasSubscriptionsWith(preds ...predicate.Subscription) predicate.Model {
+	return predicate.Model(func(s *sql.Selector) {
+		step := newSubscriptionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Model) predicate.Model {
 	return predicate.Model(sql.AndPredicates(predicates...))
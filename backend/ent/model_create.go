@@ -4,7 +4,10 @@ package ent
 
 import (
 	"arjunmal1311/fans_flow_on_chain/backend/ent/model"
+	"arjunmal1311/fans_flow_on_chain/backend/ent/subscription"
+	"arjunmal1311/fans_flow_on_chain/backend/ent/subscriptionoption"
 	"context"
+	"errors"
 	"fmt"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -18,6 +21,220 @@ type ModelCreate struct {
 	hooks    []Hook
 }
 
+// SetName sets the "name" field.
+func (mc *ModelCreate) SetName(s string) *ModelCreate {
+	mc.mutation.SetName(s)
+	return mc
+}
+
+// SetModelID sets the "model_id" field.
+func (mc *ModelCreate) SetModelID(s string) *ModelCreate {
+	mc.mutation.SetModelID(s)
+	return mc
+}
+
+// SetEmail sets the "email" field.
+func (mc *ModelCreate) SetEmail(s string) *ModelCreate {
+	mc.mutation.SetEmail(s)
+	return mc
+}
+
+// SetWalletAddress sets the "wallet_address" field.
+func (mc *ModelCreate) SetWalletAddress(s string) *ModelCreate {
+	mc.mutation.SetWalletAddress(s)
+	return mc
+}
+
+// SetIpfsURL sets the "ipfs_url" field.
+func (mc *ModelCreate) SetIpfsURL(s string) *ModelCreate {
+	mc.mutation.SetIpfsURL(s)
+	return mc
+}
+
+// SetNillableIpfsURL sets the "ipfs_url" field if the given value is not nil.
+func (mc *ModelCreate) SetNillableIpfsURL(s *string) *ModelCreate {
+	if s != nil {
+		mc.SetIpfsURL(*s)
+	}
+	return mc
+}
+
+// SetOpenaiTokenID sets the "openai_token_id" field.
+func (mc *ModelCreate) SetOpenaiTokenID(s string) *ModelCreate {
+	mc.mutation.SetOpenaiTokenID(s)
+	return mc
+}
+
+// SetNillableOpenaiTokenID sets the "openai_token_id" field if the given value is not nil.
+func (mc *ModelCreate) SetNillableOpenaiTokenID(s *string) *ModelCreate {
+	if s != nil {
+		mc.SetOpenaiTokenID(*s)
+	}
+	return mc
+}
+
+// SetSlug sets the "slug" field.
+func (mc *ModelCreate) SetSlug(s string) *ModelCreate {
+	mc.mutation.SetSlug(s)
+	return mc
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (mc *ModelCreate) SetNillableSlug(s *string) *ModelCreate {
+	if s != nil {
+		mc.SetSlug(*s)
+	}
+	return mc
+}
+
+// SetLocation sets the "location" field.
+func (mc *ModelCreate) SetLocation(s string) *ModelCreate {
+	mc.mutation.SetLocation(s)
+	return mc
+}
+
+// SetNillableLocation sets the "location" field if the given value is not nil.
+func (mc *ModelCreate) SetNillableLocation(s *string) *ModelCreate {
+	if s != nil {
+		mc.SetLocation(*s)
+	}
+	return mc
+}
+
+// SetAboutMe sets the "about_me" field.
+func (mc *ModelCreate) SetAboutMe(s string) *ModelCreate {
+	mc.mutation.SetAboutMe(s)
+	return mc
+}
+
+// SetNillableAboutMe sets the "about_me" field if the given value is not nil.
+func (mc *ModelCreate) SetNillableAboutMe(s *string) *ModelCreate {
+	if s != nil {
+		mc.SetAboutMe(*s)
+	}
+	return mc
+}
+
+// SetValue sets the "value" field.
+func (mc *ModelCreate) SetValue(f float64) *ModelCreate {
+	mc.mutation.SetValue(f)
+	return mc
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (mc *ModelCreate) SetNillableValue(f *float64) *ModelCreate {
+	if f != nil {
+		mc.SetValue(*f)
+	}
+	return mc
+}
+
+// SetViews sets the "views" field.
+func (mc *ModelCreate) SetViews(i int64) *ModelCreate {
+	mc.mutation.SetViews(i)
+	return mc
+}
+
+// SetNillableViews sets the "views" field if the given value is not nil.
+func (mc *ModelCreate) SetNillableViews(i *int64) *ModelCreate {
+	if i != nil {
+		mc.SetViews(*i)
+	}
+	return mc
+}
+
+// SetTease sets the "tease" field.
+func (mc *ModelCreate) SetTease(i int64) *ModelCreate {
+	mc.mutation.SetTease(i)
+	return mc
+}
+
+// SetNillableTease sets the "tease" field if the given value is not nil.
+func (mc *ModelCreate) SetNillableTease(i *int64) *ModelCreate {
+	if i != nil {
+		mc.SetTease(*i)
+	}
+	return mc
+}
+
+// SetPosts sets the "posts" field.
+func (mc *ModelCreate) SetPosts(i int64) *ModelCreate {
+	mc.mutation.SetPosts(i)
+	return mc
+}
+
+// SetNillablePosts sets the "posts" field if the given value is not nil.
+func (mc *ModelCreate) SetNillablePosts(i *int64) *ModelCreate {
+	if i != nil {
+		mc.SetPosts(*i)
+	}
+	return mc
+}
+
+// SetImageSrc sets the "image_src" field.
+func (mc *ModelCreate) SetImageSrc(s string) *ModelCreate {
+	mc.mutation.SetImageSrc(s)
+	return mc
+}
+
+// SetNillableImageSrc sets the "image_src" field if the given value is not nil.
+func (mc *ModelCreate) SetNillableImageSrc(s *string) *ModelCreate {
+	if s != nil {
+		mc.SetImageSrc(*s)
+	}
+	return mc
+}
+
+// SetIconSrc sets the "icon_src" field.
+func (mc *ModelCreate) SetIconSrc(s string) *ModelCreate {
+	mc.mutation.SetIconSrc(s)
+	return mc
+}
+
+// SetNillableIconSrc sets the "icon_src" field if the given value is not nil.
+func (mc *ModelCreate) SetNillableIconSrc(s *string) *ModelCreate {
+	if s != nil {
+		mc.SetIconSrc(*s)
+	}
+	return mc
+}
+
+// SetID sets the "id" field.
+func (mc *ModelCreate) SetID(s string) *ModelCreate {
+	mc.mutation.SetID(s)
+	return mc
+}
+
+// AddOptionIDs adds the "options" edge to the SubscriptionOption entity by IDs.
+func (mc *ModelCreate) AddOptionIDs(ids ...string) *ModelCreate {
+	mc.mutation.AddOptionIDs(ids...)
+	return mc
+}
+
+// AddOptions adds the "options" edges to the SubscriptionOption entity.
+func (mc *ModelCreate) AddOptions(s ...*SubscriptionOption) *ModelCreate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return mc.AddOptionIDs(ids...)
+}
+
+// AddSubscriptionIDs adds the "subscriptions" edge to the Subscription entity by IDs.
+func (mc *ModelCreate) AddSubscriptionIDs(ids ...string) *ModelCreate {
+	mc.mutation.AddSubscriptionIDs(ids...)
+	return mc
+}
+
+// AddSubscriptions adds the "subscriptions" edges to the Subscription entity.
+func (mc *ModelCreate) AddSubscriptions(s ...*Subscription) *ModelCreate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return mc.AddSubscriptionIDs(ids...)
+}
+
 // Mutation returns the ModelMutation object of the builder.
 func (mc *ModelCreate) Mutation() *ModelMutation {
 	return mc.mutation
@@ -25,6 +242,7 @@ func (mc *ModelCreate) Mutation() *ModelMutation {
 
 // Save creates the Model in the database.
 func (mc *ModelCreate) Save(ctx context.Context) (*Model, error) {
+	mc.defaults()
 	return withHooks(ctx, mc.sqlSave, mc.mutation, mc.hooks)
 }
 
@@ -50,8 +268,57 @@ func (mc *ModelCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (mc *ModelCreate) defaults() {
+	if _, ok := mc.mutation.Value(); !ok {
+		v := model.DefaultValue
+		mc.mutation.SetValue(v)
+	}
+	if _, ok := mc.mutation.Views(); !ok {
+		v := model.DefaultViews
+		mc.mutation.SetViews(v)
+	}
+	if _, ok := mc.mutation.Tease(); !ok {
+		v := model.DefaultTease
+		mc.mutation.SetTease(v)
+	}
+	if _, ok := mc.mutation.Posts(); !ok {
+		v := model.DefaultPosts
+		mc.mutation.SetPosts(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (mc *ModelCreate) check() error {
+	if _, ok := mc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Model.name"`)}
+	}
+	if _, ok := mc.mutation.ModelID(); !ok {
+		return &ValidationError{Name: "model_id", err: errors.New(`ent: missing required field "Model.model_id"`)}
+	}
+	if _, ok := mc.mutation.Email(); !ok {
+		return &ValidationError{Name: "email", err: errors.New(`ent: missing required field "Model.email"`)}
+	}
+	if _, ok := mc.mutation.WalletAddress(); !ok {
+		return &ValidationError{Name: "wallet_address", err: errors.New(`ent: missing required field "Model.wallet_address"`)}
+	}
+	if _, ok := mc.mutation.Value(); !ok {
+		return &ValidationError{Name: "value", err: errors.New(`ent: missing required field "Model.value"`)}
+	}
+	if _, ok := mc.mutation.Views(); !ok {
+		return &ValidationError{Name: "views", err: errors.New(`ent: missing required field "Model.views"`)}
+	}
+	if _, ok := mc.mutation.Tease(); !ok {
+		return &ValidationError{Name: "tease", err: errors.New(`ent: missing required field "Model.tease"`)}
+	}
+	if _, ok := mc.mutation.Posts(); !ok {
+		return &ValidationError{Name: "posts", err: errors.New(`ent: missing required field "Model.posts"`)}
+	}
+	if v, ok := mc.mutation.ID(); ok {
+		if err := model.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Model.id": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -66,8 +333,13 @@ func (mc *ModelCreate) sqlSave(ctx context.Context) (*Model, error) {
 		}
 		return nil, err
 	}
-	id := _spec.ID.Value.(int64)
-	_node.ID = int(id)
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Model.ID type: %T", _spec.ID.Value)
+		}
+	}
 	mc.mutation.id = &_node.ID
 	mc.mutation.done = true
 	return _node, nil
@@ -76,8 +348,104 @@ func (mc *ModelCreate) sqlSave(ctx context.Context) (*Model, error) {
 func (mc *ModelCreate) createSpec() (*Model, *sqlgraph.CreateSpec) {
 	var (
 		_node = &Model{config: mc.config}
-		_spec = sqlgraph.NewCreateSpec(model.Table, sqlgraph.NewFieldSpec(model.FieldID, field.TypeInt))
+		_spec = sqlgraph.NewCreateSpec(model.Table, sqlgraph.NewFieldSpec(model.FieldID, field.TypeString))
 	)
+	if id, ok := mc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := mc.mutation.Name(); ok {
+		_spec.SetField(model.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := mc.mutation.ModelID(); ok {
+		_spec.SetField(model.FieldModelID, field.TypeString, value)
+		_node.ModelID = value
+	}
+	if value, ok := mc.mutation.Email(); ok {
+		_spec.SetField(model.FieldEmail, field.TypeString, value)
+		_node.Email = value
+	}
+	if value, ok := mc.mutation.WalletAddress(); ok {
+		_spec.SetField(model.FieldWalletAddress, field.TypeString, value)
+		_node.WalletAddress = value
+	}
+	if value, ok := mc.mutation.IpfsURL(); ok {
+		_spec.SetField(model.FieldIpfsURL, field.TypeString, value)
+		_node.IpfsURL = value
+	}
+	if value, ok := mc.mutation.OpenaiTokenID(); ok {
+		_spec.SetField(model.FieldOpenaiTokenID, field.TypeString, value)
+		_node.OpenaiTokenID = value
+	}
+	if value, ok := mc.mutation.Slug(); ok {
+		_spec.SetField(model.FieldSlug, field.TypeString, value)
+		_node.Slug = &value
+	}
+	if value, ok := mc.mutation.Location(); ok {
+		_spec.SetField(model.FieldLocation, field.TypeString, value)
+		_node.Location = value
+	}
+	if value, ok := mc.mutation.AboutMe(); ok {
+		_spec.SetField(model.FieldAboutMe, field.TypeString, value)
+		_node.AboutMe = value
+	}
+	if value, ok := mc.mutation.Value(); ok {
+		_spec.SetField(model.FieldValue, field.TypeFloat64, value)
+		_node.Value = value
+	}
+	if value, ok := mc.mutation.Views(); ok {
+		_spec.SetField(model.FieldViews, field.TypeInt64, value)
+		_node.Views = value
+	}
+	if value, ok := mc.mutation.Tease(); ok {
+		_spec.SetField(model.FieldTease, field.TypeInt64, value)
+		_node.Tease = value
+	}
+	if value, ok := mc.mutation.Posts(); ok {
+		_spec.SetField(model.FieldPosts, field.TypeInt64, value)
+		_node.Posts = value
+	}
+	if value, ok := mc.mutation.ImageSrc(); ok {
+		_spec.SetField(model.FieldImageSrc, field.TypeString, value)
+		_node.ImageSrc = value
+	}
+	if value, ok := mc.mutation.IconSrc(); ok {
+		_spec.SetField(model.FieldIconSrc, field.TypeString, value)
+		_node.IconSrc = value
+	}
+	if nodes := mc.mutation.OptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   model.OptionsTable,
+			Columns: []string{model.OptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscriptionoption.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.SubscriptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   model.SubscriptionsTable,
+			Columns: []string{model.SubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -99,6 +467,7 @@ func (mcb *ModelCreateBulk) Save(ctx context.Context) ([]*Model, error) {
 	for i := range mcb.builders {
 		func(i int, root context.Context) {
 			builder := mcb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*ModelMutation)
 				if !ok {
@@ -125,10 +494,6 @@ func (mcb *ModelCreateBulk) Save(ctx context.Context) ([]*Model, error) {
 					return nil, err
 				}
 				mutation.id = &nodes[i].ID
-				if specs[i].ID.Value != nil {
-					id := specs[i].ID.Value.(int64)
-					nodes[i].ID = int(id)
-				}
 				mutation.done = true
 				return nodes[i], nil
 			})
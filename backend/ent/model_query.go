@@ -5,7 +5,10 @@ package ent
 import (
 	"arjunmal1311/fans_flow_on_chain/backend/ent/model"
 	"arjunmal1311/fans_flow_on_chain/backend/ent/predicate"
+	"arjunmal1311/fans_flow_on_chain/backend/ent/subscription"
+	"arjunmal1311/fans_flow_on_chain/backend/ent/subscriptionoption"
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -18,10 +21,12 @@ import (
 // ModelQuery is the builder for querying Model entities.
 type ModelQuery struct {
 	config
-	ctx        *QueryContext
-	order      []model.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Model
+	ctx               *QueryContext
+	order             []model.OrderOption
+	inters            []Interceptor
+	predicates        []predicate.Model
+	withOptions       *SubscriptionOptionQuery
+	withSubscriptions *SubscriptionQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -58,6 +63,50 @@ func (mq *ModelQuery) Order(o ...model.OrderOption) *ModelQuery {
 	return mq
 }
 
+// QueryOptions chains the current query on the "options" edge.
+func (mq *ModelQuery) QueryOptions() *SubscriptionOptionQuery {
+	query := (&SubscriptionOptionClient{config: mq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(model.Table, model.FieldID, selector),
+			sqlgraph.To(subscriptionoption.Table, subscriptionoption.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, model.OptionsTable, model.OptionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySubscriptions chains the current query on the "subscriptions" edge.
+func (mq *ModelQuery) QuerySubscriptions() *SubscriptionQuery {
+	query := (&SubscriptionClient{config: mq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(model.Table, model.FieldID, selector),
+			sqlgraph.To(subscription.Table, subscription.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, model.SubscriptionsTable, model.SubscriptionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Model entity from the query.
 // Returns a *NotFoundError when no Model was found.
 func (mq *ModelQuery) First(ctx context.Context) (*Model, error) {
@@ -82,8 +131,8 @@ func (mq *ModelQuery) FirstX(ctx context.Context) *Model {
 
 // FirstID returns the first Model ID from the query.
 // Returns a *NotFoundError when no Model ID was found.
-func (mq *ModelQuery) FirstID(ctx context.Context) (id int, err error) {
-	var ids []int
+func (mq *ModelQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
 	if ids, err = mq.Limit(1).IDs(setContextOp(ctx, mq.ctx, ent.OpQueryFirstID)); err != nil {
 		return
 	}
@@ -95,7 +144,7 @@ func (mq *ModelQuery) FirstID(ctx context.Context) (id int, err error) {
 }
 
 // FirstIDX is like FirstID, but panics if an error occurs.
-func (mq *ModelQuery) FirstIDX(ctx context.Context) int {
+func (mq *ModelQuery) FirstIDX(ctx context.Context) string {
 	id, err := mq.FirstID(ctx)
 	if err != nil && !IsNotFound(err) {
 		panic(err)
@@ -133,8 +182,8 @@ func (mq *ModelQuery) OnlyX(ctx context.Context) *Model {
 // OnlyID is like Only, but returns the only Model ID in the query.
 // Returns a *NotSingularError when more than one Model ID is found.
 // Returns a *NotFoundError when no entities are found.
-func (mq *ModelQuery) OnlyID(ctx context.Context) (id int, err error) {
-	var ids []int
+func (mq *ModelQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
 	if ids, err = mq.Limit(2).IDs(setContextOp(ctx, mq.ctx, ent.OpQueryOnlyID)); err != nil {
 		return
 	}
@@ -150,7 +199,7 @@ func (mq *ModelQuery) OnlyID(ctx context.Context) (id int, err error) {
 }
 
 // OnlyIDX is like OnlyID, but panics if an error occurs.
-func (mq *ModelQuery) OnlyIDX(ctx context.Context) int {
+func (mq *ModelQuery) OnlyIDX(ctx context.Context) string {
 	id, err := mq.OnlyID(ctx)
 	if err != nil {
 		panic(err)
@@ -178,7 +227,7 @@ func (mq *ModelQuery) AllX(ctx context.Context) []*Model {
 }
 
 // IDs executes the query and returns a list of Model IDs.
-func (mq *ModelQuery) IDs(ctx context.Context) (ids []int, err error) {
+func (mq *ModelQuery) IDs(ctx context.Context) (ids []string, err error) {
 	if mq.ctx.Unique == nil && mq.path != nil {
 		mq.Unique(true)
 	}
@@ -190,7 +239,7 @@ func (mq *ModelQuery) IDs(ctx context.Context) (ids []int, err error) {
 }
 
 // IDsX is like IDs, but panics if an error occurs.
-func (mq *ModelQuery) IDsX(ctx context.Context) []int {
+func (mq *ModelQuery) IDsX(ctx context.Context) []string {
 	ids, err := mq.IDs(ctx)
 	if err != nil {
 		panic(err)
@@ -245,19 +294,55 @@ func (mq *ModelQuery) Clone() *ModelQuery {
 		return nil
 	}
 	return &ModelQuery{
-		config:     mq.config,
-		ctx:        mq.ctx.Clone(),
-		order:      append([]model.OrderOption{}, mq.order...),
-		inters:     append([]Interceptor{}, mq.inters...),
-		predicates: append([]predicate.Model{}, mq.predicates...),
+		config:            mq.config,
+		ctx:               mq.ctx.Clone(),
+		order:             append([]model.OrderOption{}, mq.order...),
+		inters:            append([]Interceptor{}, mq.inters...),
+		predicates:        append([]predicate.Model{}, mq.predicates...),
+		withOptions:       mq.withOptions.Clone(),
+		withSubscriptions: mq.withSubscriptions.Clone(),
 		// clone intermediate query.
 		sql:  mq.sql.Clone(),
 		path: mq.path,
 	}
 }
 
+// WithOptions tells the query-builder to eager-load the nodes that are connected to
+// the "options" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *ModelQuery) WithOptions(opts ...func(*SubscriptionOptionQuery)) *ModelQuery {
+	query := (&SubscriptionOptionClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withOptions = query
+	return mq
+}
+
+// WithSubscriptions tells the query-builder to eager-load the nodes that are connected to
+// the "subscriptions" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *ModelQuery) WithSubscriptions(opts ...func(*SubscriptionQuery)) *ModelQuery {
+	query := (&SubscriptionClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withSubscriptions = query
+	return mq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Model.Query().
+//		GroupBy(model.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
 func (mq *ModelQuery) GroupBy(field string, fields ...string) *ModelGroupBy {
 	mq.ctx.Fields = append([]string{field}, fields...)
 	grbuild := &ModelGroupBy{build: mq}
@@ -269,6 +354,16 @@ func (mq *ModelQuery) GroupBy(field string, fields ...string) *ModelGroupBy {
 
 // Select allows the selection one or more fields/columns for the given query,
 // instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.Model.Query().
+//		Select(model.FieldName).
+//		Scan(ctx, &v)
 func (mq *ModelQuery) Select(fields ...string) *ModelSelect {
 	mq.ctx.Fields = append(mq.ctx.Fields, fields...)
 	sbuild := &ModelSelect{ModelQuery: mq}
@@ -310,8 +405,12 @@ func (mq *ModelQuery) prepareQuery(ctx context.Context) error {
 
 func (mq *ModelQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Model, error) {
 	var (
-		nodes = []*Model{}
-		_spec = mq.querySpec()
+		nodes       = []*Model{}
+		_spec       = mq.querySpec()
+		loadedTypes = [2]bool{
+			mq.withOptions != nil,
+			mq.withSubscriptions != nil,
+		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*Model).scanValues(nil, columns)
@@ -319,6 +418,7 @@ func (mq *ModelQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Model,
 	_spec.Assign = func(columns []string, values []any) error {
 		node := &Model{config: mq.config}
 		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
 	for i := range hooks {
@@ -330,9 +430,84 @@ func (mq *ModelQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Model,
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	if query := mq.withOptions; query != nil {
+		if err := mq.loadOptions(ctx, query, nodes,
+			func(n *Model) { n.Edges.Options = []*SubscriptionOption{} },
+			func(n *Model, e *SubscriptionOption) { n.Edges.Options = append(n.Edges.Options, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := mq.withSubscriptions; query != nil {
+		if err := mq.loadSubscriptions(ctx, query, nodes,
+			func(n *Model) { n.Edges.Subscriptions = []*Subscription{} },
+			func(n *Model, e *Subscription) { n.Edges.Subscriptions = append(n.Edges.Subscriptions, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
+func (mq *ModelQuery) loadOptions(ctx context.Context, query *SubscriptionOptionQuery, nodes []*Model, init func(*Model), assign func(*Model, *SubscriptionOption)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Model)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(subscriptionoption.FieldModelID)
+	}
+	query.Where(predicate.SubscriptionOption(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(model.OptionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ModelID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "model_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (mq *ModelQuery) loadSubscriptions(ctx context.Context, query *SubscriptionQuery, nodes []*Model, init func(*Model), assign func(*Model, *Subscription)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Model)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(subscription.FieldModelID)
+	}
+	query.Where(predicate.Subscription(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(model.SubscriptionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ModelID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "model_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
 func (mq *ModelQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := mq.querySpec()
 	_spec.Node.Columns = mq.ctx.Fields
@@ -343,7 +518,7 @@ func (mq *ModelQuery) sqlCount(ctx context.Context) (int, error) {
 }
 
 func (mq *ModelQuery) querySpec() *sqlgraph.QuerySpec {
-	_spec := sqlgraph.NewQuerySpec(model.Table, model.Columns, sqlgraph.NewFieldSpec(model.FieldID, field.TypeInt))
+	_spec := sqlgraph.NewQuerySpec(model.Table, model.Columns, sqlgraph.NewFieldSpec(model.FieldID, field.TypeString))
 	_spec.From = mq.sql
 	if unique := mq.ctx.Unique; unique != nil {
 		_spec.Unique = *unique
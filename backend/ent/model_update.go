@@ -5,6 +5,8 @@ package ent
 import (
 	"arjunmal1311/fans_flow_on_chain/backend/ent/model"
 	"arjunmal1311/fans_flow_on_chain/backend/ent/predicate"
+	"arjunmal1311/fans_flow_on_chain/backend/ent/subscription"
+	"arjunmal1311/fans_flow_on_chain/backend/ent/subscriptionoption"
 	"context"
 	"errors"
 	"fmt"
@@ -27,11 +29,363 @@ func (mu *ModelUpdate) Where(ps ...predicate.Model) *ModelUpdate {
 	return mu
 }
 
+// SetName sets the "name" field.
+func (mu *ModelUpdate) SetName(s string) *ModelUpdate {
+	mu.mutation.SetName(s)
+	return mu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (mu *ModelUpdate) SetNillableName(s *string) *ModelUpdate {
+	if s != nil {
+		mu.SetName(*s)
+	}
+	return mu
+}
+
+// SetModelID sets the "model_id" field.
+func (mu *ModelUpdate) SetModelID(s string) *ModelUpdate {
+	mu.mutation.SetModelID(s)
+	return mu
+}
+
+// SetNillableModelID sets the "model_id" field if the given value is not nil.
+func (mu *ModelUpdate) SetNillableModelID(s *string) *ModelUpdate {
+	if s != nil {
+		mu.SetModelID(*s)
+	}
+	return mu
+}
+
+// SetEmail sets the "email" field.
+func (mu *ModelUpdate) SetEmail(s string) *ModelUpdate {
+	mu.mutation.SetEmail(s)
+	return mu
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (mu *ModelUpdate) SetNillableEmail(s *string) *ModelUpdate {
+	if s != nil {
+		mu.SetEmail(*s)
+	}
+	return mu
+}
+
+// SetWalletAddress sets the "wallet_address" field.
+func (mu *ModelUpdate) SetWalletAddress(s string) *ModelUpdate {
+	mu.mutation.SetWalletAddress(s)
+	return mu
+}
+
+// SetNillableWalletAddress sets the "wallet_address" field if the given value is not nil.
+func (mu *ModelUpdate) SetNillableWalletAddress(s *string) *ModelUpdate {
+	if s != nil {
+		mu.SetWalletAddress(*s)
+	}
+	return mu
+}
+
+// SetIpfsURL sets the "ipfs_url" field.
+func (mu *ModelUpdate) SetIpfsURL(s string) *ModelUpdate {
+	mu.mutation.SetIpfsURL(s)
+	return mu
+}
+
+// SetNillableIpfsURL sets the "ipfs_url" field if the given value is not nil.
+func (mu *ModelUpdate) SetNillableIpfsURL(s *string) *ModelUpdate {
+	if s != nil {
+		mu.SetIpfsURL(*s)
+	}
+	return mu
+}
+
+// ClearIpfsURL clears the value of the "ipfs_url" field.
+func (mu *ModelUpdate) ClearIpfsURL() *ModelUpdate {
+	mu.mutation.ClearIpfsURL()
+	return mu
+}
+
+// SetOpenaiTokenID sets the "openai_token_id" field.
+func (mu *ModelUpdate) SetOpenaiTokenID(s string) *ModelUpdate {
+	mu.mutation.SetOpenaiTokenID(s)
+	return mu
+}
+
+// SetNillableOpenaiTokenID sets the "openai_token_id" field if the given value is not nil.
+func (mu *ModelUpdate) SetNillableOpenaiTokenID(s *string) *ModelUpdate {
+	if s != nil {
+		mu.SetOpenaiTokenID(*s)
+	}
+	return mu
+}
+
+// ClearOpenaiTokenID clears the value of the "openai_token_id" field.
+func (mu *ModelUpdate) ClearOpenaiTokenID() *ModelUpdate {
+	mu.mutation.ClearOpenaiTokenID()
+	return mu
+}
+
+// SetSlug sets the "slug" field.
+func (mu *ModelUpdate) SetSlug(s string) *ModelUpdate {
+	mu.mutation.SetSlug(s)
+	return mu
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (mu *ModelUpdate) SetNillableSlug(s *string) *ModelUpdate {
+	if s != nil {
+		mu.SetSlug(*s)
+	}
+	return mu
+}
+
+// ClearSlug clears the value of the "slug" field.
+func (mu *ModelUpdate) ClearSlug() *ModelUpdate {
+	mu.mutation.ClearSlug()
+	return mu
+}
+
+// SetLocation sets the "location" field.
+func (mu *ModelUpdate) SetLocation(s string) *ModelUpdate {
+	mu.mutation.SetLocation(s)
+	return mu
+}
+
+// SetNillableLocation sets the "location" field if the given value is not nil.
+func (mu *ModelUpdate) SetNillableLocation(s *string) *ModelUpdate {
+	if s != nil {
+		mu.SetLocation(*s)
+	}
+	return mu
+}
+
+// ClearLocation clears the value of the "location" field.
+func (mu *ModelUpdate) ClearLocation() *ModelUpdate {
+	mu.mutation.ClearLocation()
+	return mu
+}
+
+// SetAboutMe sets the "about_me" field.
+func (mu *ModelUpdate) SetAboutMe(s string) *ModelUpdate {
+	mu.mutation.SetAboutMe(s)
+	return mu
+}
+
+// SetNillableAboutMe sets the "about_me" field if the given value is not nil.
+func (mu *ModelUpdate) SetNillableAboutMe(s *string) *ModelUpdate {
+	if s != nil {
+		mu.SetAboutMe(*s)
+	}
+	return mu
+}
+
+// ClearAboutMe clears the value of the "about_me" field.
+func (mu *ModelUpdate) ClearAboutMe() *ModelUpdate {
+	mu.mutation.ClearAboutMe()
+	return mu
+}
+
+// SetValue sets the "value" field.
+func (mu *ModelUpdate) SetValue(f float64) *ModelUpdate {
+	mu.mutation.ResetValue()
+	mu.mutation.SetValue(f)
+	return mu
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (mu *ModelUpdate) SetNillableValue(f *float64) *ModelUpdate {
+	if f != nil {
+		mu.SetValue(*f)
+	}
+	return mu
+}
+
+// AddValue adds f to the "value" field.
+func (mu *ModelUpdate) AddValue(f float64) *ModelUpdate {
+	mu.mutation.AddValue(f)
+	return mu
+}
+
+// SetViews sets the "views" field.
+func (mu *ModelUpdate) SetViews(i int64) *ModelUpdate {
+	mu.mutation.ResetViews()
+	mu.mutation.SetViews(i)
+	return mu
+}
+
+// SetNillableViews sets the "views" field if the given value is not nil.
+func (mu *ModelUpdate) SetNillableViews(i *int64) *ModelUpdate {
+	if i != nil {
+		mu.SetViews(*i)
+	}
+	return mu
+}
+
+// AddViews adds i to the "views" field.
+func (mu *ModelUpdate) AddViews(i int64) *ModelUpdate {
+	mu.mutation.AddViews(i)
+	return mu
+}
+
+// SetTease sets the "tease" field.
+func (mu *ModelUpdate) SetTease(i int64) *ModelUpdate {
+	mu.mutation.ResetTease()
+	mu.mutation.SetTease(i)
+	return mu
+}
+
+// SetNillableTease sets the "tease" field if the given value is not nil.
+func (mu *ModelUpdate) SetNillableTease(i *int64) *ModelUpdate {
+	if i != nil {
+		mu.SetTease(*i)
+	}
+	return mu
+}
+
+// AddTease adds i to the "tease" field.
+func (mu *ModelUpdate) AddTease(i int64) *ModelUpdate {
+	mu.mutation.AddTease(i)
+	return mu
+}
+
+// SetPosts sets the "posts" field.
+func (mu *ModelUpdate) SetPosts(i int64) *ModelUpdate {
+	mu.mutation.ResetPosts()
+	mu.mutation.SetPosts(i)
+	return mu
+}
+
+// SetNillablePosts sets the "posts" field if the given value is not nil.
+func (mu *ModelUpdate) SetNillablePosts(i *int64) *ModelUpdate {
+	if i != nil {
+		mu.SetPosts(*i)
+	}
+	return mu
+}
+
+// AddPosts adds i to the "posts" field.
+func (mu *ModelUpdate) AddPosts(i int64) *ModelUpdate {
+	mu.mutation.AddPosts(i)
+	return mu
+}
+
+// SetImageSrc sets the "image_src" field.
+func (mu *ModelUpdate) SetImageSrc(s string) *ModelUpdate {
+	mu.mutation.SetImageSrc(s)
+	return mu
+}
+
+// SetNillableImageSrc sets the "image_src" field if the given value is not nil.
+func (mu *ModelUpdate) SetNillableImageSrc(s *string) *ModelUpdate {
+	if s != nil {
+		mu.SetImageSrc(*s)
+	}
+	return mu
+}
+
+// ClearImageSrc clears the value of the "image_src" field.
+func (mu *ModelUpdate) ClearImageSrc() *ModelUpdate {
+	mu.mutation.ClearImageSrc()
+	return mu
+}
+
+// SetIconSrc sets the "icon_src" field.
+func (mu *ModelUpdate) SetIconSrc(s string) *ModelUpdate {
+	mu.mutation.SetIconSrc(s)
+	return mu
+}
+
+// SetNillableIconSrc sets the "icon_src" field if the given value is not nil.
+func (mu *ModelUpdate) SetNillableIconSrc(s *string) *ModelUpdate {
+	if s != nil {
+		mu.SetIconSrc(*s)
+	}
+	return mu
+}
+
+// ClearIconSrc clears the value of the "icon_src" field.
+func (mu *ModelUpdate) ClearIconSrc() *ModelUpdate {
+	mu.mutation.ClearIconSrc()
+	return mu
+}
+
+// AddOptionIDs adds the "options" edge to the SubscriptionOption entity by IDs.
+func (mu *ModelUpdate) AddOptionIDs(ids ...string) *ModelUpdate {
+	mu.mutation.AddOptionIDs(ids...)
+	return mu
+}
+
+// AddOptions adds the "options" edges to the SubscriptionOption entity.
+func (mu *ModelUpdate) AddOptions(s ...*SubscriptionOption) *ModelUpdate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return mu.AddOptionIDs(ids...)
+}
+
+// AddSubscriptionIDs adds the "subscriptions" edge to the Subscription entity by IDs.
+func (mu *ModelUpdate) AddSubscriptionIDs(ids ...string) *ModelUpdate {
+	mu.mutation.AddSubscriptionIDs(ids...)
+	return mu
+}
+
+// AddSubscriptions adds the "subscriptions" edges to the Subscription entity.
+func (mu *ModelUpdate) AddSubscriptions(s ...*Subscription) *ModelUpdate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return mu.AddSubscriptionIDs(ids...)
+}
+
 // Mutation returns the ModelMutation object of the builder.
 func (mu *ModelUpdate) Mutation() *ModelMutation {
 	return mu.mutation
 }
 
+// ClearOptions clears all "options" edges to the SubscriptionOption entity.
+func (mu *ModelUpdate) ClearOptions() *ModelUpdate {
+	mu.mutation.ClearOptions()
+	return mu
+}
+
+// RemoveOptionIDs removes the "options" edge to SubscriptionOption entities by IDs.
+func (mu *ModelUpdate) RemoveOptionIDs(ids ...string) *ModelUpdate {
+	mu.mutation.RemoveOptionIDs(ids...)
+	return mu
+}
+
+// RemoveOptions removes "options" edges to SubscriptionOption entities.
+func (mu *ModelUpdate) RemoveOptions(s ...*SubscriptionOption) *ModelUpdate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return mu.RemoveOptionIDs(ids...)
+}
+
+// ClearSubscriptions clears all "subscriptions" edges to the Subscription entity.
+func (mu *ModelUpdate) ClearSubscriptions() *ModelUpdate {
+	mu.mutation.ClearSubscriptions()
+	return mu
+}
+
+// RemoveSubscriptionIDs removes the "subscriptions" edge to Subscription entities by IDs.
+func (mu *ModelUpdate) RemoveSubscriptionIDs(ids ...string) *ModelUpdate {
+	mu.mutation.RemoveSubscriptionIDs(ids...)
+	return mu
+}
+
+// RemoveSubscriptions removes "subscriptions" edges to Subscription entities.
+func (mu *ModelUpdate) RemoveSubscriptions(s ...*Subscription) *ModelUpdate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return mu.RemoveSubscriptionIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (mu *ModelUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, mu.sqlSave, mu.mutation, mu.hooks)
@@ -60,7 +414,7 @@ func (mu *ModelUpdate) ExecX(ctx context.Context) {
 }
 
 func (mu *ModelUpdate) sqlSave(ctx context.Context) (n int, err error) {
-	_spec := sqlgraph.NewUpdateSpec(model.Table, model.Columns, sqlgraph.NewFieldSpec(model.FieldID, field.TypeInt))
+	_spec := sqlgraph.NewUpdateSpec(model.Table, model.Columns, sqlgraph.NewFieldSpec(model.FieldID, field.TypeString))
 	if ps := mu.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
@@ -68,6 +422,174 @@ func (mu *ModelUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if value, ok := mu.mutation.Name(); ok {
+		_spec.SetField(model.FieldName, field.TypeString, value)
+	}
+	if value, ok := mu.mutation.ModelID(); ok {
+		_spec.SetField(model.FieldModelID, field.TypeString, value)
+	}
+	if value, ok := mu.mutation.Email(); ok {
+		_spec.SetField(model.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := mu.mutation.WalletAddress(); ok {
+		_spec.SetField(model.FieldWalletAddress, field.TypeString, value)
+	}
+	if value, ok := mu.mutation.IpfsURL(); ok {
+		_spec.SetField(model.FieldIpfsURL, field.TypeString, value)
+	}
+	if mu.mutation.IpfsURLCleared() {
+		_spec.ClearField(model.FieldIpfsURL, field.TypeString)
+	}
+	if value, ok := mu.mutation.OpenaiTokenID(); ok {
+		_spec.SetField(model.FieldOpenaiTokenID, field.TypeString, value)
+	}
+	if mu.mutation.OpenaiTokenIDCleared() {
+		_spec.ClearField(model.FieldOpenaiTokenID, field.TypeString)
+	}
+	if value, ok := mu.mutation.Slug(); ok {
+		_spec.SetField(model.FieldSlug, field.TypeString, value)
+	}
+	if mu.mutation.SlugCleared() {
+		_spec.ClearField(model.FieldSlug, field.TypeString)
+	}
+	if value, ok := mu.mutation.Location(); ok {
+		_spec.SetField(model.FieldLocation, field.TypeString, value)
+	}
+	if mu.mutation.LocationCleared() {
+		_spec.ClearField(model.FieldLocation, field.TypeString)
+	}
+	if value, ok := mu.mutation.AboutMe(); ok {
+		_spec.SetField(model.FieldAboutMe, field.TypeString, value)
+	}
+	if mu.mutation.AboutMeCleared() {
+		_spec.ClearField(model.FieldAboutMe, field.TypeString)
+	}
+	if value, ok := mu.mutation.Value(); ok {
+		_spec.SetField(model.FieldValue, field.TypeFloat64, value)
+	}
+	if value, ok := mu.mutation.AddedValue(); ok {
+		_spec.AddField(model.FieldValue, field.TypeFloat64, value)
+	}
+	if value, ok := mu.mutation.Views(); ok {
+		_spec.SetField(model.FieldViews, field.TypeInt64, value)
+	}
+	if value, ok := mu.mutation.AddedViews(); ok {
+		_spec.AddField(model.FieldViews, field.TypeInt64, value)
+	}
+	if value, ok := mu.mutation.Tease(); ok {
+		_spec.SetField(model.FieldTease, field.TypeInt64, value)
+	}
+	if value, ok := mu.mutation.AddedTease(); ok {
+		_spec.AddField(model.FieldTease, field.TypeInt64, value)
+	}
+	if value, ok := mu.mutation.Posts(); ok {
+		_spec.SetField(model.FieldPosts, field.TypeInt64, value)
+	}
+	if value, ok := mu.mutation.AddedPosts(); ok {
+		_spec.AddField(model.FieldPosts, field.TypeInt64, value)
+	}
+	if value, ok := mu.mutation.ImageSrc(); ok {
+		_spec.SetField(model.FieldImageSrc, field.TypeString, value)
+	}
+	if mu.mutation.ImageSrcCleared() {
+		_spec.ClearField(model.FieldImageSrc, field.TypeString)
+	}
+	if value, ok := mu.mutation.IconSrc(); ok {
+		_spec.SetField(model.FieldIconSrc, field.TypeString, value)
+	}
+	if mu.mutation.IconSrcCleared() {
+		_spec.ClearField(model.FieldIconSrc, field.TypeString)
+	}
+	if mu.mutation.OptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   model.OptionsTable,
+			Columns: []string{model.OptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscriptionoption.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedOptionsIDs(); len(nodes) > 0 && !mu.mutation.OptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   model.OptionsTable,
+			Columns: []string{model.OptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscriptionoption.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.OptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   model.OptionsTable,
+			Columns: []string{model.OptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscriptionoption.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.SubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   model.SubscriptionsTable,
+			Columns: []string{model.SubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedSubscriptionsIDs(); len(nodes) > 0 && !mu.mutation.SubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   model.SubscriptionsTable,
+			Columns: []string{model.SubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.SubscriptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   model.SubscriptionsTable,
+			Columns: []string{model.SubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, mu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{model.Label}
@@ -88,11 +610,363 @@ type ModelUpdateOne struct {
 	mutation *ModelMutation
 }
 
+// SetName sets the "name" field.
+func (muo *ModelUpdateOne) SetName(s string) *ModelUpdateOne {
+	muo.mutation.SetName(s)
+	return muo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (muo *ModelUpdateOne) SetNillableName(s *string) *ModelUpdateOne {
+	if s != nil {
+		muo.SetName(*s)
+	}
+	return muo
+}
+
+// SetModelID sets the "model_id" field.
+func (muo *ModelUpdateOne) SetModelID(s string) *ModelUpdateOne {
+	muo.mutation.SetModelID(s)
+	return muo
+}
+
+// SetNillableModelID sets the "model_id" field if the given value is not nil.
+func (muo *ModelUpdateOne) SetNillableModelID(s *string) *ModelUpdateOne {
+	if s != nil {
+		muo.SetModelID(*s)
+	}
+	return muo
+}
+
+// SetEmail sets the "email" field.
+func (muo *ModelUpdateOne) SetEmail(s string) *ModelUpdateOne {
+	muo.mutation.SetEmail(s)
+	return muo
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (muo *ModelUpdateOne) SetNillableEmail(s *string) *ModelUpdateOne {
+	if s != nil {
+		muo.SetEmail(*s)
+	}
+	return muo
+}
+
+// SetWalletAddress sets the "wallet_address" field.
+func (muo *ModelUpdateOne) SetWalletAddress(s string) *ModelUpdateOne {
+	muo.mutation.SetWalletAddress(s)
+	return muo
+}
+
+// SetNillableWalletAddress sets the "wallet_address" field if the given value is not nil.
+func (muo *ModelUpdateOne) SetNillableWalletAddress(s *string) *ModelUpdateOne {
+	if s != nil {
+		muo.SetWalletAddress(*s)
+	}
+	return muo
+}
+
+// SetIpfsURL sets the "ipfs_url" field.
+func (muo *ModelUpdateOne) SetIpfsURL(s string) *ModelUpdateOne {
+	muo.mutation.SetIpfsURL(s)
+	return muo
+}
+
+// SetNillableIpfsURL sets the "ipfs_url" field if the given value is not nil.
+func (muo *ModelUpdateOne) SetNillableIpfsURL(s *string) *ModelUpdateOne {
+	if s != nil {
+		muo.SetIpfsURL(*s)
+	}
+	return muo
+}
+
+// ClearIpfsURL clears the value of the "ipfs_url" field.
+func (muo *ModelUpdateOne) ClearIpfsURL() *ModelUpdateOne {
+	muo.mutation.ClearIpfsURL()
+	return muo
+}
+
+// SetOpenaiTokenID sets the "openai_token_id" field.
+func (muo *ModelUpdateOne) SetOpenaiTokenID(s string) *ModelUpdateOne {
+	muo.mutation.SetOpenaiTokenID(s)
+	return muo
+}
+
+// SetNillableOpenaiTokenID sets the "openai_token_id" field if the given value is not nil.
+func (muo *ModelUpdateOne) SetNillableOpenaiTokenID(s *string) *ModelUpdateOne {
+	if s != nil {
+		muo.SetOpenaiTokenID(*s)
+	}
+	return muo
+}
+
+// ClearOpenaiTokenID clears the value of the "openai_token_id" field.
+func (muo *ModelUpdateOne) ClearOpenaiTokenID() *ModelUpdateOne {
+	muo.mutation.ClearOpenaiTokenID()
+	return muo
+}
+
+// SetSlug sets the "slug" field.
+func (muo *ModelUpdateOne) SetSlug(s string) *ModelUpdateOne {
+	muo.mutation.SetSlug(s)
+	return muo
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (muo *ModelUpdateOne) SetNillableSlug(s *string) *ModelUpdateOne {
+	if s != nil {
+		muo.SetSlug(*s)
+	}
+	return muo
+}
+
+// ClearSlug clears the value of the "slug" field.
+func (muo *ModelUpdateOne) ClearSlug() *ModelUpdateOne {
+	muo.mutation.ClearSlug()
+	return muo
+}
+
+// SetLocation sets the "location" field.
+func (muo *ModelUpdateOne) SetLocation(s string) *ModelUpdateOne {
+	muo.mutation.SetLocation(s)
+	return muo
+}
+
+// SetNillableLocation sets the "location" field if the given value is not nil.
+func (muo *ModelUpdateOne) SetNillableLocation(s *string) *ModelUpdateOne {
+	if s != nil {
+		muo.SetLocation(*s)
+	}
+	return muo
+}
+
+// ClearLocation clears the value of the "location" field.
+func (muo *ModelUpdateOne) ClearLocation() *ModelUpdateOne {
+	muo.mutation.ClearLocation()
+	return muo
+}
+
+// SetAboutMe sets the "about_me" field.
+func (muo *ModelUpdateOne) SetAboutMe(s string) *ModelUpdateOne {
+	muo.mutation.SetAboutMe(s)
+	return muo
+}
+
+// SetNillableAboutMe sets the "about_me" field if the given value is not nil.
+func (muo *ModelUpdateOne) SetNillableAboutMe(s *string) *ModelUpdateOne {
+	if s != nil {
+		muo.SetAboutMe(*s)
+	}
+	return muo
+}
+
+// ClearAboutMe clears the value of the "about_me" field.
+func (muo *ModelUpdateOne) ClearAboutMe() *ModelUpdateOne {
+	muo.mutation.ClearAboutMe()
+	return muo
+}
+
+// SetValue sets the "value" field.
+func (muo *ModelUpdateOne) SetValue(f float64) *ModelUpdateOne {
+	muo.mutation.ResetValue()
+	muo.mutation.SetValue(f)
+	return muo
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (muo *ModelUpdateOne) SetNillableValue(f *float64) *ModelUpdateOne {
+	if f != nil {
+		muo.SetValue(*f)
+	}
+	return muo
+}
+
+// AddValue adds f to the "value" field.
+func (muo *ModelUpdateOne) AddValue(f float64) *ModelUpdateOne {
+	muo.mutation.AddValue(f)
+	return muo
+}
+
+// SetViews sets the "views" field.
+func (muo *ModelUpdateOne) SetViews(i int64) *ModelUpdateOne {
+	muo.mutation.ResetViews()
+	muo.mutation.SetViews(i)
+	return muo
+}
+
+// SetNillableViews sets the "views" field if the given value is not nil.
+func (muo *ModelUpdateOne) SetNillableViews(i *int64) *ModelUpdateOne {
+	if i != nil {
+		muo.SetViews(*i)
+	}
+	return muo
+}
+
+// AddViews adds i to the "views" field.
+func (muo *ModelUpdateOne) AddViews(i int64) *ModelUpdateOne {
+	muo.mutation.AddViews(i)
+	return muo
+}
+
+// SetTease sets the "tease" field.
+func (muo *ModelUpdateOne) SetTease(i int64) *ModelUpdateOne {
+	muo.mutation.ResetTease()
+	muo.mutation.SetTease(i)
+	return muo
+}
+
+// SetNillableTease sets the "tease" field if the given value is not nil.
+func (muo *ModelUpdateOne) SetNillableTease(i *int64) *ModelUpdateOne {
+	if i != nil {
+		muo.SetTease(*i)
+	}
+	return muo
+}
+
+// AddTease adds i to the "tease" field.
+func (muo *ModelUpdateOne) AddTease(i int64) *ModelUpdateOne {
+	muo.mutation.AddTease(i)
+	return muo
+}
+
+// SetPosts sets the "posts" field.
+func (muo *ModelUpdateOne) SetPosts(i int64) *ModelUpdateOne {
+	muo.mutation.ResetPosts()
+	muo.mutation.SetPosts(i)
+	return muo
+}
+
+// SetNillablePosts sets the "posts" field if the given value is not nil.
+func (muo *ModelUpdateOne) SetNillablePosts(i *int64) *ModelUpdateOne {
+	if i != nil {
+		muo.SetPosts(*i)
+	}
+	return muo
+}
+
+// AddPosts adds i to the "posts" field.
+func (muo *ModelUpdateOne) AddPosts(i int64) *ModelUpdateOne {
+	muo.mutation.AddPosts(i)
+	return muo
+}
+
+// SetImageSrc sets the "image_src" field.
+func (muo *ModelUpdateOne) SetImageSrc(s string) *ModelUpdateOne {
+	muo.mutation.SetImageSrc(s)
+	return muo
+}
+
+// SetNillableImageSrc sets the "image_src" field if the given value is not nil.
+func (muo *ModelUpdateOne) SetNillableImageSrc(s *string) *ModelUpdateOne {
+	if s != nil {
+		muo.SetImageSrc(*s)
+	}
+	return muo
+}
+
+// ClearImageSrc clears the value of the "image_src" field.
+func (muo *ModelUpdateOne) ClearImageSrc() *ModelUpdateOne {
+	muo.mutation.ClearImageSrc()
+	return muo
+}
+
+// SetIconSrc sets the "icon_src" field.
+func (muo *ModelUpdateOne) SetIconSrc(s string) *ModelUpdateOne {
+	muo.mutation.SetIconSrc(s)
+	return muo
+}
+
+// SetNillableIconSrc sets the "icon_src" field if the given value is not nil.
+func (muo *ModelUpdateOne) SetNillableIconSrc(s *string) *ModelUpdateOne {
+	if s != nil {
+		muo.SetIconSrc(*s)
+	}
+	return muo
+}
+
+// ClearIconSrc clears the value of the "icon_src" field.
+func (muo *ModelUpdateOne) ClearIconSrc() *ModelUpdateOne {
+	muo.mutation.ClearIconSrc()
+	return muo
+}
+
+// AddOptionIDs adds the "options" edge to the SubscriptionOption entity by IDs.
+func (muo *ModelUpdateOne) AddOptionIDs(ids ...string) *ModelUpdateOne {
+	muo.mutation.AddOptionIDs(ids...)
+	return muo
+}
+
+// AddOptions adds the "options" edges to the SubscriptionOption entity.
+func (muo *ModelUpdateOne) AddOptions(s ...*SubscriptionOption) *ModelUpdateOne {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return muo.AddOptionIDs(ids...)
+}
+
+// AddSubscriptionIDs adds the "subscriptions" edge to the Subscription entity by IDs.
+func (muo *ModelUpdateOne) AddSubscriptionIDs(ids ...string) *ModelUpdateOne {
+	muo.mutation.AddSubscriptionIDs(ids...)
+	return muo
+}
+
+// AddSubscriptions adds the "subscriptions" edges to the Subscription entity.
+func (muo *ModelUpdateOne) AddSubscriptions(s ...*Subscription) *ModelUpdateOne {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return muo.AddSubscriptionIDs(ids...)
+}
+
 // Mutation returns the ModelMutation object of the builder.
 func (muo *ModelUpdateOne) Mutation() *ModelMutation {
 	return muo.mutation
 }
 
+// ClearOptions clears all "options" edges to the SubscriptionOption entity.
+func (muo *ModelUpdateOne) ClearOptions() *ModelUpdateOne {
+	muo.mutation.ClearOptions()
+	return muo
+}
+
+// RemoveOptionIDs removes the "options" edge to SubscriptionOption entities by IDs.
+func (muo *ModelUpdateOne) RemoveOptionIDs(ids ...string) *ModelUpdateOne {
+	muo.mutation.RemoveOptionIDs(ids...)
+	return muo
+}
+
+// RemoveOptions removes "options" edges to SubscriptionOption entities.
+func (muo *ModelUpdateOne) RemoveOptions(s ...*SubscriptionOption) *ModelUpdateOne {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return muo.RemoveOptionIDs(ids...)
+}
+
+// ClearSubscriptions clears all "subscriptions" edges to the Subscription entity.
+func (muo *ModelUpdateOne) ClearSubscriptions() *ModelUpdateOne {
+	muo.mutation.ClearSubscriptions()
+	return muo
+}
+
+// RemoveSubscriptionIDs removes the "subscriptions" edge to Subscription entities by IDs.
+func (muo *ModelUpdateOne) RemoveSubscriptionIDs(ids ...string) *ModelUpdateOne {
+	muo.mutation.RemoveSubscriptionIDs(ids...)
+	return muo
+}
+
+// RemoveSubscriptions removes "subscriptions" edges to Subscription entities.
+func (muo *ModelUpdateOne) RemoveSubscriptions(s ...*Subscription) *ModelUpdateOne {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return muo.RemoveSubscriptionIDs(ids...)
+}
+
 // Where appends a list predicates to the ModelUpdate builder.
 func (muo *ModelUpdateOne) Where(ps ...predicate.Model) *ModelUpdateOne {
 	muo.mutation.Where(ps...)
@@ -134,7 +1008,7 @@ func (muo *ModelUpdateOne) ExecX(ctx context.Context) {
 }
 
 func (muo *ModelUpdateOne) sqlSave(ctx context.Context) (_node *Model, err error) {
-	_spec := sqlgraph.NewUpdateSpec(model.Table, model.Columns, sqlgraph.NewFieldSpec(model.FieldID, field.TypeInt))
+	_spec := sqlgraph.NewUpdateSpec(model.Table, model.Columns, sqlgraph.NewFieldSpec(model.FieldID, field.TypeString))
 	id, ok := muo.mutation.ID()
 	if !ok {
 		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Model.id" for update`)}
@@ -159,6 +1033,174 @@ func (muo *ModelUpdateOne) sqlSave(ctx context.Context) (_node *Model, err error
 			}
 		}
 	}
+	if value, ok := muo.mutation.Name(); ok {
+		_spec.SetField(model.FieldName, field.TypeString, value)
+	}
+	if value, ok := muo.mutation.ModelID(); ok {
+		_spec.SetField(model.FieldModelID, field.TypeString, value)
+	}
+	if value, ok := muo.mutation.Email(); ok {
+		_spec.SetField(model.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := muo.mutation.WalletAddress(); ok {
+		_spec.SetField(model.FieldWalletAddress, field.TypeString, value)
+	}
+	if value, ok := muo.mutation.IpfsURL(); ok {
+		_spec.SetField(model.FieldIpfsURL, field.TypeString, value)
+	}
+	if muo.mutation.IpfsURLCleared() {
+		_spec.ClearField(model.FieldIpfsURL, field.TypeString)
+	}
+	if value, ok := muo.mutation.OpenaiTokenID(); ok {
+		_spec.SetField(model.FieldOpenaiTokenID, field.TypeString, value)
+	}
+	if muo.mutation.OpenaiTokenIDCleared() {
+		_spec.ClearField(model.FieldOpenaiTokenID, field.TypeString)
+	}
+	if value, ok := muo.mutation.Slug(); ok {
+		_spec.SetField(model.FieldSlug, field.TypeString, value)
+	}
+	if muo.mutation.SlugCleared() {
+		_spec.ClearField(model.FieldSlug, field.TypeString)
+	}
+	if value, ok := muo.mutation.Location(); ok {
+		_spec.SetField(model.FieldLocation, field.TypeString, value)
+	}
+	if muo.mutation.LocationCleared() {
+		_spec.ClearField(model.FieldLocation, field.TypeString)
+	}
+	if value, ok := muo.mutation.AboutMe(); ok {
+		_spec.SetField(model.FieldAboutMe, field.TypeString, value)
+	}
+	if muo.mutation.AboutMeCleared() {
+		_spec.ClearField(model.FieldAboutMe, field.TypeString)
+	}
+	if value, ok := muo.mutation.Value(); ok {
+		_spec.SetField(model.FieldValue, field.TypeFloat64, value)
+	}
+	if value, ok := muo.mutation.AddedValue(); ok {
+		_spec.AddField(model.FieldValue, field.TypeFloat64, value)
+	}
+	if value, ok := muo.mutation.Views(); ok {
+		_spec.SetField(model.FieldViews, field.TypeInt64, value)
+	}
+	if value, ok := muo.mutation.AddedViews(); ok {
+		_spec.AddField(model.FieldViews, field.TypeInt64, value)
+	}
+	if value, ok := muo.mutation.Tease(); ok {
+		_spec.SetField(model.FieldTease, field.TypeInt64, value)
+	}
+	if value, ok := muo.mutation.AddedTease(); ok {
+		_spec.AddField(model.FieldTease, field.TypeInt64, value)
+	}
+	if value, ok := muo.mutation.Posts(); ok {
+		_spec.SetField(model.FieldPosts, field.TypeInt64, value)
+	}
+	if value, ok := muo.mutation.AddedPosts(); ok {
+		_spec.AddField(model.FieldPosts, field.TypeInt64, value)
+	}
+	if value, ok := muo.mutation.ImageSrc(); ok {
+		_spec.SetField(model.FieldImageSrc, field.TypeString, value)
+	}
+	if muo.mutation.ImageSrcCleared() {
+		_spec.ClearField(model.FieldImageSrc, field.TypeString)
+	}
+	if value, ok := muo.mutation.IconSrc(); ok {
+		_spec.SetField(model.FieldIconSrc, field.TypeString, value)
+	}
+	if muo.mutation.IconSrcCleared() {
+		_spec.ClearField(model.FieldIconSrc, field.TypeString)
+	}
+	if muo.mutation.OptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   model.OptionsTable,
+			Columns: []string{model.OptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscriptionoption.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RemovedOptionsIDs(); len(nodes) > 0 && !muo.mutation.OptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   model.OptionsTable,
+			Columns: []string{model.OptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscriptionoption.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.OptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   model.OptionsTable,
+			Columns: []string{model.OptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscriptionoption.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if muo.mutation.SubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   model.SubscriptionsTable,
+			Columns: []string{model.SubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RemovedSubscriptionsIDs(); len(nodes) > 0 && !muo.mutation.SubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   model.SubscriptionsTable,
+			Columns: []string{model.SubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.SubscriptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   model.SubscriptionsTable,
+			Columns: []string{model.SubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Model{config: muo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
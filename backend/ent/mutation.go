@@ -3,11 +3,16 @@
 package ent
 
 import (
+	"arjunmal1311/fans_flow_on_chain/backend/ent/model"
 	"arjunmal1311/fans_flow_on_chain/backend/ent/predicate"
+	"arjunmal1311/fans_flow_on_chain/backend/ent/subscription"
+	"arjunmal1311/fans_flow_on_chain/backend/ent/subscriptionoption"
+	"arjunmal1311/fans_flow_on_chain/backend/ent/user"
 	"context"
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -22,24 +27,47 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeModel                = "Model"
-	TypeSubscription         = "Subscription"
-	TypeSubscriptionMetis    = "SubscriptionMetis"
-	TypeSubscriptionMoonbeam = "SubscriptionMoonbeam"
-	TypeSubscriptionZkEVM    = "SubscriptionZkEVM"
-	TypeUser                 = "User"
+	TypeModel              = "Model"
+	TypeSubscription       = "Subscription"
+	TypeSubscriptionOption = "SubscriptionOption"
+	TypeUser               = "User"
 )
 
 // ModelMutation represents an operation that mutates the Model nodes in the graph.
 type ModelMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*Model, error)
-	predicates    []predicate.Model
+	op                   Op
+	typ                  string
+	id                   *string
+	name                 *string
+	model_id             *string
+	email                *string
+	wallet_address       *string
+	ipfs_url             *string
+	openai_token_id      *string
+	slug                 *string
+	location             *string
+	about_me             *string
+	value                *float64
+	addvalue             *float64
+	views                *int64
+	addviews             *int64
+	tease                *int64
+	addtease             *int64
+	posts                *int64
+	addposts             *int64
+	image_src            *string
+	icon_src             *string
+	clearedFields        map[string]struct{}
+	options              map[string]struct{}
+	removedoptions       map[string]struct{}
+	clearedoptions       bool
+	subscriptions        map[string]struct{}
+	removedsubscriptions map[string]struct{}
+	clearedsubscriptions bool
+	done                 bool
+	oldValue             func(context.Context) (*Model, error)
+	predicates           []predicate.Model
 }
 
 var _ ent.Mutation = (*ModelMutation)(nil)
@@ -62,7 +90,7 @@ func newModelMutation(c config, op Op, opts ...modelOption) *ModelMutation {
 }
 
 // withModelID sets the ID field of the mutation.
-func withModelID(id int) modelOption {
+func withModelID(id string) modelOption {
 	return func(m *ModelMutation) {
 		var (
 			err   error
@@ -112,9 +140,15 @@ func (m ModelMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Model entities.
+func (m *ModelMutation) SetID(id string) {
+	m.id = &id
+}
+
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ModelMutation) ID() (id int, exists bool) {
+func (m *ModelMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -125,12 +159,12 @@ func (m *ModelMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ModelMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ModelMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []int{id}, nil
+			return []string{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
@@ -140,543 +174,2203 @@ func (m *ModelMutation) IDs(ctx context.Context) ([]int, error) {
 	}
 }
 
-// Where appends a list predicates to the ModelMutation builder.
-func (m *ModelMutation) Where(ps ...predicate.Model) {
-	m.predicates = append(m.predicates, ps...)
+// SetName sets the "name" field.
+func (m *ModelMutation) SetName(s string) {
+	m.name = &s
 }
 
-// WhereP appends storage-level predicates to the ModelMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ModelMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Model, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// Name returns the value of the "name" field in the mutation.
+func (m *ModelMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *ModelMutation) Op() Op {
-	return m.op
+// OldName returns the old "name" field's value of the Model entity.
+// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *ModelMutation) SetOp(op Op) {
-	m.op = op
+// ResetName resets all changes to the "name" field.
+func (m *ModelMutation) ResetName() {
+	m.name = nil
 }
 
-// Type returns the node type of this mutation (Model).
-func (m *ModelMutation) Type() string {
-	return m.typ
+// SetModelID sets the "model_id" field.
+func (m *ModelMutation) SetModelID(s string) {
+	m.model_id = &s
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *ModelMutation) Fields() []string {
-	fields := make([]string, 0, 0)
-	return fields
+// ModelID returns the value of the "model_id" field in the mutation.
+func (m *ModelMutation) ModelID() (r string, exists bool) {
+	v := m.model_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *ModelMutation) Field(name string) (ent.Value, bool) {
-	return nil, false
+// OldModelID returns the old "model_id" field's value of the Model entity.
+// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelMutation) OldModelID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModelID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModelID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModelID: %w", err)
+	}
+	return oldValue.ModelID, nil
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *ModelMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	return nil, fmt.Errorf("unknown Model field %s", name)
+// ResetModelID resets all changes to the "model_id" field.
+func (m *ModelMutation) ResetModelID() {
+	m.model_id = nil
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ModelMutation) SetField(name string, value ent.Value) error {
-	switch name {
+// SetEmail sets the "email" field.
+func (m *ModelMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *ModelMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Model field %s", name)
+	return *v, true
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *ModelMutation) AddedFields() []string {
-	return nil
+// OldEmail returns the old "email" field's value of the Model entity.
+// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *ModelMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// ResetEmail resets all changes to the "email" field.
+func (m *ModelMutation) ResetEmail() {
+	m.email = nil
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ModelMutation) AddField(name string, value ent.Value) error {
-	return fmt.Errorf("unknown Model numeric field %s", name)
+// SetWalletAddress sets the "wallet_address" field.
+func (m *ModelMutation) SetWalletAddress(s string) {
+	m.wallet_address = &s
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *ModelMutation) ClearedFields() []string {
-	return nil
+// WalletAddress returns the value of the "wallet_address" field in the mutation.
+func (m *ModelMutation) WalletAddress() (r string, exists bool) {
+	v := m.wallet_address
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *ModelMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// OldWalletAddress returns the old "wallet_address" field's value of the Model entity.
+// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelMutation) OldWalletAddress(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWalletAddress is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWalletAddress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWalletAddress: %w", err)
+	}
+	return oldValue.WalletAddress, nil
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *ModelMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Model nullable field %s", name)
+// ResetWalletAddress resets all changes to the "wallet_address" field.
+func (m *ModelMutation) ResetWalletAddress() {
+	m.wallet_address = nil
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *ModelMutation) ResetField(name string) error {
-	return fmt.Errorf("unknown Model field %s", name)
+// SetIpfsURL sets the "ipfs_url" field.
+func (m *ModelMutation) SetIpfsURL(s string) {
+	m.ipfs_url = &s
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ModelMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// IpfsURL returns the value of the "ipfs_url" field in the mutation.
+func (m *ModelMutation) IpfsURL() (r string, exists bool) {
+	v := m.ipfs_url
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *ModelMutation) AddedIDs(name string) []ent.Value {
-	return nil
+// OldIpfsURL returns the old "ipfs_url" field's value of the Model entity.
+// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelMutation) OldIpfsURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIpfsURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIpfsURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIpfsURL: %w", err)
+	}
+	return oldValue.IpfsURL, nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ModelMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// ClearIpfsURL clears the value of the "ipfs_url" field.
+func (m *ModelMutation) ClearIpfsURL() {
+	m.ipfs_url = nil
+	m.clearedFields[model.FieldIpfsURL] = struct{}{}
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *ModelMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// IpfsURLCleared returns if the "ipfs_url" field was cleared in this mutation.
+func (m *ModelMutation) IpfsURLCleared() bool {
+	_, ok := m.clearedFields[model.FieldIpfsURL]
+	return ok
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ModelMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// ResetIpfsURL resets all changes to the "ipfs_url" field.
+func (m *ModelMutation) ResetIpfsURL() {
+	m.ipfs_url = nil
+	delete(m.clearedFields, model.FieldIpfsURL)
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *ModelMutation) EdgeCleared(name string) bool {
-	return false
+// SetOpenaiTokenID sets the "openai_token_id" field.
+func (m *ModelMutation) SetOpenaiTokenID(s string) {
+	m.openai_token_id = &s
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *ModelMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Model unique edge %s", name)
+// OpenaiTokenID returns the value of the "openai_token_id" field in the mutation.
+func (m *ModelMutation) OpenaiTokenID() (r string, exists bool) {
+	v := m.openai_token_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *ModelMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Model edge %s", name)
+// OldOpenaiTokenID returns the old "openai_token_id" field's value of the Model entity.
+// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelMutation) OldOpenaiTokenID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOpenaiTokenID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOpenaiTokenID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOpenaiTokenID: %w", err)
+	}
+	return oldValue.OpenaiTokenID, nil
 }
 
-// SubscriptionMutation represents an operation that mutates the Subscription nodes in the graph.
-type SubscriptionMutation struct {
-	config
-	op            Op
-	typ           string
-	id            *int
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*Subscription, error)
-	predicates    []predicate.Subscription
+// ClearOpenaiTokenID clears the value of the "openai_token_id" field.
+func (m *ModelMutation) ClearOpenaiTokenID() {
+	m.openai_token_id = nil
+	m.clearedFields[model.FieldOpenaiTokenID] = struct{}{}
 }
 
-var _ ent.Mutation = (*SubscriptionMutation)(nil)
+// OpenaiTokenIDCleared returns if the "openai_token_id" field was cleared in this mutation.
+func (m *ModelMutation) OpenaiTokenIDCleared() bool {
+	_, ok := m.clearedFields[model.FieldOpenaiTokenID]
+	return ok
+}
 
-// subscriptionOption allows management of the mutation configuration using functional options.
-type subscriptionOption func(*SubscriptionMutation)
+// ResetOpenaiTokenID resets all changes to the "openai_token_id" field.
+func (m *ModelMutation) ResetOpenaiTokenID() {
+	m.openai_token_id = nil
+	delete(m.clearedFields, model.FieldOpenaiTokenID)
+}
 
-// newSubscriptionMutation creates new mutation for the Subscription entity.
-func newSubscriptionMutation(c config, op Op, opts ...subscriptionOption) *SubscriptionMutation {
-	m := &SubscriptionMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeSubscription,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
-	}
-	return m
+// SetSlug sets the "slug" field.
+func (m *ModelMutation) SetSlug(s string) {
+	m.slug = &s
 }
 
-// withSubscriptionID sets the ID field of the mutation.
-func withSubscriptionID(id int) subscriptionOption {
-	return func(m *SubscriptionMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *Subscription
-		)
-		m.oldValue = func(ctx context.Context) (*Subscription, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().Subscription.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// Slug returns the value of the "slug" field in the mutation.
+func (m *ModelMutation) Slug() (r string, exists bool) {
+	v := m.slug
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// withSubscription sets the old Subscription of the mutation.
-func withSubscription(node *Subscription) subscriptionOption {
-	return func(m *SubscriptionMutation) {
-		m.oldValue = func(context.Context) (*Subscription, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// OldSlug returns the old "slug" field's value of the Model entity.
+// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelMutation) OldSlug(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSlug is only allowed on UpdateOne operations")
 	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSlug requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSlug: %w", err)
+	}
+	return oldValue.Slug, nil
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SubscriptionMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ClearSlug clears the value of the "slug" field.
+func (m *ModelMutation) ClearSlug() {
+	m.slug = nil
+	m.clearedFields[model.FieldSlug] = struct{}{}
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m SubscriptionMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// SlugCleared returns if the "slug" field was cleared in this mutation.
+func (m *ModelMutation) SlugCleared() bool {
+	_, ok := m.clearedFields[model.FieldSlug]
+	return ok
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *SubscriptionMutation) ID() (id int, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
+// ResetSlug resets all changes to the "slug" field.
+func (m *ModelMutation) ResetSlug() {
+	m.slug = nil
+	delete(m.clearedFields, model.FieldSlug)
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *SubscriptionMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Subscription.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// SetLocation sets the "location" field.
+func (m *ModelMutation) SetLocation(s string) {
+	m.location = &s
 }
 
-// Where appends a list predicates to the SubscriptionMutation builder.
-func (m *SubscriptionMutation) Where(ps ...predicate.Subscription) {
-	m.predicates = append(m.predicates, ps...)
+// Location returns the value of the "location" field in the mutation.
+func (m *ModelMutation) Location() (r string, exists bool) {
+	v := m.location
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// WhereP appends storage-level predicates to the SubscriptionMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SubscriptionMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Subscription, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// OldLocation returns the old "location" field's value of the Model entity.
+// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelMutation) OldLocation(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLocation is only allowed on UpdateOne operations")
 	}
-	m.Where(p...)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLocation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLocation: %w", err)
+	}
+	return oldValue.Location, nil
 }
 
-// Op returns the operation name.
-func (m *SubscriptionMutation) Op() Op {
-	return m.op
+// ClearLocation clears the value of the "location" field.
+func (m *ModelMutation) ClearLocation() {
+	m.location = nil
+	m.clearedFields[model.FieldLocation] = struct{}{}
 }
 
-// SetOp allows setting the mutation operation.
-func (m *SubscriptionMutation) SetOp(op Op) {
-	m.op = op
+// LocationCleared returns if the "location" field was cleared in this mutation.
+func (m *ModelMutation) LocationCleared() bool {
+	_, ok := m.clearedFields[model.FieldLocation]
+	return ok
 }
 
-// Type returns the node type of this mutation (Subscription).
-func (m *SubscriptionMutation) Type() string {
-	return m.typ
+// ResetLocation resets all changes to the "location" field.
+func (m *ModelMutation) ResetLocation() {
+	m.location = nil
+	delete(m.clearedFields, model.FieldLocation)
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *SubscriptionMutation) Fields() []string {
-	fields := make([]string, 0, 0)
-	return fields
+// SetAboutMe sets the "about_me" field.
+func (m *ModelMutation) SetAboutMe(s string) {
+	m.about_me = &s
+}
+
+// AboutMe returns the value of the "about_me" field in the mutation.
+func (m *ModelMutation) AboutMe() (r string, exists bool) {
+	v := m.about_me
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAboutMe returns the old "about_me" field's value of the Model entity.
+// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelMutation) OldAboutMe(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAboutMe is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAboutMe requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAboutMe: %w", err)
+	}
+	return oldValue.AboutMe, nil
+}
+
+// ClearAboutMe clears the value of the "about_me" field.
+func (m *ModelMutation) ClearAboutMe() {
+	m.about_me = nil
+	m.clearedFields[model.FieldAboutMe] = struct{}{}
+}
+
+// AboutMeCleared returns if the "about_me" field was cleared in this mutation.
+func (m *ModelMutation) AboutMeCleared() bool {
+	_, ok := m.clearedFields[model.FieldAboutMe]
+	return ok
+}
+
+// ResetAboutMe resets all changes to the "about_me" field.
+func (m *ModelMutation) ResetAboutMe() {
+	m.about_me = nil
+	delete(m.clearedFields, model.FieldAboutMe)
+}
+
+// SetValue sets the "value" field.
+func (m *ModelMutation) SetValue(f float64) {
+	m.value = &f
+	m.addvalue = nil
+}
+
+// Value returns the value of the "value" field in the mutation.
+func (m *ModelMutation) Value() (r float64, exists bool) {
+	v := m.value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValue returns the old "value" field's value of the Model entity.
+// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelMutation) OldValue(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+	}
+	return oldValue.Value, nil
+}
+
+// AddValue adds f to the "value" field.
+func (m *ModelMutation) AddValue(f float64) {
+	if m.addvalue != nil {
+		*m.addvalue += f
+	} else {
+		m.addvalue = &f
+	}
+}
+
+// AddedValue returns the value that was added to the "value" field in this mutation.
+func (m *ModelMutation) AddedValue() (r float64, exists bool) {
+	v := m.addvalue
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetValue resets all changes to the "value" field.
+func (m *ModelMutation) ResetValue() {
+	m.value = nil
+	m.addvalue = nil
+}
+
+// SetViews sets the "views" field.
+func (m *ModelMutation) SetViews(i int64) {
+	m.views = &i
+	m.addviews = nil
+}
+
+// Views returns the value of the "views" field in the mutation.
+func (m *ModelMutation) Views() (r int64, exists bool) {
+	v := m.views
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldViews returns the old "views" field's value of the Model entity.
+// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelMutation) OldViews(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldViews is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldViews requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldViews: %w", err)
+	}
+	return oldValue.Views, nil
+}
+
+// AddViews adds i to the "views" field.
+func (m *ModelMutation) AddViews(i int64) {
+	if m.addviews != nil {
+		*m.addviews += i
+	} else {
+		m.addviews = &i
+	}
+}
+
+// AddedViews returns the value that was added to the "views" field in this mutation.
+func (m *ModelMutation) AddedViews() (r int64, exists bool) {
+	v := m.addviews
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetViews resets all changes to the "views" field.
+func (m *ModelMutation) ResetViews() {
+	m.views = nil
+	m.addviews = nil
+}
+
+// SetTease sets the "tease" field.
+func (m *ModelMutation) SetTease(i int64) {
+	m.tease = &i
+	m.addtease = nil
+}
+
+// Tease returns the value of the "tease" field in the mutation.
+func (m *ModelMutation) Tease() (r int64, exists bool) {
+	v := m.tease
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTease returns the old "tease" field's value of the Model entity.
+// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelMutation) OldTease(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTease is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTease requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTease: %w", err)
+	}
+	return oldValue.Tease, nil
+}
+
+// AddTease adds i to the "tease" field.
+func (m *ModelMutation) AddTease(i int64) {
+	if m.addtease != nil {
+		*m.addtease += i
+	} else {
+		m.addtease = &i
+	}
+}
+
+// AddedTease returns the value that was added to the "tease" field in this mutation.
+func (m *ModelMutation) AddedTease() (r int64, exists bool) {
+	v := m.addtease
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTease resets all changes to the "tease" field.
+func (m *ModelMutation) ResetTease() {
+	m.tease = nil
+	m.addtease = nil
+}
+
+// SetPosts sets the "posts" field.
+func (m *ModelMutation) SetPosts(i int64) {
+	m.posts = &i
+	m.addposts = nil
+}
+
+// Posts returns the value of the "posts" field in the mutation.
+func (m *ModelMutation) Posts() (r int64, exists bool) {
+	v := m.posts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPosts returns the old "posts" field's value of the Model entity.
+// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelMutation) OldPosts(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPosts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPosts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPosts: %w", err)
+	}
+	return oldValue.Posts, nil
+}
+
+// AddPosts adds i to the "posts" field.
+func (m *ModelMutation) AddPosts(i int64) {
+	if m.addposts != nil {
+		*m.addposts += i
+	} else {
+		m.addposts = &i
+	}
+}
+
+// AddedPosts returns the value that was added to the "posts" field in this mutation.
+func (m *ModelMutation) AddedPosts() (r int64, exists bool) {
+	v := m.addposts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPosts resets all changes to the "posts" field.
+func (m *ModelMutation) ResetPosts() {
+	m.posts = nil
+	m.addposts = nil
+}
+
+// SetImageSrc sets the "image_src" field.
+func (m *ModelMutation) SetImageSrc(s string) {
+	m.image_src = &s
+}
+
+// ImageSrc returns the value of the "image_src" field in the mutation.
+func (m *ModelMutation) ImageSrc() (r string, exists bool) {
+	v := m.image_src
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldImageSrc returns the old "image_src" field's value of the Model entity.
+// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelMutation) OldImageSrc(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldImageSrc is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldImageSrc requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldImageSrc: %w", err)
+	}
+	return oldValue.ImageSrc, nil
+}
+
+// ClearImageSrc clears the value of the "image_src" field.
+func (m *ModelMutation) ClearImageSrc() {
+	m.image_src = nil
+	m.clearedFields[model.FieldImageSrc] = struct{}{}
+}
+
+// ImageSrcCleared returns if the "image_src" field was cleared in this mutation.
+func (m *ModelMutation) ImageSrcCleared() bool {
+	_, ok := m.clearedFields[model.FieldImageSrc]
+	return ok
+}
+
+// ResetImageSrc resets all changes to the "image_src" field.
+func (m *ModelMutation) ResetImageSrc() {
+	m.image_src = nil
+	delete(m.clearedFields, model.FieldImageSrc)
+}
+
+// SetIconSrc sets the "icon_src" field.
+func (m *ModelMutation) SetIconSrc(s string) {
+	m.icon_src = &s
+}
+
+// IconSrc returns the value of the "icon_src" field in the mutation.
+func (m *ModelMutation) IconSrc() (r string, exists bool) {
+	v := m.icon_src
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIconSrc returns the old "icon_src" field's value of the Model entity.
+// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelMutation) OldIconSrc(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIconSrc is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIconSrc requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIconSrc: %w", err)
+	}
+	return oldValue.IconSrc, nil
+}
+
+// ClearIconSrc clears the value of the "icon_src" field.
+func (m *ModelMutation) ClearIconSrc() {
+	m.icon_src = nil
+	m.clearedFields[model.FieldIconSrc] = struct{}{}
+}
+
+// IconSrcCleared returns if the "icon_src" field was cleared in this mutation.
+func (m *ModelMutation) IconSrcCleared() bool {
+	_, ok := m.clearedFields[model.FieldIconSrc]
+	return ok
+}
+
+// ResetIconSrc resets all changes to the "icon_src" field.
+func (m *ModelMutation) ResetIconSrc() {
+	m.icon_src = nil
+	delete(m.clearedFields, model.FieldIconSrc)
+}
+
+// AddOptionIDs adds the "options" edge to the SubscriptionOption entity by ids.
+func (m *ModelMutation) AddOptionIDs(ids ...string) {
+	if m.options == nil {
+		m.options = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.options[ids[i]] = struct{}{}
+	}
+}
+
+// ClearOptions clears the "options" edge to the SubscriptionOption entity.
+func (m *ModelMutation) ClearOptions() {
+	m.clearedoptions = true
+}
+
+// OptionsCleared reports if the "options" edge to the SubscriptionOption entity was cleared.
+func (m *ModelMutation) OptionsCleared() bool {
+	return m.clearedoptions
+}
+
+// RemoveOptionIDs removes the "options" edge to the SubscriptionOption entity by IDs.
+func (m *ModelMutation) RemoveOptionIDs(ids ...string) {
+	if m.removedoptions == nil {
+		m.removedoptions = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.options, ids[i])
+		m.removedoptions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedOptions returns the removed IDs of the "options" edge to the SubscriptionOption entity.
+func (m *ModelMutation) RemovedOptionsIDs() (ids []string) {
+	for id := range m.removedoptions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// OptionsIDs returns the "options" edge IDs in the mutation.
+func (m *ModelMutation) OptionsIDs() (ids []string) {
+	for id := range m.options {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetOptions resets all changes to the "options" edge.
+func (m *ModelMutation) ResetOptions() {
+	m.options = nil
+	m.clearedoptions = false
+	m.removedoptions = nil
+}
+
+// AddSubscriptionIDs adds the "subscriptions" edge to the Subscription entity by ids.
+func (m *ModelMutation) AddSubscriptionIDs(ids ...string) {
+	if m.subscriptions == nil {
+		m.subscriptions = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.subscriptions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSubscriptions clears the "subscriptions" edge to the Subscription entity.
+func (m *ModelMutation) ClearSubscriptions() {
+	m.clearedsubscriptions = true
+}
+
+// SubscriptionsCleared reports if the "subscriptions" edge to the Subscription entity was cleared.
+func (m *ModelMutation) SubscriptionsCleared() bool {
+	return m.clearedsubscriptions
+}
+
+// RemoveSubscriptionIDs removes the "subscriptions" edge to the Subscription entity by IDs.
+func (m *ModelMutation) RemoveSubscriptionIDs(ids ...string) {
+	if m.removedsubscriptions == nil {
+		m.removedsubscriptions = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.subscriptions, ids[i])
+		m.removedsubscriptions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSubscriptions returns the removed IDs of the "subscriptions" edge to the Subscription entity.
+func (m *ModelMutation) RemovedSubscriptionsIDs() (ids []string) {
+	for id := range m.removedsubscriptions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SubscriptionsIDs returns the "subscriptions" edge IDs in the mutation.
+func (m *ModelMutation) SubscriptionsIDs() (ids []string) {
+	for id := range m.subscriptions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSubscriptions resets all changes to the "subscriptions" edge.
+func (m *ModelMutation) ResetSubscriptions() {
+	m.subscriptions = nil
+	m.clearedsubscriptions = false
+	m.removedsubscriptions = nil
+}
+
+// Where appends a list predicates to the ModelMutation builder.
+func (m *ModelMutation) Where(ps ...predicate.Model) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ModelMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ModelMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Model, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ModelMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ModelMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Model).
+func (m *ModelMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ModelMutation) Fields() []string {
+	fields := make([]string, 0, 15)
+	if m.name != nil {
+		fields = append(fields, model.FieldName)
+	}
+	if m.model_id != nil {
+		fields = append(fields, model.FieldModelID)
+	}
+	if m.email != nil {
+		fields = append(fields, model.FieldEmail)
+	}
+	if m.wallet_address != nil {
+		fields = append(fields, model.FieldWalletAddress)
+	}
+	if m.ipfs_url != nil {
+		fields = append(fields, model.FieldIpfsURL)
+	}
+	if m.openai_token_id != nil {
+		fields = append(fields, model.FieldOpenaiTokenID)
+	}
+	if m.slug != nil {
+		fields = append(fields, model.FieldSlug)
+	}
+	if m.location != nil {
+		fields = append(fields, model.FieldLocation)
+	}
+	if m.about_me != nil {
+		fields = append(fields, model.FieldAboutMe)
+	}
+	if m.value != nil {
+		fields = append(fields, model.FieldValue)
+	}
+	if m.views != nil {
+		fields = append(fields, model.FieldViews)
+	}
+	if m.tease != nil {
+		fields = append(fields, model.FieldTease)
+	}
+	if m.posts != nil {
+		fields = append(fields, model.FieldPosts)
+	}
+	if m.image_src != nil {
+		fields = append(fields, model.FieldImageSrc)
+	}
+	if m.icon_src != nil {
+		fields = append(fields, model.FieldIconSrc)
+	}
+	return fields
 }
 
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SubscriptionMutation) Field(name string) (ent.Value, bool) {
+func (m *ModelMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case model.FieldName:
+		return m.Name()
+	case model.FieldModelID:
+		return m.ModelID()
+	case model.FieldEmail:
+		return m.Email()
+	case model.FieldWalletAddress:
+		return m.WalletAddress()
+	case model.FieldIpfsURL:
+		return m.IpfsURL()
+	case model.FieldOpenaiTokenID:
+		return m.OpenaiTokenID()
+	case model.FieldSlug:
+		return m.Slug()
+	case model.FieldLocation:
+		return m.Location()
+	case model.FieldAboutMe:
+		return m.AboutMe()
+	case model.FieldValue:
+		return m.Value()
+	case model.FieldViews:
+		return m.Views()
+	case model.FieldTease:
+		return m.Tease()
+	case model.FieldPosts:
+		return m.Posts()
+	case model.FieldImageSrc:
+		return m.ImageSrc()
+	case model.FieldIconSrc:
+		return m.IconSrc()
+	}
 	return nil, false
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *SubscriptionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	return nil, fmt.Errorf("unknown Subscription field %s", name)
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ModelMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case model.FieldName:
+		return m.OldName(ctx)
+	case model.FieldModelID:
+		return m.OldModelID(ctx)
+	case model.FieldEmail:
+		return m.OldEmail(ctx)
+	case model.FieldWalletAddress:
+		return m.OldWalletAddress(ctx)
+	case model.FieldIpfsURL:
+		return m.OldIpfsURL(ctx)
+	case model.FieldOpenaiTokenID:
+		return m.OldOpenaiTokenID(ctx)
+	case model.FieldSlug:
+		return m.OldSlug(ctx)
+	case model.FieldLocation:
+		return m.OldLocation(ctx)
+	case model.FieldAboutMe:
+		return m.OldAboutMe(ctx)
+	case model.FieldValue:
+		return m.OldValue(ctx)
+	case model.FieldViews:
+		return m.OldViews(ctx)
+	case model.FieldTease:
+		return m.OldTease(ctx)
+	case model.FieldPosts:
+		return m.OldPosts(ctx)
+	case model.FieldImageSrc:
+		return m.OldImageSrc(ctx)
+	case model.FieldIconSrc:
+		return m.OldIconSrc(ctx)
+	}
+	return nil, fmt.Errorf("unknown Model field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ModelMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case model.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case model.FieldModelID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModelID(v)
+		return nil
+	case model.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case model.FieldWalletAddress:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWalletAddress(v)
+		return nil
+	case model.FieldIpfsURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIpfsURL(v)
+		return nil
+	case model.FieldOpenaiTokenID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOpenaiTokenID(v)
+		return nil
+	case model.FieldSlug:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSlug(v)
+		return nil
+	case model.FieldLocation:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLocation(v)
+		return nil
+	case model.FieldAboutMe:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAboutMe(v)
+		return nil
+	case model.FieldValue:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
+		return nil
+	case model.FieldViews:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetViews(v)
+		return nil
+	case model.FieldTease:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTease(v)
+		return nil
+	case model.FieldPosts:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPosts(v)
+		return nil
+	case model.FieldImageSrc:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImageSrc(v)
+		return nil
+	case model.FieldIconSrc:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIconSrc(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Model field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ModelMutation) AddedFields() []string {
+	var fields []string
+	if m.addvalue != nil {
+		fields = append(fields, model.FieldValue)
+	}
+	if m.addviews != nil {
+		fields = append(fields, model.FieldViews)
+	}
+	if m.addtease != nil {
+		fields = append(fields, model.FieldTease)
+	}
+	if m.addposts != nil {
+		fields = append(fields, model.FieldPosts)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ModelMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case model.FieldValue:
+		return m.AddedValue()
+	case model.FieldViews:
+		return m.AddedViews()
+	case model.FieldTease:
+		return m.AddedTease()
+	case model.FieldPosts:
+		return m.AddedPosts()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ModelMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case model.FieldValue:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddValue(v)
+		return nil
+	case model.FieldViews:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddViews(v)
+		return nil
+	case model.FieldTease:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTease(v)
+		return nil
+	case model.FieldPosts:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPosts(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Model numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ModelMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(model.FieldIpfsURL) {
+		fields = append(fields, model.FieldIpfsURL)
+	}
+	if m.FieldCleared(model.FieldOpenaiTokenID) {
+		fields = append(fields, model.FieldOpenaiTokenID)
+	}
+	if m.FieldCleared(model.FieldSlug) {
+		fields = append(fields, model.FieldSlug)
+	}
+	if m.FieldCleared(model.FieldLocation) {
+		fields = append(fields, model.FieldLocation)
+	}
+	if m.FieldCleared(model.FieldAboutMe) {
+		fields = append(fields, model.FieldAboutMe)
+	}
+	if m.FieldCleared(model.FieldImageSrc) {
+		fields = append(fields, model.FieldImageSrc)
+	}
+	if m.FieldCleared(model.FieldIconSrc) {
+		fields = append(fields, model.FieldIconSrc)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ModelMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ModelMutation) ClearField(name string) error {
+	switch name {
+	case model.FieldIpfsURL:
+		m.ClearIpfsURL()
+		return nil
+	case model.FieldOpenaiTokenID:
+		m.ClearOpenaiTokenID()
+		return nil
+	case model.FieldSlug:
+		m.ClearSlug()
+		return nil
+	case model.FieldLocation:
+		m.ClearLocation()
+		return nil
+	case model.FieldAboutMe:
+		m.ClearAboutMe()
+		return nil
+	case model.FieldImageSrc:
+		m.ClearImageSrc()
+		return nil
+	case model.FieldIconSrc:
+		m.ClearIconSrc()
+		return nil
+	}
+	return fmt.Errorf("unknown Model nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ModelMutation) ResetField(name string) error {
+	switch name {
+	case model.FieldName:
+		m.ResetName()
+		return nil
+	case model.FieldModelID:
+		m.ResetModelID()
+		return nil
+	case model.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case model.FieldWalletAddress:
+		m.ResetWalletAddress()
+		return nil
+	case model.FieldIpfsURL:
+		m.ResetIpfsURL()
+		return nil
+	case model.FieldOpenaiTokenID:
+		m.ResetOpenaiTokenID()
+		return nil
+	case model.FieldSlug:
+		m.ResetSlug()
+		return nil
+	case model.FieldLocation:
+		m.ResetLocation()
+		return nil
+	case model.FieldAboutMe:
+		m.ResetAboutMe()
+		return nil
+	case model.FieldValue:
+		m.ResetValue()
+		return nil
+	case model.FieldViews:
+		m.ResetViews()
+		return nil
+	case model.FieldTease:
+		m.ResetTease()
+		return nil
+	case model.FieldPosts:
+		m.ResetPosts()
+		return nil
+	case model.FieldImageSrc:
+		m.ResetImageSrc()
+		return nil
+	case model.FieldIconSrc:
+		m.ResetIconSrc()
+		return nil
+	}
+	return fmt.Errorf("unknown Model field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ModelMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.options != nil {
+		edges = append(edges, model.EdgeOptions)
+	}
+	if m.subscriptions != nil {
+		edges = append(edges, model.EdgeSubscriptions)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ModelMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case model.EdgeOptions:
+		ids := make([]ent.Value, 0, len(m.options))
+		for id := range m.options {
+			ids = append(ids, id)
+		}
+		return ids
+	case model.EdgeSubscriptions:
+		ids := make([]ent.Value, 0, len(m.subscriptions))
+		for id := range m.subscriptions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ModelMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedoptions != nil {
+		edges = append(edges, model.EdgeOptions)
+	}
+	if m.removedsubscriptions != nil {
+		edges = append(edges, model.EdgeSubscriptions)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ModelMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case model.EdgeOptions:
+		ids := make([]ent.Value, 0, len(m.removedoptions))
+		for id := range m.removedoptions {
+			ids = append(ids, id)
+		}
+		return ids
+	case model.EdgeSubscriptions:
+		ids := make([]ent.Value, 0, len(m.removedsubscriptions))
+		for id := range m.removedsubscriptions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ModelMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedoptions {
+		edges = append(edges, model.EdgeOptions)
+	}
+	if m.clearedsubscriptions {
+		edges = append(edges, model.EdgeSubscriptions)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ModelMutation) EdgeCleared(name string) bool {
+	switch name {
+	case model.EdgeOptions:
+		return m.clearedoptions
+	case model.EdgeSubscriptions:
+		return m.clearedsubscriptions
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ModelMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Model unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ModelMutation) ResetEdge(name string) error {
+	switch name {
+	case model.EdgeOptions:
+		m.ResetOptions()
+		return nil
+	case model.EdgeSubscriptions:
+		m.ResetSubscriptions()
+		return nil
+	}
+	return fmt.Errorf("unknown Model edge %s", name)
+}
+
+// SubscriptionMutation represents an operation that mutates the Subscription nodes in the graph.
+type SubscriptionMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *string
+	chain                  *string
+	token_id               *string
+	tx_hash                *string
+	listing_id             *string
+	price                  *string
+	is_listed              *bool
+	starts_at              *time.Time
+	expires_at             *time.Time
+	expired                *bool
+	held_on_chain          *bool
+	reconciled_at          *time.Time
+	subscription_option_id *string
+	clearedFields          map[string]struct{}
+	user                   *string
+	cleareduser            bool
+	model                  *string
+	clearedmodel           bool
+	done                   bool
+	oldValue               func(context.Context) (*Subscription, error)
+	predicates             []predicate.Subscription
+}
+
+var _ ent.Mutation = (*SubscriptionMutation)(nil)
+
+// subscriptionOption allows management of the mutation configuration using functional options.
+type subscriptionOption func(*SubscriptionMutation)
+
+// newSubscriptionMutation creates new mutation for the Subscription entity.
+func newSubscriptionMutation(c config, op Op, opts ...subscriptionOption) *SubscriptionMutation {
+	m := &SubscriptionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSubscription,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSubscriptionID sets the ID field of the mutation.
+func withSubscriptionID(id string) subscriptionOption {
+	return func(m *SubscriptionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Subscription
+		)
+		m.oldValue = func(ctx context.Context) (*Subscription, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Subscription.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSubscription sets the old Subscription of the mutation.
+func withSubscription(node *Subscription) subscriptionOption {
+	return func(m *SubscriptionMutation) {
+		m.oldValue = func(context.Context) (*Subscription, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SubscriptionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SubscriptionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Subscription entities.
+func (m *SubscriptionMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SubscriptionMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SubscriptionMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Subscription.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetChain sets the "chain" field.
+func (m *SubscriptionMutation) SetChain(s string) {
+	m.chain = &s
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *SubscriptionMutation) SetField(name string, value ent.Value) error {
-	switch name {
+// Chain returns the value of the "chain" field in the mutation.
+func (m *SubscriptionMutation) Chain() (r string, exists bool) {
+	v := m.chain
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Subscription field %s", name)
+	return *v, true
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *SubscriptionMutation) AddedFields() []string {
-	return nil
+// OldChain returns the old "chain" field's value of the Subscription entity.
+// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubscriptionMutation) OldChain(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChain is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChain requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChain: %w", err)
+	}
+	return oldValue.Chain, nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *SubscriptionMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// ResetChain resets all changes to the "chain" field.
+func (m *SubscriptionMutation) ResetChain() {
+	m.chain = nil
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *SubscriptionMutation) AddField(name string, value ent.Value) error {
-	return fmt.Errorf("unknown Subscription numeric field %s", name)
+// SetTokenID sets the "token_id" field.
+func (m *SubscriptionMutation) SetTokenID(s string) {
+	m.token_id = &s
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *SubscriptionMutation) ClearedFields() []string {
-	return nil
+// TokenID returns the value of the "token_id" field in the mutation.
+func (m *SubscriptionMutation) TokenID() (r string, exists bool) {
+	v := m.token_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *SubscriptionMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
+// OldTokenID returns the old "token_id" field's value of the Subscription entity.
+// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubscriptionMutation) OldTokenID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenID: %w", err)
+	}
+	return oldValue.TokenID, nil
+}
+
+// ResetTokenID resets all changes to the "token_id" field.
+func (m *SubscriptionMutation) ResetTokenID() {
+	m.token_id = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *SubscriptionMutation) SetUserID(s string) {
+	m.user = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *SubscriptionMutation) UserID() (r string, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Subscription entity.
+// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubscriptionMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *SubscriptionMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetModelID sets the "model_id" field.
+func (m *SubscriptionMutation) SetModelID(s string) {
+	m.model = &s
+}
+
+// ModelID returns the value of the "model_id" field in the mutation.
+func (m *SubscriptionMutation) ModelID() (r string, exists bool) {
+	v := m.model
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModelID returns the old "model_id" field's value of the Subscription entity.
+// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubscriptionMutation) OldModelID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModelID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModelID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModelID: %w", err)
+	}
+	return oldValue.ModelID, nil
+}
+
+// ResetModelID resets all changes to the "model_id" field.
+func (m *SubscriptionMutation) ResetModelID() {
+	m.model = nil
+}
+
+// SetTxHash sets the "tx_hash" field.
+func (m *SubscriptionMutation) SetTxHash(s string) {
+	m.tx_hash = &s
+}
+
+// TxHash returns the value of the "tx_hash" field in the mutation.
+func (m *SubscriptionMutation) TxHash() (r string, exists bool) {
+	v := m.tx_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTxHash returns the old "tx_hash" field's value of the Subscription entity.
+// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubscriptionMutation) OldTxHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTxHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTxHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTxHash: %w", err)
+	}
+	return oldValue.TxHash, nil
+}
+
+// ClearTxHash clears the value of the "tx_hash" field.
+func (m *SubscriptionMutation) ClearTxHash() {
+	m.tx_hash = nil
+	m.clearedFields[subscription.FieldTxHash] = struct{}{}
+}
+
+// TxHashCleared returns if the "tx_hash" field was cleared in this mutation.
+func (m *SubscriptionMutation) TxHashCleared() bool {
+	_, ok := m.clearedFields[subscription.FieldTxHash]
 	return ok
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *SubscriptionMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Subscription nullable field %s", name)
+// ResetTxHash resets all changes to the "tx_hash" field.
+func (m *SubscriptionMutation) ResetTxHash() {
+	m.tx_hash = nil
+	delete(m.clearedFields, subscription.FieldTxHash)
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *SubscriptionMutation) ResetField(name string) error {
-	return fmt.Errorf("unknown Subscription field %s", name)
+// SetListingID sets the "listing_id" field.
+func (m *SubscriptionMutation) SetListingID(s string) {
+	m.listing_id = &s
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SubscriptionMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// ListingID returns the value of the "listing_id" field in the mutation.
+func (m *SubscriptionMutation) ListingID() (r string, exists bool) {
+	v := m.listing_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *SubscriptionMutation) AddedIDs(name string) []ent.Value {
-	return nil
+// OldListingID returns the old "listing_id" field's value of the Subscription entity.
+// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubscriptionMutation) OldListingID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldListingID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldListingID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldListingID: %w", err)
+	}
+	return oldValue.ListingID, nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SubscriptionMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// ClearListingID clears the value of the "listing_id" field.
+func (m *SubscriptionMutation) ClearListingID() {
+	m.listing_id = nil
+	m.clearedFields[subscription.FieldListingID] = struct{}{}
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *SubscriptionMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// ListingIDCleared returns if the "listing_id" field was cleared in this mutation.
+func (m *SubscriptionMutation) ListingIDCleared() bool {
+	_, ok := m.clearedFields[subscription.FieldListingID]
+	return ok
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SubscriptionMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// ResetListingID resets all changes to the "listing_id" field.
+func (m *SubscriptionMutation) ResetListingID() {
+	m.listing_id = nil
+	delete(m.clearedFields, subscription.FieldListingID)
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *SubscriptionMutation) EdgeCleared(name string) bool {
-	return false
+// SetPrice sets the "price" field.
+func (m *SubscriptionMutation) SetPrice(s string) {
+	m.price = &s
+}
+
+// Price returns the value of the "price" field in the mutation.
+func (m *SubscriptionMutation) Price() (r string, exists bool) {
+	v := m.price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrice returns the old "price" field's value of the Subscription entity.
+// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubscriptionMutation) OldPrice(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrice: %w", err)
+	}
+	return oldValue.Price, nil
+}
+
+// ClearPrice clears the value of the "price" field.
+func (m *SubscriptionMutation) ClearPrice() {
+	m.price = nil
+	m.clearedFields[subscription.FieldPrice] = struct{}{}
+}
+
+// PriceCleared returns if the "price" field was cleared in this mutation.
+func (m *SubscriptionMutation) PriceCleared() bool {
+	_, ok := m.clearedFields[subscription.FieldPrice]
+	return ok
+}
+
+// ResetPrice resets all changes to the "price" field.
+func (m *SubscriptionMutation) ResetPrice() {
+	m.price = nil
+	delete(m.clearedFields, subscription.FieldPrice)
+}
+
+// SetIsListed sets the "is_listed" field.
+func (m *SubscriptionMutation) SetIsListed(b bool) {
+	m.is_listed = &b
+}
+
+// IsListed returns the value of the "is_listed" field in the mutation.
+func (m *SubscriptionMutation) IsListed() (r bool, exists bool) {
+	v := m.is_listed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsListed returns the old "is_listed" field's value of the Subscription entity.
+// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubscriptionMutation) OldIsListed(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsListed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsListed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsListed: %w", err)
+	}
+	return oldValue.IsListed, nil
+}
+
+// ResetIsListed resets all changes to the "is_listed" field.
+func (m *SubscriptionMutation) ResetIsListed() {
+	m.is_listed = nil
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (m *SubscriptionMutation) SetStartsAt(t time.Time) {
+	m.starts_at = &t
+}
+
+// StartsAt returns the value of the "starts_at" field in the mutation.
+func (m *SubscriptionMutation) StartsAt() (r time.Time, exists bool) {
+	v := m.starts_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartsAt returns the old "starts_at" field's value of the Subscription entity.
+// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubscriptionMutation) OldStartsAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartsAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartsAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartsAt: %w", err)
+	}
+	return oldValue.StartsAt, nil
+}
+
+// ClearStartsAt clears the value of the "starts_at" field.
+func (m *SubscriptionMutation) ClearStartsAt() {
+	m.starts_at = nil
+	m.clearedFields[subscription.FieldStartsAt] = struct{}{}
+}
+
+// StartsAtCleared returns if the "starts_at" field was cleared in this mutation.
+func (m *SubscriptionMutation) StartsAtCleared() bool {
+	_, ok := m.clearedFields[subscription.FieldStartsAt]
+	return ok
+}
+
+// ResetStartsAt resets all changes to the "starts_at" field.
+func (m *SubscriptionMutation) ResetStartsAt() {
+	m.starts_at = nil
+	delete(m.clearedFields, subscription.FieldStartsAt)
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *SubscriptionMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *SubscriptionMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the Subscription entity.
+// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubscriptionMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *SubscriptionMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[subscription.FieldExpiresAt] = struct{}{}
+}
+
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *SubscriptionMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[subscription.FieldExpiresAt]
+	return ok
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *SubscriptionMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, subscription.FieldExpiresAt)
+}
+
+// SetExpired sets the "expired" field.
+func (m *SubscriptionMutation) SetExpired(b bool) {
+	m.expired = &b
+}
+
+// Expired returns the value of the "expired" field in the mutation.
+func (m *SubscriptionMutation) Expired() (r bool, exists bool) {
+	v := m.expired
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpired returns the old "expired" field's value of the Subscription entity.
+// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubscriptionMutation) OldExpired(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpired is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpired requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpired: %w", err)
+	}
+	return oldValue.Expired, nil
+}
+
+// ResetExpired resets all changes to the "expired" field.
+func (m *SubscriptionMutation) ResetExpired() {
+	m.expired = nil
+}
+
+// SetHeldOnChain sets the "held_on_chain" field.
+func (m *SubscriptionMutation) SetHeldOnChain(b bool) {
+	m.held_on_chain = &b
+}
+
+// HeldOnChain returns the value of the "held_on_chain" field in the mutation.
+func (m *SubscriptionMutation) HeldOnChain() (r bool, exists bool) {
+	v := m.held_on_chain
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHeldOnChain returns the old "held_on_chain" field's value of the Subscription entity.
+// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubscriptionMutation) OldHeldOnChain(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHeldOnChain is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHeldOnChain requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHeldOnChain: %w", err)
+	}
+	return oldValue.HeldOnChain, nil
+}
+
+// ClearHeldOnChain clears the value of the "held_on_chain" field.
+func (m *SubscriptionMutation) ClearHeldOnChain() {
+	m.held_on_chain = nil
+	m.clearedFields[subscription.FieldHeldOnChain] = struct{}{}
+}
+
+// HeldOnChainCleared returns if the "held_on_chain" field was cleared in this mutation.
+func (m *SubscriptionMutation) HeldOnChainCleared() bool {
+	_, ok := m.clearedFields[subscription.FieldHeldOnChain]
+	return ok
+}
+
+// ResetHeldOnChain resets all changes to the "held_on_chain" field.
+func (m *SubscriptionMutation) ResetHeldOnChain() {
+	m.held_on_chain = nil
+	delete(m.clearedFields, subscription.FieldHeldOnChain)
+}
+
+// SetReconciledAt sets the "reconciled_at" field.
+func (m *SubscriptionMutation) SetReconciledAt(t time.Time) {
+	m.reconciled_at = &t
+}
+
+// ReconciledAt returns the value of the "reconciled_at" field in the mutation.
+func (m *SubscriptionMutation) ReconciledAt() (r time.Time, exists bool) {
+	v := m.reconciled_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReconciledAt returns the old "reconciled_at" field's value of the Subscription entity.
+// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubscriptionMutation) OldReconciledAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReconciledAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReconciledAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReconciledAt: %w", err)
+	}
+	return oldValue.ReconciledAt, nil
+}
+
+// ClearReconciledAt clears the value of the "reconciled_at" field.
+func (m *SubscriptionMutation) ClearReconciledAt() {
+	m.reconciled_at = nil
+	m.clearedFields[subscription.FieldReconciledAt] = struct{}{}
+}
+
+// ReconciledAtCleared returns if the "reconciled_at" field was cleared in this mutation.
+func (m *SubscriptionMutation) ReconciledAtCleared() bool {
+	_, ok := m.clearedFields[subscription.FieldReconciledAt]
+	return ok
+}
+
+// ResetReconciledAt resets all changes to the "reconciled_at" field.
+func (m *SubscriptionMutation) ResetReconciledAt() {
+	m.reconciled_at = nil
+	delete(m.clearedFields, subscription.FieldReconciledAt)
+}
+
+// SetSubscriptionOptionID sets the "subscription_option_id" field.
+func (m *SubscriptionMutation) SetSubscriptionOptionID(s string) {
+	m.subscription_option_id = &s
+}
+
+// SubscriptionOptionID returns the value of the "subscription_option_id" field in the mutation.
+func (m *SubscriptionMutation) SubscriptionOptionID() (r string, exists bool) {
+	v := m.subscription_option_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubscriptionOptionID returns the old "subscription_option_id" field's value of the Subscription entity.
+// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubscriptionMutation) OldSubscriptionOptionID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubscriptionOptionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubscriptionOptionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubscriptionOptionID: %w", err)
+	}
+	return oldValue.SubscriptionOptionID, nil
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *SubscriptionMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Subscription unique edge %s", name)
+// ClearSubscriptionOptionID clears the value of the "subscription_option_id" field.
+func (m *SubscriptionMutation) ClearSubscriptionOptionID() {
+	m.subscription_option_id = nil
+	m.clearedFields[subscription.FieldSubscriptionOptionID] = struct{}{}
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *SubscriptionMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Subscription edge %s", name)
+// SubscriptionOptionIDCleared returns if the "subscription_option_id" field was cleared in this mutation.
+func (m *SubscriptionMutation) SubscriptionOptionIDCleared() bool {
+	_, ok := m.clearedFields[subscription.FieldSubscriptionOptionID]
+	return ok
 }
 
-// SubscriptionMetisMutation represents an operation that mutates the SubscriptionMetis nodes in the graph.
-type SubscriptionMetisMutation struct {
-	config
-	op            Op
-	typ           string
-	id            *int
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*SubscriptionMetis, error)
-	predicates    []predicate.SubscriptionMetis
+// ResetSubscriptionOptionID resets all changes to the "subscription_option_id" field.
+func (m *SubscriptionMutation) ResetSubscriptionOptionID() {
+	m.subscription_option_id = nil
+	delete(m.clearedFields, subscription.FieldSubscriptionOptionID)
 }
 
-var _ ent.Mutation = (*SubscriptionMetisMutation)(nil)
-
-// subscriptionmetisOption allows management of the mutation configuration using functional options.
-type subscriptionmetisOption func(*SubscriptionMetisMutation)
+// ClearUser clears the "user" edge to the User entity.
+func (m *SubscriptionMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[subscription.FieldUserID] = struct{}{}
+}
 
-// newSubscriptionMetisMutation creates new mutation for the SubscriptionMetis entity.
-func newSubscriptionMetisMutation(c config, op Op, opts ...subscriptionmetisOption) *SubscriptionMetisMutation {
-	m := &SubscriptionMetisMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeSubscriptionMetis,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
-	}
-	return m
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *SubscriptionMutation) UserCleared() bool {
+	return m.cleareduser
 }
 
-// withSubscriptionMetisID sets the ID field of the mutation.
-func withSubscriptionMetisID(id int) subscriptionmetisOption {
-	return func(m *SubscriptionMetisMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *SubscriptionMetis
-		)
-		m.oldValue = func(ctx context.Context) (*SubscriptionMetis, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().SubscriptionMetis.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *SubscriptionMutation) UserIDs() (ids []string) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
 	}
+	return
 }
 
-// withSubscriptionMetis sets the old SubscriptionMetis of the mutation.
-func withSubscriptionMetis(node *SubscriptionMetis) subscriptionmetisOption {
-	return func(m *SubscriptionMetisMutation) {
-		m.oldValue = func(context.Context) (*SubscriptionMetis, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
+// ResetUser resets all changes to the "user" edge.
+func (m *SubscriptionMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SubscriptionMetisMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ClearModel clears the "model" edge to the Model entity.
+func (m *SubscriptionMutation) ClearModel() {
+	m.clearedmodel = true
+	m.clearedFields[subscription.FieldModelID] = struct{}{}
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m SubscriptionMetisMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// ModelCleared reports if the "model" edge to the Model entity was cleared.
+func (m *SubscriptionMutation) ModelCleared() bool {
+	return m.clearedmodel
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *SubscriptionMetisMutation) ID() (id int, exists bool) {
-	if m.id == nil {
-		return
+// ModelIDs returns the "model" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ModelID instead. It exists only for internal usage by the builders.
+func (m *SubscriptionMutation) ModelIDs() (ids []string) {
+	if id := m.model; id != nil {
+		ids = append(ids, *id)
 	}
-	return *m.id, true
+	return
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *SubscriptionMetisMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().SubscriptionMetis.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ResetModel resets all changes to the "model" edge.
+func (m *SubscriptionMutation) ResetModel() {
+	m.model = nil
+	m.clearedmodel = false
 }
 
-// Where appends a list predicates to the SubscriptionMetisMutation builder.
-func (m *SubscriptionMetisMutation) Where(ps ...predicate.SubscriptionMetis) {
+// Where appends a list predicates to the SubscriptionMutation builder.
+func (m *SubscriptionMutation) Where(ps ...predicate.Subscription) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the SubscriptionMetisMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SubscriptionMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SubscriptionMetisMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.SubscriptionMetis, len(ps))
+func (m *SubscriptionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Subscription, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -684,167 +2378,515 @@ func (m *SubscriptionMetisMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SubscriptionMetisMutation) Op() Op {
+func (m *SubscriptionMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SubscriptionMetisMutation) SetOp(op Op) {
+func (m *SubscriptionMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (SubscriptionMetis).
-func (m *SubscriptionMetisMutation) Type() string {
+// Type returns the node type of this mutation (Subscription).
+func (m *SubscriptionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SubscriptionMetisMutation) Fields() []string {
-	fields := make([]string, 0, 0)
+func (m *SubscriptionMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.chain != nil {
+		fields = append(fields, subscription.FieldChain)
+	}
+	if m.token_id != nil {
+		fields = append(fields, subscription.FieldTokenID)
+	}
+	if m.user != nil {
+		fields = append(fields, subscription.FieldUserID)
+	}
+	if m.model != nil {
+		fields = append(fields, subscription.FieldModelID)
+	}
+	if m.tx_hash != nil {
+		fields = append(fields, subscription.FieldTxHash)
+	}
+	if m.listing_id != nil {
+		fields = append(fields, subscription.FieldListingID)
+	}
+	if m.price != nil {
+		fields = append(fields, subscription.FieldPrice)
+	}
+	if m.is_listed != nil {
+		fields = append(fields, subscription.FieldIsListed)
+	}
+	if m.starts_at != nil {
+		fields = append(fields, subscription.FieldStartsAt)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, subscription.FieldExpiresAt)
+	}
+	if m.expired != nil {
+		fields = append(fields, subscription.FieldExpired)
+	}
+	if m.held_on_chain != nil {
+		fields = append(fields, subscription.FieldHeldOnChain)
+	}
+	if m.reconciled_at != nil {
+		fields = append(fields, subscription.FieldReconciledAt)
+	}
+	if m.subscription_option_id != nil {
+		fields = append(fields, subscription.FieldSubscriptionOptionID)
+	}
 	return fields
 }
 
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SubscriptionMetisMutation) Field(name string) (ent.Value, bool) {
+func (m *SubscriptionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case subscription.FieldChain:
+		return m.Chain()
+	case subscription.FieldTokenID:
+		return m.TokenID()
+	case subscription.FieldUserID:
+		return m.UserID()
+	case subscription.FieldModelID:
+		return m.ModelID()
+	case subscription.FieldTxHash:
+		return m.TxHash()
+	case subscription.FieldListingID:
+		return m.ListingID()
+	case subscription.FieldPrice:
+		return m.Price()
+	case subscription.FieldIsListed:
+		return m.IsListed()
+	case subscription.FieldStartsAt:
+		return m.StartsAt()
+	case subscription.FieldExpiresAt:
+		return m.ExpiresAt()
+	case subscription.FieldExpired:
+		return m.Expired()
+	case subscription.FieldHeldOnChain:
+		return m.HeldOnChain()
+	case subscription.FieldReconciledAt:
+		return m.ReconciledAt()
+	case subscription.FieldSubscriptionOptionID:
+		return m.SubscriptionOptionID()
+	}
 	return nil, false
 }
 
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SubscriptionMetisMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	return nil, fmt.Errorf("unknown SubscriptionMetis field %s", name)
+func (m *SubscriptionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case subscription.FieldChain:
+		return m.OldChain(ctx)
+	case subscription.FieldTokenID:
+		return m.OldTokenID(ctx)
+	case subscription.FieldUserID:
+		return m.OldUserID(ctx)
+	case subscription.FieldModelID:
+		return m.OldModelID(ctx)
+	case subscription.FieldTxHash:
+		return m.OldTxHash(ctx)
+	case subscription.FieldListingID:
+		return m.OldListingID(ctx)
+	case subscription.FieldPrice:
+		return m.OldPrice(ctx)
+	case subscription.FieldIsListed:
+		return m.OldIsListed(ctx)
+	case subscription.FieldStartsAt:
+		return m.OldStartsAt(ctx)
+	case subscription.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case subscription.FieldExpired:
+		return m.OldExpired(ctx)
+	case subscription.FieldHeldOnChain:
+		return m.OldHeldOnChain(ctx)
+	case subscription.FieldReconciledAt:
+		return m.OldReconciledAt(ctx)
+	case subscription.FieldSubscriptionOptionID:
+		return m.OldSubscriptionOptionID(ctx)
+	}
+	return nil, fmt.Errorf("unknown Subscription field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SubscriptionMetisMutation) SetField(name string, value ent.Value) error {
+func (m *SubscriptionMutation) SetField(name string, value ent.Value) error {
 	switch name {
+	case subscription.FieldChain:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChain(v)
+		return nil
+	case subscription.FieldTokenID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenID(v)
+		return nil
+	case subscription.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case subscription.FieldModelID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModelID(v)
+		return nil
+	case subscription.FieldTxHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTxHash(v)
+		return nil
+	case subscription.FieldListingID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetListingID(v)
+		return nil
+	case subscription.FieldPrice:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrice(v)
+		return nil
+	case subscription.FieldIsListed:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsListed(v)
+		return nil
+	case subscription.FieldStartsAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartsAt(v)
+		return nil
+	case subscription.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case subscription.FieldExpired:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpired(v)
+		return nil
+	case subscription.FieldHeldOnChain:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHeldOnChain(v)
+		return nil
+	case subscription.FieldReconciledAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReconciledAt(v)
+		return nil
+	case subscription.FieldSubscriptionOptionID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubscriptionOptionID(v)
+		return nil
 	}
-	return fmt.Errorf("unknown SubscriptionMetis field %s", name)
+	return fmt.Errorf("unknown Subscription field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SubscriptionMetisMutation) AddedFields() []string {
+func (m *SubscriptionMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SubscriptionMetisMutation) AddedField(name string) (ent.Value, bool) {
+func (m *SubscriptionMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SubscriptionMetisMutation) AddField(name string, value ent.Value) error {
-	return fmt.Errorf("unknown SubscriptionMetis numeric field %s", name)
+func (m *SubscriptionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Subscription numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SubscriptionMetisMutation) ClearedFields() []string {
-	return nil
+func (m *SubscriptionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(subscription.FieldTxHash) {
+		fields = append(fields, subscription.FieldTxHash)
+	}
+	if m.FieldCleared(subscription.FieldListingID) {
+		fields = append(fields, subscription.FieldListingID)
+	}
+	if m.FieldCleared(subscription.FieldPrice) {
+		fields = append(fields, subscription.FieldPrice)
+	}
+	if m.FieldCleared(subscription.FieldStartsAt) {
+		fields = append(fields, subscription.FieldStartsAt)
+	}
+	if m.FieldCleared(subscription.FieldExpiresAt) {
+		fields = append(fields, subscription.FieldExpiresAt)
+	}
+	if m.FieldCleared(subscription.FieldHeldOnChain) {
+		fields = append(fields, subscription.FieldHeldOnChain)
+	}
+	if m.FieldCleared(subscription.FieldReconciledAt) {
+		fields = append(fields, subscription.FieldReconciledAt)
+	}
+	if m.FieldCleared(subscription.FieldSubscriptionOptionID) {
+		fields = append(fields, subscription.FieldSubscriptionOptionID)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SubscriptionMetisMutation) FieldCleared(name string) bool {
+func (m *SubscriptionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SubscriptionMetisMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown SubscriptionMetis nullable field %s", name)
+func (m *SubscriptionMutation) ClearField(name string) error {
+	switch name {
+	case subscription.FieldTxHash:
+		m.ClearTxHash()
+		return nil
+	case subscription.FieldListingID:
+		m.ClearListingID()
+		return nil
+	case subscription.FieldPrice:
+		m.ClearPrice()
+		return nil
+	case subscription.FieldStartsAt:
+		m.ClearStartsAt()
+		return nil
+	case subscription.FieldExpiresAt:
+		m.ClearExpiresAt()
+		return nil
+	case subscription.FieldHeldOnChain:
+		m.ClearHeldOnChain()
+		return nil
+	case subscription.FieldReconciledAt:
+		m.ClearReconciledAt()
+		return nil
+	case subscription.FieldSubscriptionOptionID:
+		m.ClearSubscriptionOptionID()
+		return nil
+	}
+	return fmt.Errorf("unknown Subscription nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SubscriptionMetisMutation) ResetField(name string) error {
-	return fmt.Errorf("unknown SubscriptionMetis field %s", name)
+func (m *SubscriptionMutation) ResetField(name string) error {
+	switch name {
+	case subscription.FieldChain:
+		m.ResetChain()
+		return nil
+	case subscription.FieldTokenID:
+		m.ResetTokenID()
+		return nil
+	case subscription.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case subscription.FieldModelID:
+		m.ResetModelID()
+		return nil
+	case subscription.FieldTxHash:
+		m.ResetTxHash()
+		return nil
+	case subscription.FieldListingID:
+		m.ResetListingID()
+		return nil
+	case subscription.FieldPrice:
+		m.ResetPrice()
+		return nil
+	case subscription.FieldIsListed:
+		m.ResetIsListed()
+		return nil
+	case subscription.FieldStartsAt:
+		m.ResetStartsAt()
+		return nil
+	case subscription.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case subscription.FieldExpired:
+		m.ResetExpired()
+		return nil
+	case subscription.FieldHeldOnChain:
+		m.ResetHeldOnChain()
+		return nil
+	case subscription.FieldReconciledAt:
+		m.ResetReconciledAt()
+		return nil
+	case subscription.FieldSubscriptionOptionID:
+		m.ResetSubscriptionOptionID()
+		return nil
+	}
+	return fmt.Errorf("unknown Subscription field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SubscriptionMetisMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *SubscriptionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.user != nil {
+		edges = append(edges, subscription.EdgeUser)
+	}
+	if m.model != nil {
+		edges = append(edges, subscription.EdgeModel)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SubscriptionMetisMutation) AddedIDs(name string) []ent.Value {
+func (m *SubscriptionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case subscription.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case subscription.EdgeModel:
+		if id := m.model; id != nil {
+			return []ent.Value{*id}
+		}
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SubscriptionMetisMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *SubscriptionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SubscriptionMetisMutation) RemovedIDs(name string) []ent.Value {
+func (m *SubscriptionMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SubscriptionMetisMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *SubscriptionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareduser {
+		edges = append(edges, subscription.EdgeUser)
+	}
+	if m.clearedmodel {
+		edges = append(edges, subscription.EdgeModel)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SubscriptionMetisMutation) EdgeCleared(name string) bool {
+func (m *SubscriptionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case subscription.EdgeUser:
+		return m.cleareduser
+	case subscription.EdgeModel:
+		return m.clearedmodel
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SubscriptionMetisMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown SubscriptionMetis unique edge %s", name)
+func (m *SubscriptionMutation) ClearEdge(name string) error {
+	switch name {
+	case subscription.EdgeUser:
+		m.ClearUser()
+		return nil
+	case subscription.EdgeModel:
+		m.ClearModel()
+		return nil
+	}
+	return fmt.Errorf("unknown Subscription unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SubscriptionMetisMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown SubscriptionMetis edge %s", name)
+func (m *SubscriptionMutation) ResetEdge(name string) error {
+	switch name {
+	case subscription.EdgeUser:
+		m.ResetUser()
+		return nil
+	case subscription.EdgeModel:
+		m.ResetModel()
+		return nil
+	}
+	return fmt.Errorf("unknown Subscription edge %s", name)
 }
 
-// SubscriptionMoonbeamMutation represents an operation that mutates the SubscriptionMoonbeam nodes in the graph.
-type SubscriptionMoonbeamMutation struct {
+// SubscriptionOptionMutation represents an operation that mutates the SubscriptionOption nodes in the graph.
+type SubscriptionOptionMutation struct {
 	config
 	op            Op
 	typ           string
-	id            *int
+	id            *string
+	price         *string
+	duration      *int
+	addduration   *int
+	description   *string
+	created_at    *time.Time
 	clearedFields map[string]struct{}
+	model         *string
+	clearedmodel  bool
 	done          bool
-	oldValue      func(context.Context) (*SubscriptionMoonbeam, error)
-	predicates    []predicate.SubscriptionMoonbeam
+	oldValue      func(context.Context) (*SubscriptionOption, error)
+	predicates    []predicate.SubscriptionOption
 }
 
-var _ ent.Mutation = (*SubscriptionMoonbeamMutation)(nil)
+var _ ent.Mutation = (*SubscriptionOptionMutation)(nil)
 
-// subscriptionmoonbeamOption allows management of the mutation configuration using functional options.
-type subscriptionmoonbeamOption func(*SubscriptionMoonbeamMutation)
+// subscriptionoptionOption allows management of the mutation configuration using functional options.
+type subscriptionoptionOption func(*SubscriptionOptionMutation)
 
-// newSubscriptionMoonbeamMutation creates new mutation for the SubscriptionMoonbeam entity.
-func newSubscriptionMoonbeamMutation(c config, op Op, opts ...subscriptionmoonbeamOption) *SubscriptionMoonbeamMutation {
-	m := &SubscriptionMoonbeamMutation{
+// newSubscriptionOptionMutation creates new mutation for the SubscriptionOption entity.
+func newSubscriptionOptionMutation(c config, op Op, opts ...subscriptionoptionOption) *SubscriptionOptionMutation {
+	m := &SubscriptionOptionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSubscriptionMoonbeam,
+		typ:           TypeSubscriptionOption,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -853,20 +2895,20 @@ func newSubscriptionMoonbeamMutation(c config, op Op, opts ...subscriptionmoonbe
 	return m
 }
 
-// withSubscriptionMoonbeamID sets the ID field of the mutation.
-func withSubscriptionMoonbeamID(id int) subscriptionmoonbeamOption {
-	return func(m *SubscriptionMoonbeamMutation) {
+// withSubscriptionOptionID sets the ID field of the mutation.
+func withSubscriptionOptionID(id string) subscriptionoptionOption {
+	return func(m *SubscriptionOptionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *SubscriptionMoonbeam
+			value *SubscriptionOption
 		)
-		m.oldValue = func(ctx context.Context) (*SubscriptionMoonbeam, error) {
+		m.oldValue = func(ctx context.Context) (*SubscriptionOption, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().SubscriptionMoonbeam.Get(ctx, id)
+					value, err = m.Client().SubscriptionOption.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -875,10 +2917,10 @@ func withSubscriptionMoonbeamID(id int) subscriptionmoonbeamOption {
 	}
 }
 
-// withSubscriptionMoonbeam sets the old SubscriptionMoonbeam of the mutation.
-func withSubscriptionMoonbeam(node *SubscriptionMoonbeam) subscriptionmoonbeamOption {
-	return func(m *SubscriptionMoonbeamMutation) {
-		m.oldValue = func(context.Context) (*SubscriptionMoonbeam, error) {
+// withSubscriptionOption sets the old SubscriptionOption of the mutation.
+func withSubscriptionOption(node *SubscriptionOption) subscriptionoptionOption {
+	return func(m *SubscriptionOptionMutation) {
+		m.oldValue = func(context.Context) (*SubscriptionOption, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -887,7 +2929,7 @@ func withSubscriptionMoonbeam(node *SubscriptionMoonbeam) subscriptionmoonbeamOp
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SubscriptionMoonbeamMutation) Client() *Client {
+func (m SubscriptionOptionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -895,7 +2937,7 @@ func (m SubscriptionMoonbeamMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SubscriptionMoonbeamMutation) Tx() (*Tx, error) {
+func (m SubscriptionOptionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -904,9 +2946,15 @@ func (m SubscriptionMoonbeamMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SubscriptionOption entities.
+func (m *SubscriptionOptionMutation) SetID(id string) {
+	m.id = &id
+}
+
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *SubscriptionMoonbeamMutation) ID() (id int, exists bool) {
+func (m *SubscriptionOptionMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -917,294 +2965,270 @@ func (m *SubscriptionMoonbeamMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *SubscriptionMoonbeamMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *SubscriptionOptionMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []int{id}, nil
+			return []string{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().SubscriptionMoonbeam.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().SubscriptionOption.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// Where appends a list predicates to the SubscriptionMoonbeamMutation builder.
-func (m *SubscriptionMoonbeamMutation) Where(ps ...predicate.SubscriptionMoonbeam) {
-	m.predicates = append(m.predicates, ps...)
+// SetModelID sets the "model_id" field.
+func (m *SubscriptionOptionMutation) SetModelID(s string) {
+	m.model = &s
 }
 
-// WhereP appends storage-level predicates to the SubscriptionMoonbeamMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SubscriptionMoonbeamMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.SubscriptionMoonbeam, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// ModelID returns the value of the "model_id" field in the mutation.
+func (m *SubscriptionOptionMutation) ModelID() (r string, exists bool) {
+	v := m.model
+	if v == nil {
+		return
 	}
-	m.Where(p...)
-}
-
-// Op returns the operation name.
-func (m *SubscriptionMoonbeamMutation) Op() Op {
-	return m.op
-}
-
-// SetOp allows setting the mutation operation.
-func (m *SubscriptionMoonbeamMutation) SetOp(op Op) {
-	m.op = op
-}
-
-// Type returns the node type of this mutation (SubscriptionMoonbeam).
-func (m *SubscriptionMoonbeamMutation) Type() string {
-	return m.typ
+	return *v, true
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *SubscriptionMoonbeamMutation) Fields() []string {
-	fields := make([]string, 0, 0)
-	return fields
+// OldModelID returns the old "model_id" field's value of the SubscriptionOption entity.
+// If the SubscriptionOption object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubscriptionOptionMutation) OldModelID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModelID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModelID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModelID: %w", err)
+	}
+	return oldValue.ModelID, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *SubscriptionMoonbeamMutation) Field(name string) (ent.Value, bool) {
-	return nil, false
+// ResetModelID resets all changes to the "model_id" field.
+func (m *SubscriptionOptionMutation) ResetModelID() {
+	m.model = nil
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *SubscriptionMoonbeamMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	return nil, fmt.Errorf("unknown SubscriptionMoonbeam field %s", name)
+// SetPrice sets the "price" field.
+func (m *SubscriptionOptionMutation) SetPrice(s string) {
+	m.price = &s
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *SubscriptionMoonbeamMutation) SetField(name string, value ent.Value) error {
-	switch name {
+// Price returns the value of the "price" field in the mutation.
+func (m *SubscriptionOptionMutation) Price() (r string, exists bool) {
+	v := m.price
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown SubscriptionMoonbeam field %s", name)
+	return *v, true
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *SubscriptionMoonbeamMutation) AddedFields() []string {
-	return nil
+// OldPrice returns the old "price" field's value of the SubscriptionOption entity.
+// If the SubscriptionOption object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubscriptionOptionMutation) OldPrice(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrice: %w", err)
+	}
+	return oldValue.Price, nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *SubscriptionMoonbeamMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// ResetPrice resets all changes to the "price" field.
+func (m *SubscriptionOptionMutation) ResetPrice() {
+	m.price = nil
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *SubscriptionMoonbeamMutation) AddField(name string, value ent.Value) error {
-	return fmt.Errorf("unknown SubscriptionMoonbeam numeric field %s", name)
+// SetDuration sets the "duration" field.
+func (m *SubscriptionOptionMutation) SetDuration(i int) {
+	m.duration = &i
+	m.addduration = nil
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *SubscriptionMoonbeamMutation) ClearedFields() []string {
-	return nil
+// Duration returns the value of the "duration" field in the mutation.
+func (m *SubscriptionOptionMutation) Duration() (r int, exists bool) {
+	v := m.duration
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *SubscriptionMoonbeamMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// OldDuration returns the old "duration" field's value of the SubscriptionOption entity.
+// If the SubscriptionOption object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubscriptionOptionMutation) OldDuration(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDuration is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDuration requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDuration: %w", err)
+	}
+	return oldValue.Duration, nil
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *SubscriptionMoonbeamMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown SubscriptionMoonbeam nullable field %s", name)
+// AddDuration adds i to the "duration" field.
+func (m *SubscriptionOptionMutation) AddDuration(i int) {
+	if m.addduration != nil {
+		*m.addduration += i
+	} else {
+		m.addduration = &i
+	}
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *SubscriptionMoonbeamMutation) ResetField(name string) error {
-	return fmt.Errorf("unknown SubscriptionMoonbeam field %s", name)
+// AddedDuration returns the value that was added to the "duration" field in this mutation.
+func (m *SubscriptionOptionMutation) AddedDuration() (r int, exists bool) {
+	v := m.addduration
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SubscriptionMoonbeamMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// ResetDuration resets all changes to the "duration" field.
+func (m *SubscriptionOptionMutation) ResetDuration() {
+	m.duration = nil
+	m.addduration = nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *SubscriptionMoonbeamMutation) AddedIDs(name string) []ent.Value {
-	return nil
+// SetDescription sets the "description" field.
+func (m *SubscriptionOptionMutation) SetDescription(s string) {
+	m.description = &s
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SubscriptionMoonbeamMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// Description returns the value of the "description" field in the mutation.
+func (m *SubscriptionOptionMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *SubscriptionMoonbeamMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// OldDescription returns the old "description" field's value of the SubscriptionOption entity.
+// If the SubscriptionOption object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubscriptionOptionMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SubscriptionMoonbeamMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// ClearDescription clears the value of the "description" field.
+func (m *SubscriptionOptionMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[subscriptionoption.FieldDescription] = struct{}{}
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *SubscriptionMoonbeamMutation) EdgeCleared(name string) bool {
-	return false
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *SubscriptionOptionMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[subscriptionoption.FieldDescription]
+	return ok
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *SubscriptionMoonbeamMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown SubscriptionMoonbeam unique edge %s", name)
+// ResetDescription resets all changes to the "description" field.
+func (m *SubscriptionOptionMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, subscriptionoption.FieldDescription)
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *SubscriptionMoonbeamMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown SubscriptionMoonbeam edge %s", name)
+// SetCreatedAt sets the "created_at" field.
+func (m *SubscriptionOptionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// SubscriptionZkEVMMutation represents an operation that mutates the SubscriptionZkEVM nodes in the graph.
-type SubscriptionZkEVMMutation struct {
-	config
-	op            Op
-	typ           string
-	id            *int
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*SubscriptionZkEVM, error)
-	predicates    []predicate.SubscriptionZkEVM
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SubscriptionOptionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-var _ ent.Mutation = (*SubscriptionZkEVMMutation)(nil)
-
-// subscriptionzkevmOption allows management of the mutation configuration using functional options.
-type subscriptionzkevmOption func(*SubscriptionZkEVMMutation)
-
-// newSubscriptionZkEVMMutation creates new mutation for the SubscriptionZkEVM entity.
-func newSubscriptionZkEVMMutation(c config, op Op, opts ...subscriptionzkevmOption) *SubscriptionZkEVMMutation {
-	m := &SubscriptionZkEVMMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeSubscriptionZkEVM,
-		clearedFields: make(map[string]struct{}),
+// OldCreatedAt returns the old "created_at" field's value of the SubscriptionOption entity.
+// If the SubscriptionOption object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubscriptionOptionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	for _, opt := range opts {
-		opt(m)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
-	return m
-}
-
-// withSubscriptionZkEVMID sets the ID field of the mutation.
-func withSubscriptionZkEVMID(id int) subscriptionzkevmOption {
-	return func(m *SubscriptionZkEVMMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *SubscriptionZkEVM
-		)
-		m.oldValue = func(ctx context.Context) (*SubscriptionZkEVM, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().SubscriptionZkEVM.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
+	return oldValue.CreatedAt, nil
 }
 
-// withSubscriptionZkEVM sets the old SubscriptionZkEVM of the mutation.
-func withSubscriptionZkEVM(node *SubscriptionZkEVM) subscriptionzkevmOption {
-	return func(m *SubscriptionZkEVMMutation) {
-		m.oldValue = func(context.Context) (*SubscriptionZkEVM, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SubscriptionOptionMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SubscriptionZkEVMMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ClearModel clears the "model" edge to the Model entity.
+func (m *SubscriptionOptionMutation) ClearModel() {
+	m.clearedmodel = true
+	m.clearedFields[subscriptionoption.FieldModelID] = struct{}{}
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m SubscriptionZkEVMMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// ModelCleared reports if the "model" edge to the Model entity was cleared.
+func (m *SubscriptionOptionMutation) ModelCleared() bool {
+	return m.clearedmodel
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *SubscriptionZkEVMMutation) ID() (id int, exists bool) {
-	if m.id == nil {
-		return
+// ModelIDs returns the "model" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ModelID instead. It exists only for internal usage by the builders.
+func (m *SubscriptionOptionMutation) ModelIDs() (ids []string) {
+	if id := m.model; id != nil {
+		ids = append(ids, *id)
 	}
-	return *m.id, true
+	return
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *SubscriptionZkEVMMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().SubscriptionZkEVM.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ResetModel resets all changes to the "model" edge.
+func (m *SubscriptionOptionMutation) ResetModel() {
+	m.model = nil
+	m.clearedmodel = false
 }
 
-// Where appends a list predicates to the SubscriptionZkEVMMutation builder.
-func (m *SubscriptionZkEVMMutation) Where(ps ...predicate.SubscriptionZkEVM) {
+// Where appends a list predicates to the SubscriptionOptionMutation builder.
+func (m *SubscriptionOptionMutation) Where(ps ...predicate.SubscriptionOption) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the SubscriptionZkEVMMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SubscriptionOptionMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SubscriptionZkEVMMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.SubscriptionZkEVM, len(ps))
+func (m *SubscriptionOptionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SubscriptionOption, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1212,154 +3236,305 @@ func (m *SubscriptionZkEVMMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SubscriptionZkEVMMutation) Op() Op {
+func (m *SubscriptionOptionMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SubscriptionZkEVMMutation) SetOp(op Op) {
+func (m *SubscriptionOptionMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (SubscriptionZkEVM).
-func (m *SubscriptionZkEVMMutation) Type() string {
+// Type returns the node type of this mutation (SubscriptionOption).
+func (m *SubscriptionOptionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SubscriptionZkEVMMutation) Fields() []string {
-	fields := make([]string, 0, 0)
+func (m *SubscriptionOptionMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.model != nil {
+		fields = append(fields, subscriptionoption.FieldModelID)
+	}
+	if m.price != nil {
+		fields = append(fields, subscriptionoption.FieldPrice)
+	}
+	if m.duration != nil {
+		fields = append(fields, subscriptionoption.FieldDuration)
+	}
+	if m.description != nil {
+		fields = append(fields, subscriptionoption.FieldDescription)
+	}
+	if m.created_at != nil {
+		fields = append(fields, subscriptionoption.FieldCreatedAt)
+	}
 	return fields
 }
 
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SubscriptionZkEVMMutation) Field(name string) (ent.Value, bool) {
+func (m *SubscriptionOptionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case subscriptionoption.FieldModelID:
+		return m.ModelID()
+	case subscriptionoption.FieldPrice:
+		return m.Price()
+	case subscriptionoption.FieldDuration:
+		return m.Duration()
+	case subscriptionoption.FieldDescription:
+		return m.Description()
+	case subscriptionoption.FieldCreatedAt:
+		return m.CreatedAt()
+	}
 	return nil, false
 }
 
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SubscriptionZkEVMMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	return nil, fmt.Errorf("unknown SubscriptionZkEVM field %s", name)
+func (m *SubscriptionOptionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case subscriptionoption.FieldModelID:
+		return m.OldModelID(ctx)
+	case subscriptionoption.FieldPrice:
+		return m.OldPrice(ctx)
+	case subscriptionoption.FieldDuration:
+		return m.OldDuration(ctx)
+	case subscriptionoption.FieldDescription:
+		return m.OldDescription(ctx)
+	case subscriptionoption.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown SubscriptionOption field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SubscriptionZkEVMMutation) SetField(name string, value ent.Value) error {
+func (m *SubscriptionOptionMutation) SetField(name string, value ent.Value) error {
 	switch name {
+	case subscriptionoption.FieldModelID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModelID(v)
+		return nil
+	case subscriptionoption.FieldPrice:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrice(v)
+		return nil
+	case subscriptionoption.FieldDuration:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDuration(v)
+		return nil
+	case subscriptionoption.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case subscriptionoption.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
 	}
-	return fmt.Errorf("unknown SubscriptionZkEVM field %s", name)
+	return fmt.Errorf("unknown SubscriptionOption field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SubscriptionZkEVMMutation) AddedFields() []string {
-	return nil
+func (m *SubscriptionOptionMutation) AddedFields() []string {
+	var fields []string
+	if m.addduration != nil {
+		fields = append(fields, subscriptionoption.FieldDuration)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SubscriptionZkEVMMutation) AddedField(name string) (ent.Value, bool) {
+func (m *SubscriptionOptionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case subscriptionoption.FieldDuration:
+		return m.AddedDuration()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SubscriptionZkEVMMutation) AddField(name string, value ent.Value) error {
-	return fmt.Errorf("unknown SubscriptionZkEVM numeric field %s", name)
+func (m *SubscriptionOptionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case subscriptionoption.FieldDuration:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDuration(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SubscriptionOption numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SubscriptionZkEVMMutation) ClearedFields() []string {
-	return nil
+func (m *SubscriptionOptionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(subscriptionoption.FieldDescription) {
+		fields = append(fields, subscriptionoption.FieldDescription)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SubscriptionZkEVMMutation) FieldCleared(name string) bool {
+func (m *SubscriptionOptionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SubscriptionZkEVMMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown SubscriptionZkEVM nullable field %s", name)
+func (m *SubscriptionOptionMutation) ClearField(name string) error {
+	switch name {
+	case subscriptionoption.FieldDescription:
+		m.ClearDescription()
+		return nil
+	}
+	return fmt.Errorf("unknown SubscriptionOption nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SubscriptionZkEVMMutation) ResetField(name string) error {
-	return fmt.Errorf("unknown SubscriptionZkEVM field %s", name)
+func (m *SubscriptionOptionMutation) ResetField(name string) error {
+	switch name {
+	case subscriptionoption.FieldModelID:
+		m.ResetModelID()
+		return nil
+	case subscriptionoption.FieldPrice:
+		m.ResetPrice()
+		return nil
+	case subscriptionoption.FieldDuration:
+		m.ResetDuration()
+		return nil
+	case subscriptionoption.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case subscriptionoption.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown SubscriptionOption field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SubscriptionZkEVMMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *SubscriptionOptionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.model != nil {
+		edges = append(edges, subscriptionoption.EdgeModel)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SubscriptionZkEVMMutation) AddedIDs(name string) []ent.Value {
+func (m *SubscriptionOptionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case subscriptionoption.EdgeModel:
+		if id := m.model; id != nil {
+			return []ent.Value{*id}
+		}
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SubscriptionZkEVMMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *SubscriptionOptionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SubscriptionZkEVMMutation) RemovedIDs(name string) []ent.Value {
+func (m *SubscriptionOptionMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SubscriptionZkEVMMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *SubscriptionOptionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedmodel {
+		edges = append(edges, subscriptionoption.EdgeModel)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SubscriptionZkEVMMutation) EdgeCleared(name string) bool {
+func (m *SubscriptionOptionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case subscriptionoption.EdgeModel:
+		return m.clearedmodel
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SubscriptionZkEVMMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown SubscriptionZkEVM unique edge %s", name)
+func (m *SubscriptionOptionMutation) ClearEdge(name string) error {
+	switch name {
+	case subscriptionoption.EdgeModel:
+		m.ClearModel()
+		return nil
+	}
+	return fmt.Errorf("unknown SubscriptionOption unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SubscriptionZkEVMMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown SubscriptionZkEVM edge %s", name)
+func (m *SubscriptionOptionMutation) ResetEdge(name string) error {
+	switch name {
+	case subscriptionoption.EdgeModel:
+		m.ResetModel()
+		return nil
+	}
+	return fmt.Errorf("unknown SubscriptionOption edge %s", name)
 }
 
 // UserMutation represents an operation that mutates the User nodes in the graph.
 type UserMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*User, error)
-	predicates    []predicate.User
+	op                   Op
+	typ                  string
+	id                   *string
+	username             *string
+	email                *string
+	wallet_address       *string
+	ipfs_url             *string
+	openai_token_id      *string
+	clearedFields        map[string]struct{}
+	subscriptions        map[string]struct{}
+	removedsubscriptions map[string]struct{}
+	clearedsubscriptions bool
+	done                 bool
+	oldValue             func(context.Context) (*User, error)
+	predicates           []predicate.User
 }
 
 var _ ent.Mutation = (*UserMutation)(nil)
@@ -1382,7 +3557,7 @@ func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
 }
 
 // withUserID sets the ID field of the mutation.
-func withUserID(id int) userOption {
+func withUserID(id string) userOption {
 	return func(m *UserMutation) {
 		var (
 			err   error
@@ -1432,9 +3607,15 @@ func (m UserMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of User entities.
+func (m *UserMutation) SetID(id string) {
+	m.id = &id
+}
+
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *UserMutation) ID() (id int, exists bool) {
+func (m *UserMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1445,12 +3626,12 @@ func (m *UserMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *UserMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []int{id}, nil
+			return []string{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
@@ -1460,6 +3641,266 @@ func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
 	}
 }
 
+// SetUsername sets the "username" field.
+func (m *UserMutation) SetUsername(s string) {
+	m.username = &s
+}
+
+// Username returns the value of the "username" field in the mutation.
+func (m *UserMutation) Username() (r string, exists bool) {
+	v := m.username
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsername returns the old "username" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldUsername(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsername requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+	}
+	return oldValue.Username, nil
+}
+
+// ResetUsername resets all changes to the "username" field.
+func (m *UserMutation) ResetUsername() {
+	m.username = nil
+}
+
+// SetEmail sets the "email" field.
+func (m *UserMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *UserMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *UserMutation) ResetEmail() {
+	m.email = nil
+}
+
+// SetWalletAddress sets the "wallet_address" field.
+func (m *UserMutation) SetWalletAddress(s string) {
+	m.wallet_address = &s
+}
+
+// WalletAddress returns the value of the "wallet_address" field in the mutation.
+func (m *UserMutation) WalletAddress() (r string, exists bool) {
+	v := m.wallet_address
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWalletAddress returns the old "wallet_address" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldWalletAddress(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWalletAddress is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWalletAddress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWalletAddress: %w", err)
+	}
+	return oldValue.WalletAddress, nil
+}
+
+// ResetWalletAddress resets all changes to the "wallet_address" field.
+func (m *UserMutation) ResetWalletAddress() {
+	m.wallet_address = nil
+}
+
+// SetIpfsURL sets the "ipfs_url" field.
+func (m *UserMutation) SetIpfsURL(s string) {
+	m.ipfs_url = &s
+}
+
+// IpfsURL returns the value of the "ipfs_url" field in the mutation.
+func (m *UserMutation) IpfsURL() (r string, exists bool) {
+	v := m.ipfs_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIpfsURL returns the old "ipfs_url" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldIpfsURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIpfsURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIpfsURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIpfsURL: %w", err)
+	}
+	return oldValue.IpfsURL, nil
+}
+
+// ClearIpfsURL clears the value of the "ipfs_url" field.
+func (m *UserMutation) ClearIpfsURL() {
+	m.ipfs_url = nil
+	m.clearedFields[user.FieldIpfsURL] = struct{}{}
+}
+
+// IpfsURLCleared returns if the "ipfs_url" field was cleared in this mutation.
+func (m *UserMutation) IpfsURLCleared() bool {
+	_, ok := m.clearedFields[user.FieldIpfsURL]
+	return ok
+}
+
+// ResetIpfsURL resets all changes to the "ipfs_url" field.
+func (m *UserMutation) ResetIpfsURL() {
+	m.ipfs_url = nil
+	delete(m.clearedFields, user.FieldIpfsURL)
+}
+
+// SetOpenaiTokenID sets the "openai_token_id" field.
+func (m *UserMutation) SetOpenaiTokenID(s string) {
+	m.openai_token_id = &s
+}
+
+// OpenaiTokenID returns the value of the "openai_token_id" field in the mutation.
+func (m *UserMutation) OpenaiTokenID() (r string, exists bool) {
+	v := m.openai_token_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOpenaiTokenID returns the old "openai_token_id" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldOpenaiTokenID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOpenaiTokenID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOpenaiTokenID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOpenaiTokenID: %w", err)
+	}
+	return oldValue.OpenaiTokenID, nil
+}
+
+// ClearOpenaiTokenID clears the value of the "openai_token_id" field.
+func (m *UserMutation) ClearOpenaiTokenID() {
+	m.openai_token_id = nil
+	m.clearedFields[user.FieldOpenaiTokenID] = struct{}{}
+}
+
+// OpenaiTokenIDCleared returns if the "openai_token_id" field was cleared in this mutation.
+func (m *UserMutation) OpenaiTokenIDCleared() bool {
+	_, ok := m.clearedFields[user.FieldOpenaiTokenID]
+	return ok
+}
+
+// ResetOpenaiTokenID resets all changes to the "openai_token_id" field.
+func (m *UserMutation) ResetOpenaiTokenID() {
+	m.openai_token_id = nil
+	delete(m.clearedFields, user.FieldOpenaiTokenID)
+}
+
+// AddSubscriptionIDs adds the "subscriptions" edge to the Subscription entity by ids.
+func (m *UserMutation) AddSubscriptionIDs(ids ...string) {
+	if m.subscriptions == nil {
+		m.subscriptions = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.subscriptions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSubscriptions clears the "subscriptions" edge to the Subscription entity.
+func (m *UserMutation) ClearSubscriptions() {
+	m.clearedsubscriptions = true
+}
+
+// SubscriptionsCleared reports if the "subscriptions" edge to the Subscription entity was cleared.
+func (m *UserMutation) SubscriptionsCleared() bool {
+	return m.clearedsubscriptions
+}
+
+// RemoveSubscriptionIDs removes the "subscriptions" edge to the Subscription entity by IDs.
+func (m *UserMutation) RemoveSubscriptionIDs(ids ...string) {
+	if m.removedsubscriptions == nil {
+		m.removedsubscriptions = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.subscriptions, ids[i])
+		m.removedsubscriptions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSubscriptions returns the removed IDs of the "subscriptions" edge to the Subscription entity.
+func (m *UserMutation) RemovedSubscriptionsIDs() (ids []string) {
+	for id := range m.removedsubscriptions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SubscriptionsIDs returns the "subscriptions" edge IDs in the mutation.
+func (m *UserMutation) SubscriptionsIDs() (ids []string) {
+	for id := range m.subscriptions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSubscriptions resets all changes to the "subscriptions" edge.
+func (m *UserMutation) ResetSubscriptions() {
+	m.subscriptions = nil
+	m.clearedsubscriptions = false
+	m.removedsubscriptions = nil
+}
+
 // Where appends a list predicates to the UserMutation builder.
 func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
@@ -1494,7 +3935,22 @@ func (m *UserMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 0)
+	fields := make([]string, 0, 5)
+	if m.username != nil {
+		fields = append(fields, user.FieldUsername)
+	}
+	if m.email != nil {
+		fields = append(fields, user.FieldEmail)
+	}
+	if m.wallet_address != nil {
+		fields = append(fields, user.FieldWalletAddress)
+	}
+	if m.ipfs_url != nil {
+		fields = append(fields, user.FieldIpfsURL)
+	}
+	if m.openai_token_id != nil {
+		fields = append(fields, user.FieldOpenaiTokenID)
+	}
 	return fields
 }
 
@@ -1502,6 +3958,18 @@ func (m *UserMutation) Fields() []string {
 // return value indicates that this field was not set, or was not defined in the
 // schema.
 func (m *UserMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case user.FieldUsername:
+		return m.Username()
+	case user.FieldEmail:
+		return m.Email()
+	case user.FieldWalletAddress:
+		return m.WalletAddress()
+	case user.FieldIpfsURL:
+		return m.IpfsURL()
+	case user.FieldOpenaiTokenID:
+		return m.OpenaiTokenID()
+	}
 	return nil, false
 }
 
@@ -1509,6 +3977,18 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
 func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case user.FieldUsername:
+		return m.OldUsername(ctx)
+	case user.FieldEmail:
+		return m.OldEmail(ctx)
+	case user.FieldWalletAddress:
+		return m.OldWalletAddress(ctx)
+	case user.FieldIpfsURL:
+		return m.OldIpfsURL(ctx)
+	case user.FieldOpenaiTokenID:
+		return m.OldOpenaiTokenID(ctx)
+	}
 	return nil, fmt.Errorf("unknown User field %s", name)
 }
 
@@ -1517,6 +3997,41 @@ func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, er
 // type.
 func (m *UserMutation) SetField(name string, value ent.Value) error {
 	switch name {
+	case user.FieldUsername:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsername(v)
+		return nil
+	case user.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case user.FieldWalletAddress:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWalletAddress(v)
+		return nil
+	case user.FieldIpfsURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIpfsURL(v)
+		return nil
+	case user.FieldOpenaiTokenID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOpenaiTokenID(v)
+		return nil
 	}
 	return fmt.Errorf("unknown User field %s", name)
 }
@@ -1538,13 +4053,22 @@ func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
 func (m *UserMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
 	return fmt.Errorf("unknown User numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
 func (m *UserMutation) ClearedFields() []string {
-	return nil
+	var fields []string
+	if m.FieldCleared(user.FieldIpfsURL) {
+		fields = append(fields, user.FieldIpfsURL)
+	}
+	if m.FieldCleared(user.FieldOpenaiTokenID) {
+		fields = append(fields, user.FieldOpenaiTokenID)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
@@ -1557,59 +4081,120 @@ func (m *UserMutation) FieldCleared(name string) bool {
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *UserMutation) ClearField(name string) error {
+	switch name {
+	case user.FieldIpfsURL:
+		m.ClearIpfsURL()
+		return nil
+	case user.FieldOpenaiTokenID:
+		m.ClearOpenaiTokenID()
+		return nil
+	}
 	return fmt.Errorf("unknown User nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
 func (m *UserMutation) ResetField(name string) error {
+	switch name {
+	case user.FieldUsername:
+		m.ResetUsername()
+		return nil
+	case user.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case user.FieldWalletAddress:
+		m.ResetWalletAddress()
+		return nil
+	case user.FieldIpfsURL:
+		m.ResetIpfsURL()
+		return nil
+	case user.FieldOpenaiTokenID:
+		m.ResetOpenaiTokenID()
+		return nil
+	}
 	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+	edges := make([]string, 0, 1)
+	if m.subscriptions != nil {
+		edges = append(edges, user.EdgeSubscriptions)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
 func (m *UserMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgeSubscriptions:
+		ids := make([]ent.Value, 0, len(m.subscriptions))
+		for id := range m.subscriptions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+	edges := make([]string, 0, 1)
+	if m.removedsubscriptions != nil {
+		edges = append(edges, user.EdgeSubscriptions)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
 func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgeSubscriptions:
+		ids := make([]ent.Value, 0, len(m.removedsubscriptions))
+		for id := range m.removedsubscriptions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+	edges := make([]string, 0, 1)
+	if m.clearedsubscriptions {
+		edges = append(edges, user.EdgeSubscriptions)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
 func (m *UserMutation) EdgeCleared(name string) bool {
+	switch name {
+	case user.EdgeSubscriptions:
+		return m.clearedsubscriptions
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
 func (m *UserMutation) ClearEdge(name string) error {
+	switch name {
+	}
 	return fmt.Errorf("unknown User unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
 func (m *UserMutation) ResetEdge(name string) error {
+	switch name {
+	case user.EdgeSubscriptions:
+		m.ResetSubscriptions()
+		return nil
+	}
 	return fmt.Errorf("unknown User edge %s", name)
 }
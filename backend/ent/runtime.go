@@ -2,8 +2,137 @@
 
 package ent
 
+import (
+	"arjunmal1311/fans_flow_on_chain/backend/ent/model"
+	"arjunmal1311/fans_flow_on_chain/backend/ent/schema"
+	"arjunmal1311/fans_flow_on_chain/backend/ent/subscription"
+	"arjunmal1311/fans_flow_on_chain/backend/ent/subscriptionoption"
+	"arjunmal1311/fans_flow_on_chain/backend/ent/user"
+	"time"
+)
+
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	modelMixin := schema.Model{}.Mixin()
+	modelMixinFields0 := modelMixin[0].Fields()
+	_ = modelMixinFields0
+	modelFields := schema.Model{}.Fields()
+	_ = modelFields
+	// modelDescValue is the schema descriptor for value field.
+	modelDescValue := modelFields[9].Descriptor()
+	// model.DefaultValue holds the default value on creation for the value field.
+	model.DefaultValue = modelDescValue.Default.(float64)
+	// modelDescViews is the schema descriptor for views field.
+	modelDescViews := modelFields[10].Descriptor()
+	// model.DefaultViews holds the default value on creation for the views field.
+	model.DefaultViews = modelDescViews.Default.(int64)
+	// modelDescTease is the schema descriptor for tease field.
+	modelDescTease := modelFields[11].Descriptor()
+	// model.DefaultTease holds the default value on creation for the tease field.
+	model.DefaultTease = modelDescTease.Default.(int64)
+	// modelDescPosts is the schema descriptor for posts field.
+	modelDescPosts := modelFields[12].Descriptor()
+	// model.DefaultPosts holds the default value on creation for the posts field.
+	model.DefaultPosts = modelDescPosts.Default.(int64)
+	// modelDescID is the schema descriptor for id field.
+	modelDescID := modelMixinFields0[0].Descriptor()
+	// model.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	model.IDValidator = func() func(string) error {
+		validators := modelDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	subscriptionMixin := schema.Subscription{}.Mixin()
+	subscriptionMixinFields0 := subscriptionMixin[0].Fields()
+	_ = subscriptionMixinFields0
+	subscriptionFields := schema.Subscription{}.Fields()
+	_ = subscriptionFields
+	// subscriptionDescIsListed is the schema descriptor for is_listed field.
+	subscriptionDescIsListed := subscriptionFields[7].Descriptor()
+	// subscription.DefaultIsListed holds the default value on creation for the is_listed field.
+	subscription.DefaultIsListed = subscriptionDescIsListed.Default.(bool)
+	// subscriptionDescExpired is the schema descriptor for expired field.
+	subscriptionDescExpired := subscriptionFields[10].Descriptor()
+	// subscription.DefaultExpired holds the default value on creation for the expired field.
+	subscription.DefaultExpired = subscriptionDescExpired.Default.(bool)
+	// subscriptionDescID is the schema descriptor for id field.
+	subscriptionDescID := subscriptionMixinFields0[0].Descriptor()
+	// subscription.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	subscription.IDValidator = func() func(string) error {
+		validators := subscriptionDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	subscriptionoptionMixin := schema.SubscriptionOption{}.Mixin()
+	subscriptionoptionMixinFields0 := subscriptionoptionMixin[0].Fields()
+	_ = subscriptionoptionMixinFields0
+	subscriptionoptionFields := schema.SubscriptionOption{}.Fields()
+	_ = subscriptionoptionFields
+	// subscriptionoptionDescCreatedAt is the schema descriptor for created_at field.
+	subscriptionoptionDescCreatedAt := subscriptionoptionFields[4].Descriptor()
+	// subscriptionoption.DefaultCreatedAt holds the default value on creation for the created_at field.
+	subscriptionoption.DefaultCreatedAt = subscriptionoptionDescCreatedAt.Default.(func() time.Time)
+	// subscriptionoptionDescID is the schema descriptor for id field.
+	subscriptionoptionDescID := subscriptionoptionMixinFields0[0].Descriptor()
+	// subscriptionoption.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	subscriptionoption.IDValidator = func() func(string) error {
+		validators := subscriptionoptionDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	userMixin := schema.User{}.Mixin()
+	userMixinFields0 := userMixin[0].Fields()
+	_ = userMixinFields0
+	userFields := schema.User{}.Fields()
+	_ = userFields
+	// userDescID is the schema descriptor for id field.
+	userDescID := userMixinFields0[0].Descriptor()
+	// user.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	user.IDValidator = func() func(string) error {
+		validators := userDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
 }
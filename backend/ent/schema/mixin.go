@@ -0,0 +1,24 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+)
+
+// ObjectIDMixin keys an entity by the hex form of a MongoDB ObjectID, so
+// ids are the same whichever backend stores them.
+type ObjectIDMixin struct {
+	mixin.Schema
+}
+
+// Fields of the ObjectIDMixin.
+func (ObjectIDMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			MaxLen(24).
+			NotEmpty().
+			Unique().
+			Immutable(),
+	}
+}
@@ -1,18 +1,56 @@
 package schema
 
-import "entgo.io/ent"
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
 
 // Model holds the schema definition for the Model entity.
 type Model struct {
 	ent.Schema
 }
 
+// Mixin of the Model.
+func (Model) Mixin() []ent.Mixin {
+	return []ent.Mixin{ObjectIDMixin{}}
+}
+
 // Fields of the Model.
 func (Model) Fields() []ent.Field {
-	return nil
+	return []ent.Field{
+		field.String("name"),
+		field.String("model_id").Unique(),
+		field.String("email"),
+		field.String("wallet_address"),
+		field.String("ipfs_url").Optional(),
+		field.String("openai_token_id").Optional(),
+		// Models registered without a slug store NULL, which the unique
+		// index ignores.
+		field.String("slug").Optional().Nillable().Unique(),
+		field.String("location").Optional(),
+		field.Text("about_me").Optional(),
+		field.Float("value").Default(0),
+		field.Int64("views").Default(0),
+		field.Int64("tease").Default(0),
+		field.Int64("posts").Default(0),
+		field.String("image_src").Optional(),
+		field.String("icon_src").Optional(),
+	}
 }
 
 // Edges of the Model.
 func (Model) Edges() []ent.Edge {
-	return nil
+	return []ent.Edge{
+		edge.To("options", SubscriptionOption.Type),
+		edge.To("subscriptions", Subscription.Type),
+	}
+}
+
+// Indexes of the Model.
+func (Model) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("wallet_address"),
+	}
 }
@@ -1,18 +1,64 @@
 package schema
 
-import "entgo.io/ent"
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
 
 // Subscription holds the schema definition for the Subscription entity.
 type Subscription struct {
 	ent.Schema
 }
 
+// Mixin of the Subscription.
+func (Subscription) Mixin() []ent.Mixin {
+	return []ent.Mixin{ObjectIDMixin{}}
+}
+
 // Fields of the Subscription.
 func (Subscription) Fields() []ent.Field {
-	return nil
+	return []ent.Field{
+		field.String("chain"),
+		field.String("token_id"),
+		field.String("user_id"),
+		field.String("model_id"),
+		field.String("tx_hash").Optional(),
+		field.String("listing_id").Optional(),
+		field.String("price").Optional(),
+		field.Bool("is_listed").Default(false),
+		field.Time("starts_at").Optional().Nillable(),
+		field.Time("expires_at").Optional().Nillable(),
+		field.Bool("expired").Default(false),
+		field.Bool("held_on_chain").Optional().Nillable(),
+		field.Time("reconciled_at").Optional().Nillable(),
+		field.String("subscription_option_id").Optional().Nillable(),
+	}
 }
 
 // Edges of the Subscription.
 func (Subscription) Edges() []ent.Edge {
-	return nil
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("subscriptions").
+			Field("user_id").
+			Unique().
+			Required(),
+		edge.From("model", Model.Type).
+			Ref("subscriptions").
+			Field("model_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the Subscription.
+func (Subscription) Indexes() []ent.Index {
+	return []ent.Index{
+		// A subscription is keyed by token and holder on each chain.
+		index.Fields("chain", "token_id", "user_id").Unique(),
+		index.Fields("chain", "is_listed"),
+		index.Fields("tx_hash"),
+	}
 }
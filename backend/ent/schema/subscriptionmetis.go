@@ -1,18 +0,0 @@
-package schema
-
-import "entgo.io/ent"
-
-// SubscriptionMetis holds the schema definition for the SubscriptionMetis entity.
-type SubscriptionMetis struct {
-	ent.Schema
-}
-
-// Fields of the SubscriptionMetis.
-func (SubscriptionMetis) Fields() []ent.Field {
-	return nil
-}
-
-// Edges of the SubscriptionMetis.
-func (SubscriptionMetis) Edges() []ent.Edge {
-	return nil
-}
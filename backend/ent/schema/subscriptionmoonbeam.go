@@ -1,18 +0,0 @@
-package schema
-
-import "entgo.io/ent"
-
-// SubscriptionMoonbeam holds the schema definition for the SubscriptionMoonbeam entity.
-type SubscriptionMoonbeam struct {
-	ent.Schema
-}
-
-// Fields of the SubscriptionMoonbeam.
-func (SubscriptionMoonbeam) Fields() []ent.Field {
-	return nil
-}
-
-// Edges of the SubscriptionMoonbeam.
-func (SubscriptionMoonbeam) Edges() []ent.Edge {
-	return nil
-}
@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// SubscriptionOption holds the schema definition for the SubscriptionOption entity.
+type SubscriptionOption struct {
+	ent.Schema
+}
+
+// Mixin of the SubscriptionOption.
+func (SubscriptionOption) Mixin() []ent.Mixin {
+	return []ent.Mixin{ObjectIDMixin{}}
+}
+
+// Fields of the SubscriptionOption.
+func (SubscriptionOption) Fields() []ent.Field {
+	return []ent.Field{
+		// model_id references the model's id; the API names models by
+		// their model_id field instead.
+		field.String("model_id"),
+		field.String("price"),
+		field.Int("duration"),
+		field.Text("description").Optional(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the SubscriptionOption.
+func (SubscriptionOption) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("model", Model.Type).
+			Ref("options").
+			Field("model_id").
+			Unique().
+			Required(),
+	}
+}
@@ -1,18 +0,0 @@
-package schema
-
-import "entgo.io/ent"
-
-// SubscriptionZkEVM holds the schema definition for the SubscriptionZkEVM entity.
-type SubscriptionZkEVM struct {
-	ent.Schema
-}
-
-// Fields of the SubscriptionZkEVM.
-func (SubscriptionZkEVM) Fields() []ent.Field {
-	return nil
-}
-
-// Edges of the SubscriptionZkEVM.
-func (SubscriptionZkEVM) Edges() []ent.Edge {
-	return nil
-}
@@ -1,18 +1,35 @@
 package schema
 
-import "entgo.io/ent"
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
 
 // User holds the schema definition for the User entity.
 type User struct {
 	ent.Schema
 }
 
+// Mixin of the User.
+func (User) Mixin() []ent.Mixin {
+	return []ent.Mixin{ObjectIDMixin{}}
+}
+
 // Fields of the User.
 func (User) Fields() []ent.Field {
-	return nil
+	return []ent.Field{
+		field.String("username").Unique(),
+		field.String("email").Unique(),
+		field.String("wallet_address").Unique(),
+		field.String("ipfs_url").Optional(),
+		field.String("openai_token_id").Optional(),
+	}
 }
 
 // Edges of the User.
 func (User) Edges() []ent.Edge {
-	return nil
+	return []ent.Edge{
+		edge.To("subscriptions", Subscription.Type),
+	}
 }
@@ -3,9 +3,12 @@
 package ent
 
 import (
+	"arjunmal1311/fans_flow_on_chain/backend/ent/model"
 	"arjunmal1311/fans_flow_on_chain/backend/ent/subscription"
+	"arjunmal1311/fans_flow_on_chain/backend/ent/user"
 	"fmt"
 	"strings"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -13,19 +16,87 @@ import (
 
 // Subscription is the model entity for the Subscription schema.
 type Subscription struct {
-	config
+	config `json:"-"`
 	// ID of the ent.
-	ID           int `json:"id,omitempty"`
+	ID string `json:"id,omitempty"`
+	// Chain holds the value of the "chain" field.
+	Chain string `json:"chain,omitempty"`
+	// TokenID holds the value of the "token_id" field.
+	TokenID string `json:"token_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// ModelID holds the value of the "model_id" field.
+	ModelID string `json:"model_id,omitempty"`
+	// TxHash holds the value of the "tx_hash" field.
+	TxHash string `json:"tx_hash,omitempty"`
+	// ListingID holds the value of the "listing_id" field.
+	ListingID string `json:"listing_id,omitempty"`
+	// Price holds the value of the "price" field.
+	Price string `json:"price,omitempty"`
+	// IsListed holds the value of the "is_listed" field.
+	IsListed bool `json:"is_listed,omitempty"`
+	// StartsAt holds the value of the "starts_at" field.
+	StartsAt *time.Time `json:"starts_at,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// Expired holds the value of the "expired" field.
+	Expired bool `json:"expired,omitempty"`
+	// HeldOnChain holds the value of the "held_on_chain" field.
+	HeldOnChain *bool `json:"held_on_chain,omitempty"`
+	// ReconciledAt holds the value of the "reconciled_at" field.
+	ReconciledAt *time.Time `json:"reconciled_at,omitempty"`
+	// SubscriptionOptionID holds the value of the "subscription_option_id" field.
+	SubscriptionOptionID *string `json:"subscription_option_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SubscriptionQuery when eager-loading is set.
+	Edges        SubscriptionEdges `json:"edges"`
 	selectValues sql.SelectValues
 }
 
+// SubscriptionEdges holds the relations/edges for other nodes in the graph.
+type SubscriptionEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Model holds the value of the model edge.
+	Model *Model `json:"model,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SubscriptionEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// ModelOrErr returns the Model value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SubscriptionEdges) ModelOrErr() (*Model, error) {
+	if e.Model != nil {
+		return e.Model, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: model.Label}
+	}
+	return nil, &NotLoadedError{edge: "model"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Subscription) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case subscription.FieldID:
-			values[i] = new(sql.NullInt64)
+		case subscription.FieldIsListed, subscription.FieldExpired, subscription.FieldHeldOnChain:
+			values[i] = new(sql.NullBool)
+		case subscription.FieldID, subscription.FieldChain, subscription.FieldTokenID, subscription.FieldUserID, subscription.FieldModelID, subscription.FieldTxHash, subscription.FieldListingID, subscription.FieldPrice, subscription.FieldSubscriptionOptionID:
+			values[i] = new(sql.NullString)
+		case subscription.FieldStartsAt, subscription.FieldExpiresAt, subscription.FieldReconciledAt:
+			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -42,11 +113,100 @@ func (s *Subscription) assignValues(columns []string, values []any) error {
 	for i := range columns {
 		switch columns[i] {
 		case subscription.FieldID:
-			value, ok := values[i].(*sql.NullInt64)
-			if !ok {
-				return fmt.Errorf("unexpected type %T for field id", value)
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				s.ID = value.String
+			}
+		case subscription.FieldChain:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field chain", values[i])
+			} else if value.Valid {
+				s.Chain = value.String
+			}
+		case subscription.FieldTokenID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token_id", values[i])
+			} else if value.Valid {
+				s.TokenID = value.String
+			}
+		case subscription.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				s.UserID = value.String
+			}
+		case subscription.FieldModelID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field model_id", values[i])
+			} else if value.Valid {
+				s.ModelID = value.String
+			}
+		case subscription.FieldTxHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tx_hash", values[i])
+			} else if value.Valid {
+				s.TxHash = value.String
+			}
+		case subscription.FieldListingID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field listing_id", values[i])
+			} else if value.Valid {
+				s.ListingID = value.String
+			}
+		case subscription.FieldPrice:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field price", values[i])
+			} else if value.Valid {
+				s.Price = value.String
+			}
+		case subscription.FieldIsListed:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_listed", values[i])
+			} else if value.Valid {
+				s.IsListed = value.Bool
+			}
+		case subscription.FieldStartsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field starts_at", values[i])
+			} else if value.Valid {
+				s.StartsAt = new(time.Time)
+				*s.StartsAt = value.Time
+			}
+		case subscription.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				s.ExpiresAt = new(time.Time)
+				*s.ExpiresAt = value.Time
+			}
+		case subscription.FieldExpired:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field expired", values[i])
+			} else if value.Valid {
+				s.Expired = value.Bool
+			}
+		case subscription.FieldHeldOnChain:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field held_on_chain", values[i])
+			} else if value.Valid {
+				s.HeldOnChain = new(bool)
+				*s.HeldOnChain = value.Bool
+			}
+		case subscription.FieldReconciledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reconciled_at", values[i])
+			} else if value.Valid {
+				s.ReconciledAt = new(time.Time)
+				*s.ReconciledAt = value.Time
+			}
+		case subscription.FieldSubscriptionOptionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subscription_option_id", values[i])
+			} else if value.Valid {
+				s.SubscriptionOptionID = new(string)
+				*s.SubscriptionOptionID = value.String
 			}
-			s.ID = int(value.Int64)
 		default:
 			s.selectValues.Set(columns[i], values[i])
 		}
@@ -60,6 +220,16 @@ func (s *Subscription) Value(name string) (ent.Value, error) {
 	return s.selectValues.Get(name)
 }
 
+// QueryUser queries the "user" edge of the Subscription entity.
+func (s *Subscription) QueryUser() *UserQuery {
+	return NewSubscriptionClient(s.config).QueryUser(s)
+}
+
+// QueryModel queries the "model" edge of the Subscription entity.
+func (s *Subscription) QueryModel() *ModelQuery {
+	return NewSubscriptionClient(s.config).QueryModel(s)
+}
+
 // Update returns a builder for updating this Subscription.
 // Note that you need to call Subscription.Unwrap() before calling this method if this Subscription
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -82,7 +252,58 @@ func (s *Subscription) Unwrap() *Subscription {
 func (s *Subscription) String() string {
 	var builder strings.Builder
 	builder.WriteString("Subscription(")
-	builder.WriteString(fmt.Sprintf("id=%v", s.ID))
+	builder.WriteString(fmt.Sprintf("id=%v, ", s.ID))
+	builder.WriteString("chain=")
+	builder.WriteString(s.Chain)
+	builder.WriteString(", ")
+	builder.WriteString("token_id=")
+	builder.WriteString(s.TokenID)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(s.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("model_id=")
+	builder.WriteString(s.ModelID)
+	builder.WriteString(", ")
+	builder.WriteString("tx_hash=")
+	builder.WriteString(s.TxHash)
+	builder.WriteString(", ")
+	builder.WriteString("listing_id=")
+	builder.WriteString(s.ListingID)
+	builder.WriteString(", ")
+	builder.WriteString("price=")
+	builder.WriteString(s.Price)
+	builder.WriteString(", ")
+	builder.WriteString("is_listed=")
+	builder.WriteString(fmt.Sprintf("%v", s.IsListed))
+	builder.WriteString(", ")
+	if v := s.StartsAt; v != nil {
+		builder.WriteString("starts_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := s.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("expired=")
+	builder.WriteString(fmt.Sprintf("%v", s.Expired))
+	builder.WriteString(", ")
+	if v := s.HeldOnChain; v != nil {
+		builder.WriteString("held_on_chain=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := s.ReconciledAt; v != nil {
+		builder.WriteString("reconciled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := s.SubscriptionOptionID; v != nil {
+		builder.WriteString("subscription_option_id=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
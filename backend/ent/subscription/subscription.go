@@ -4,6 +4,7 @@ package subscription
 
 import (
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 )
 
 const (
@@ -11,13 +12,73 @@ const (
 	Label = "subscription"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldChain holds the string denoting the chain field in the database.
+	FieldChain = "chain"
+	// FieldTokenID holds the string denoting the token_id field in the database.
+	FieldTokenID = "token_id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldModelID holds the string denoting the model_id field in the database.
+	FieldModelID = "model_id"
+	// FieldTxHash holds the string denoting the tx_hash field in the database.
+	FieldTxHash = "tx_hash"
+	// FieldListingID holds the string denoting the listing_id field in the database.
+	FieldListingID = "listing_id"
+	// FieldPrice holds the string denoting the price field in the database.
+	FieldPrice = "price"
+	// FieldIsListed holds the string denoting the is_listed field in the database.
+	FieldIsListed = "is_listed"
+	// FieldStartsAt holds the string denoting the starts_at field in the database.
+	FieldStartsAt = "starts_at"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// FieldExpired holds the string denoting the expired field in the database.
+	FieldExpired = "expired"
+	// FieldHeldOnChain holds the string denoting the held_on_chain field in the database.
+	FieldHeldOnChain = "held_on_chain"
+	// FieldReconciledAt holds the string denoting the reconciled_at field in the database.
+	FieldReconciledAt = "reconciled_at"
+	// FieldSubscriptionOptionID holds the string denoting the subscription_option_id field in the database.
+	FieldSubscriptionOptionID = "subscription_option_id"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// EdgeModel holds the string denoting the model edge name in mutations.
+	EdgeModel = "model"
 	// Table holds the table name of the subscription in the database.
 	Table = "subscriptions"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "subscriptions"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_id"
+	// ModelTable is the table that holds the model relation/edge.
+	ModelTable = "subscriptions"
+	// ModelInverseTable is the table name for the Model entity.
+	// It exists in this package in order to avoid circular dependency with the "model" package.
+	ModelInverseTable = "models"
+	// ModelColumn is the table column denoting the model relation/edge.
+	ModelColumn = "model_id"
 )
 
 // Columns holds all SQL columns for subscription fields.
 var Columns = []string{
 	FieldID,
+	FieldChain,
+	FieldTokenID,
+	FieldUserID,
+	FieldModelID,
+	FieldTxHash,
+	FieldListingID,
+	FieldPrice,
+	FieldIsListed,
+	FieldStartsAt,
+	FieldExpiresAt,
+	FieldExpired,
+	FieldHeldOnChain,
+	FieldReconciledAt,
+	FieldSubscriptionOptionID,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -30,6 +91,15 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+var (
+	// DefaultIsListed holds the default value on creation for the "is_listed" field.
+	DefaultIsListed bool
+	// DefaultExpired holds the default value on creation for the "expired" field.
+	DefaultExpired bool
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
 // OrderOption defines the ordering options for the Subscription queries.
 type OrderOption func(*sql.Selector)
 
@@ -37,3 +107,101 @@ type OrderOption func(*sql.Selector)
 func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
+
+// ByChain orders the results by the chain field.
+func ByChain(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChain, opts...).ToFunc()
+}
+
+// ByTokenID orders the results by the token_id field.
+func ByTokenID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTokenID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByModelID orders the results by the model_id field.
+func ByModelID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModelID, opts...).ToFunc()
+}
+
+// ByTxHash orders the results by the tx_hash field.
+func ByTxHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTxHash, opts...).ToFunc()
+}
+
+// ByListingID orders the results by the listing_id field.
+func ByListingID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldListingID, opts...).ToFunc()
+}
+
+// ByPrice orders the results by the price field.
+func ByPrice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPrice, opts...).ToFunc()
+}
+
+// ByIsListed orders the results by the is_listed field.
+func ByIsListed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsListed, opts...).ToFunc()
+}
+
+// ByStartsAt orders the results by the starts_at field.
+func ByStartsAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartsAt, opts...).ToFunc()
+}
+
+// ByExpiresAt orders the results by the expires_at field.
+func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
+}
+
+// ByExpired orders the results by the expired field.
+func ByExpired(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpired, opts...).ToFunc()
+}
+
+// ByHeldOnChain orders the results by the held_on_chain field.
+func ByHeldOnChain(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHeldOnChain, opts...).ToFunc()
+}
+
+// ByReconciledAt orders the results by the reconciled_at field.
+func ByReconciledAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReconciledAt, opts...).ToFunc()
+}
+
+// BySubscriptionOptionID orders the results by the subscription_option_id field.
+func BySubscriptionOptionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubscriptionOptionID, opts...).ToFunc()
+}
+
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByModelField orders the results by model field.
+func ByModelField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newModelStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+	)
+}
+func newModelStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ModelInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ModelTable, ModelColumn),
+	)
+}
@@ -4,55 +4,933 @@ package subscription
 
 import (
 	"arjunmal1311/fans_flow_on_chain/backend/ent/predicate"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 )
 
 // ID filters vertices based on their ID field.
-func ID(id int) predicate.Subscription {
+func ID(id string) predicate.Subscription {
 	return predicate.Subscription(sql.FieldEQ(FieldID, id))
 }
 
 // IDEQ applies the EQ predicate on the ID field.
-func IDEQ(id int) predicate.Subscription {
+func IDEQ(id string) predicate.Subscription {
 	return predicate.Subscription(sql.FieldEQ(FieldID, id))
 }
 
 // IDNEQ applies the NEQ predicate on the ID field.
-func IDNEQ(id int) predicate.Subscription {
+func IDNEQ(id string) predicate.Subscription {
 	return predicate.Subscription(sql.FieldNEQ(FieldID, id))
 }
 
 // IDIn applies the In predicate on the ID field.
-func IDIn(ids ...int) predicate.Subscription {
+func IDIn(ids ...string) predicate.Subscription {
 	return predicate.Subscription(sql.FieldIn(FieldID, ids...))
 }
 
 // IDNotIn applies the NotIn predicate on the ID field.
-func IDNotIn(ids ...int) predicate.Subscription {
+func IDNotIn(ids ...string) predicate.Subscription {
 	return predicate.Subscription(sql.FieldNotIn(FieldID, ids...))
 }
 
 // IDGT applies the GT predicate on the ID field.
-func IDGT(id int) predicate.Subscription {
+func IDGT(id string) predicate.Subscription {
 	return predicate.Subscription(sql.FieldGT(FieldID, id))
 }
 
 // IDGTE applies the GTE predicate on the ID field.
-func IDGTE(id int) predicate.Subscription {
+func IDGTE(id string) predicate.Subscription {
 	return predicate.Subscription(sql.FieldGTE(FieldID, id))
 }
 
 // IDLT applies the LT predicate on the ID field.
-func IDLT(id int) predicate.Subscription {
+func IDLT(id string) predicate.Subscription {
 	return predicate.Subscription(sql.FieldLT(FieldID, id))
 }
 
 // IDLTE applies the LTE predicate on the ID field.
-func IDLTE(id int) predicate.Subscription {
+func IDLTE(id string) predicate.Subscription {
 	return predicate.Subscription(sql.FieldLTE(FieldID, id))
 }
 
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContainsFold(FieldID, id))
+}
+
+// Chain applies equality check predicate on the "chain" field. It's identical to ChainEQ.
+func Chain(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldChain, v))
+}
+
+// TokenID applies equality check predicate on the "token_id" field. It's identical to TokenIDEQ.
+func TokenID(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldTokenID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldUserID, v))
+}
+
+// ModelID applies equality check predicate on the "model_id" field. It's identical to ModelIDEQ.
+func ModelID(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldModelID, v))
+}
+
+// TxHash applies equality check predicate on the "tx_hash" field. It's identical to TxHashEQ.
+func TxHash(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldTxHash, v))
+}
+
+// ListingID applies equality check predicate on the "listing_id" field. It's identical to ListingIDEQ.
+func ListingID(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldListingID, v))
+}
+
+// Price applies equality check predicate on the "price" field. It's identical to PriceEQ.
+func Price(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldPrice, v))
+}
+
+// IsListed applies equality check predicate on the "is_listed" field. It's identical to IsListedEQ.
+func IsListed(v bool) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldIsListed, v))
+}
+
+// StartsAt applies equality check predicate on the "starts_at" field. It's identical to StartsAtEQ.
+func StartsAt(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldStartsAt, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// Expired applies equality check predicate on the "expired" field. It's identical to ExpiredEQ.
+func Expired(v bool) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldExpired, v))
+}
+
+// HeldOnChain applies equality check predicate on the "held_on_chain" field. It's identical to HeldOnChainEQ.
+func HeldOnChain(v bool) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldHeldOnChain, v))
+}
+
+// ReconciledAt applies equality check predicate on the "reconciled_at" field. It's identical to ReconciledAtEQ.
+func ReconciledAt(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldReconciledAt, v))
+}
+
+// SubscriptionOptionID applies equality check predicate on the "subscription_option_id" field. It's identical to SubscriptionOptionIDEQ.
+func SubscriptionOptionID(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldSubscriptionOptionID, v))
+}
+
+// ChainEQ applies the EQ predicate on the "chain" field.
+func ChainEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldChain, v))
+}
+
+// ChainNEQ applies the NEQ predicate on the "chain" field.
+func ChainNEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldChain, v))
+}
+
+// ChainIn applies the In predicate on the "chain" field.
+func ChainIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldChain, vs...))
+}
+
+// ChainNotIn applies the NotIn predicate on the "chain" field.
+func ChainNotIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldChain, vs...))
+}
+
+// ChainGT applies the GT predicate on the "chain" field.
+func ChainGT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldChain, v))
+}
+
+// ChainGTE applies the GTE predicate on the "chain" field.
+func ChainGTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldChain, v))
+}
+
+// ChainLT applies the LT predicate on the "chain" field.
+func ChainLT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldChain, v))
+}
+
+// ChainLTE applies the LTE predicate on the "chain" field.
+func ChainLTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldChain, v))
+}
+
+// ChainContains applies the Contains predicate on the "chain" field.
+func ChainContains(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContains(FieldChain, v))
+}
+
+// ChainHasPrefix applies the HasPrefix predicate on the "chain" field.
+func ChainHasPrefix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasPrefix(FieldChain, v))
+}
+
+// ChainHasSuffix applies the HasSuffix predicate on the "chain" field.
+func ChainHasSuffix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasSuffix(FieldChain, v))
+}
+
+// ChainEqualFold applies the EqualFold predicate on the "chain" field.
+func ChainEqualFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEqualFold(FieldChain, v))
+}
+
+// ChainContainsFold applies the ContainsFold predicate on the "chain" field.
+func ChainContainsFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContainsFold(FieldChain, v))
+}
+
+// TokenIDEQ applies the EQ predicate on the "token_id" field.
+func TokenIDEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldTokenID, v))
+}
+
+// TokenIDNEQ applies the NEQ predicate on the "token_id" field.
+func TokenIDNEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldTokenID, v))
+}
+
+// TokenIDIn applies the In predicate on the "token_id" field.
+func TokenIDIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldTokenID, vs...))
+}
+
+// TokenIDNotIn applies the NotIn predicate on the "token_id" field.
+func TokenIDNotIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldTokenID, vs...))
+}
+
+// TokenIDGT applies the GT predicate on the "token_id" field.
+func TokenIDGT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldTokenID, v))
+}
+
+// TokenIDGTE applies the GTE predicate on the "token_id" field.
+func TokenIDGTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldTokenID, v))
+}
+
+// TokenIDLT applies the LT predicate on the "token_id" field.
+func TokenIDLT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldTokenID, v))
+}
+
+// TokenIDLTE applies the LTE predicate on the "token_id" field.
+func TokenIDLTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldTokenID, v))
+}
+
+// TokenIDContains applies the Contains predicate on the "token_id" field.
+func TokenIDContains(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContains(FieldTokenID, v))
+}
+
+// TokenIDHasPrefix applies the HasPrefix predicate on the "token_id" field.
+func TokenIDHasPrefix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasPrefix(FieldTokenID, v))
+}
+
+// TokenIDHasSuffix applies the HasSuffix predicate on the "token_id" field.
+func TokenIDHasSuffix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasSuffix(FieldTokenID, v))
+}
+
+// TokenIDEqualFold applies the EqualFold predicate on the "token_id" field.
+func TokenIDEqualFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEqualFold(FieldTokenID, v))
+}
+
+// TokenIDContainsFold applies the ContainsFold predicate on the "token_id" field.
+func TokenIDContainsFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContainsFold(FieldTokenID, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// ModelIDEQ applies the EQ predicate on the "model_id" field.
+func ModelIDEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldModelID, v))
+}
+
+// ModelIDNEQ applies the NEQ predicate on the "model_id" field.
+func ModelIDNEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldModelID, v))
+}
+
+// ModelIDIn applies the In predicate on the "model_id" field.
+func ModelIDIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldModelID, vs...))
+}
+
+// ModelIDNotIn applies the NotIn predicate on the "model_id" field.
+func ModelIDNotIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldModelID, vs...))
+}
+
+// ModelIDGT applies the GT predicate on the "model_id" field.
+func ModelIDGT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldModelID, v))
+}
+
+// ModelIDGTE applies the GTE predicate on the "model_id" field.
+func ModelIDGTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldModelID, v))
+}
+
+// ModelIDLT applies the LT predicate on the "model_id" field.
+func ModelIDLT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldModelID, v))
+}
+
+// ModelIDLTE applies the LTE predicate on the "model_id" field.
+func ModelIDLTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldModelID, v))
+}
+
+// ModelIDContains applies the Contains predicate on the "model_id" field.
+func ModelIDContains(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContains(FieldModelID, v))
+}
+
+// ModelIDHasPrefix applies the HasPrefix predicate on the "model_id" field.
+func ModelIDHasPrefix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasPrefix(FieldModelID, v))
+}
+
+// ModelIDHasSuffix applies the HasSuffix predicate on the "model_id" field.
+func ModelIDHasSuffix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasSuffix(FieldModelID, v))
+}
+
+// ModelIDEqualFold applies the EqualFold predicate on the "model_id" field.
+func ModelIDEqualFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEqualFold(FieldModelID, v))
+}
+
+// ModelIDContainsFold applies the ContainsFold predicate on the "model_id" field.
+func ModelIDContainsFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContainsFold(FieldModelID, v))
+}
+
+// TxHashEQ applies the EQ predicate on the "tx_hash" field.
+func TxHashEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldTxHash, v))
+}
+
+// TxHashNEQ applies the NEQ predicate on the "tx_hash" field.
+func TxHashNEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldTxHash, v))
+}
+
+// TxHashIn applies the In predicate on the "tx_hash" field.
+func TxHashIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldTxHash, vs...))
+}
+
+// TxHashNotIn applies the NotIn predicate on the "tx_hash" field.
+func TxHashNotIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldTxHash, vs...))
+}
+
+// TxHashGT applies the GT predicate on the "tx_hash" field.
+func TxHashGT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldTxHash, v))
+}
+
+// TxHashGTE applies the GTE predicate on the "tx_hash" field.
+func TxHashGTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldTxHash, v))
+}
+
+// TxHashLT applies the LT predicate on the "tx_hash" field.
+func TxHashLT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldTxHash, v))
+}
+
+// TxHashLTE applies the LTE predicate on the "tx_hash" field.
+func TxHashLTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldTxHash, v))
+}
+
+// TxHashContains applies the Contains predicate on the "tx_hash" field.
+func TxHashContains(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContains(FieldTxHash, v))
+}
+
+// TxHashHasPrefix applies the HasPrefix predicate on the "tx_hash" field.
+func TxHashHasPrefix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasPrefix(FieldTxHash, v))
+}
+
+// TxHashHasSuffix applies the HasSuffix predicate on the "tx_hash" field.
+func TxHashHasSuffix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasSuffix(FieldTxHash, v))
+}
+
+// TxHashIsNil applies the IsNil predicate on the "tx_hash" field.
+func TxHashIsNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldIsNull(FieldTxHash))
+}
+
+// TxHashNotNil applies the NotNil predicate on the "tx_hash" field.
+func TxHashNotNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotNull(FieldTxHash))
+}
+
+// TxHashEqualFold applies the EqualFold predicate on the "tx_hash" field.
+func TxHashEqualFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEqualFold(FieldTxHash, v))
+}
+
+// TxHashContainsFold applies the ContainsFold predicate on the "tx_hash" field.
+func TxHashContainsFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContainsFold(FieldTxHash, v))
+}
+
+// ListingIDEQ applies the EQ predicate on the "listing_id" field.
+func ListingIDEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldListingID, v))
+}
+
+// ListingIDNEQ applies the NEQ predicate on the "listing_id" field.
+func ListingIDNEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldListingID, v))
+}
+
+// ListingIDIn applies the In predicate on the "listing_id" field.
+func ListingIDIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldListingID, vs...))
+}
+
+// ListingIDNotIn applies the NotIn predicate on the "listing_id" field.
+func ListingIDNotIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldListingID, vs...))
+}
+
+// ListingIDGT applies the GT predicate on the "listing_id" field.
+func ListingIDGT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldListingID, v))
+}
+
+// ListingIDGTE applies the GTE predicate on the "listing_id" field.
+func ListingIDGTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldListingID, v))
+}
+
+// ListingIDLT applies the LT predicate on the "listing_id" field.
+func ListingIDLT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldListingID, v))
+}
+
+// ListingIDLTE applies the LTE predicate on the "listing_id" field.
+func ListingIDLTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldListingID, v))
+}
+
+// ListingIDContains applies the Contains predicate on the "listing_id" field.
+func ListingIDContains(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContains(FieldListingID, v))
+}
+
+// ListingIDHasPrefix applies the HasPrefix predicate on the "listing_id" field.
+func ListingIDHasPrefix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasPrefix(FieldListingID, v))
+}
+
+// ListingIDHasSuffix applies the HasSuffix predicate on the "listing_id" field.
+func ListingIDHasSuffix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasSuffix(FieldListingID, v))
+}
+
+// ListingIDIsNil applies the IsNil predicate on the "listing_id" field.
+func ListingIDIsNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldIsNull(FieldListingID))
+}
+
+// ListingIDNotNil applies the NotNil predicate on the "listing_id" field.
+func ListingIDNotNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotNull(FieldListingID))
+}
+
+// ListingIDEqualFold applies the EqualFold predicate on the "listing_id" field.
+func ListingIDEqualFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEqualFold(FieldListingID, v))
+}
+
+// ListingIDContainsFold applies the ContainsFold predicate on the "listing_id" field.
+func ListingIDContainsFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContainsFold(FieldListingID, v))
+}
+
+// PriceEQ applies the EQ predicate on the "price" field.
+func PriceEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldPrice, v))
+}
+
+// PriceNEQ applies the NEQ predicate on the "price" field.
+func PriceNEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldPrice, v))
+}
+
+// PriceIn applies the In predicate on the "price" field.
+func PriceIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldPrice, vs...))
+}
+
+// PriceNotIn applies the NotIn predicate on the "price" field.
+func PriceNotIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldPrice, vs...))
+}
+
+// PriceGT applies the GT predicate on the "price" field.
+func PriceGT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldPrice, v))
+}
+
+// PriceGTE applies the GTE predicate on the "price" field.
+func PriceGTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldPrice, v))
+}
+
+// PriceLT applies the LT predicate on the "price" field.
+func PriceLT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldPrice, v))
+}
+
+// PriceLTE applies the LTE predicate on the "price" field.
+func PriceLTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldPrice, v))
+}
+
+// PriceContains applies the Contains predicate on the "price" field.
+func PriceContains(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContains(FieldPrice, v))
+}
+
+// PriceHasPrefix applies the HasPrefix predicate on the "price" field.
+func PriceHasPrefix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasPrefix(FieldPrice, v))
+}
+
+// PriceHasSuffix applies the HasSuffix predicate on the "price" field.
+func PriceHasSuffix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasSuffix(FieldPrice, v))
+}
+
+// PriceIsNil applies the IsNil predicate on the "price" field.
+func PriceIsNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldIsNull(FieldPrice))
+}
+
+// PriceNotNil applies the NotNil predicate on the "price" field.
+func PriceNotNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotNull(FieldPrice))
+}
+
+// PriceEqualFold applies the EqualFold predicate on the "price" field.
+func PriceEqualFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEqualFold(FieldPrice, v))
+}
+
+// PriceContainsFold applies the ContainsFold predicate on the "price" field.
+func PriceContainsFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContainsFold(FieldPrice, v))
+}
+
+// IsListedEQ applies the EQ predicate on the "is_listed" field.
+func IsListedEQ(v bool) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldIsListed, v))
+}
+
+// IsListedNEQ applies the NEQ predicate on the "is_listed" field.
+func IsListedNEQ(v bool) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldIsListed, v))
+}
+
+// StartsAtEQ applies the EQ predicate on the "starts_at" field.
+func StartsAtEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldStartsAt, v))
+}
+
+// StartsAtNEQ applies the NEQ predicate on the "starts_at" field.
+func StartsAtNEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldStartsAt, v))
+}
+
+// StartsAtIn applies the In predicate on the "starts_at" field.
+func StartsAtIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldStartsAt, vs...))
+}
+
+// StartsAtNotIn applies the NotIn predicate on the "starts_at" field.
+func StartsAtNotIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldStartsAt, vs...))
+}
+
+// StartsAtGT applies the GT predicate on the "starts_at" field.
+func StartsAtGT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldStartsAt, v))
+}
+
+// StartsAtGTE applies the GTE predicate on the "starts_at" field.
+func StartsAtGTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldStartsAt, v))
+}
+
+// StartsAtLT applies the LT predicate on the "starts_at" field.
+func StartsAtLT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldStartsAt, v))
+}
+
+// StartsAtLTE applies the LTE predicate on the "starts_at" field.
+func StartsAtLTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldStartsAt, v))
+}
+
+// StartsAtIsNil applies the IsNil predicate on the "starts_at" field.
+func StartsAtIsNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldIsNull(FieldStartsAt))
+}
+
+// StartsAtNotNil applies the NotNil predicate on the "starts_at" field.
+func StartsAtNotNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotNull(FieldStartsAt))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtIsNil applies the IsNil predicate on the "expires_at" field.
+func ExpiresAtIsNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldIsNull(FieldExpiresAt))
+}
+
+// ExpiresAtNotNil applies the NotNil predicate on the "expires_at" field.
+func ExpiresAtNotNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotNull(FieldExpiresAt))
+}
+
+// ExpiredEQ applies the EQ predicate on the "expired" field.
+func ExpiredEQ(v bool) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldExpired, v))
+}
+
+// ExpiredNEQ applies the NEQ predicate on the "expired" field.
+func ExpiredNEQ(v bool) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldExpired, v))
+}
+
+// HeldOnChainEQ applies the EQ predicate on the "held_on_chain" field.
+func HeldOnChainEQ(v bool) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldHeldOnChain, v))
+}
+
+// HeldOnChainNEQ applies the NEQ predicate on the "held_on_chain" field.
+func HeldOnChainNEQ(v bool) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldHeldOnChain, v))
+}
+
+// HeldOnChainIsNil applies the IsNil predicate on the "held_on_chain" field.
+func HeldOnChainIsNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldIsNull(FieldHeldOnChain))
+}
+
+// HeldOnChainNotNil applies the NotNil predicate on the "held_on_chain" field.
+func HeldOnChainNotNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotNull(FieldHeldOnChain))
+}
+
+// ReconciledAtEQ applies the EQ predicate on the "reconciled_at" field.
+func ReconciledAtEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldReconciledAt, v))
+}
+
+// ReconciledAtNEQ applies the NEQ predicate on the "reconciled_at" field.
+func ReconciledAtNEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldReconciledAt, v))
+}
+
+// ReconciledAtIn applies the In predicate on the "reconciled_at" field.
+func ReconciledAtIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldReconciledAt, vs...))
+}
+
+// ReconciledAtNotIn applies the NotIn predicate on the "reconciled_at" field.
+func ReconciledAtNotIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldReconciledAt, vs...))
+}
+
+// ReconciledAtGT applies the GT predicate on the "reconciled_at" field.
+func ReconciledAtGT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldReconciledAt, v))
+}
+
+// ReconciledAtGTE applies the GTE predicate on the "reconciled_at" field.
+func ReconciledAtGTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldReconciledAt, v))
+}
+
+// ReconciledAtLT applies the LT predicate on the "reconciled_at" field.
+func ReconciledAtLT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldReconciledAt, v))
+}
+
+// ReconciledAtLTE applies the LTE predicate on the "reconciled_at" field.
+func ReconciledAtLTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldReconciledAt, v))
+}
+
+// ReconciledAtIsNil applies the IsNil predicate on the "reconciled_at" field.
+func ReconciledAtIsNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldIsNull(FieldReconciledAt))
+}
+
+// ReconciledAtNotNil applies the NotNil predicate on the "reconciled_at" field.
+func ReconciledAtNotNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotNull(FieldReconciledAt))
+}
+
+// SubscriptionOptionIDEQ applies the EQ predicate on the "subscription_option_id" field.
+func SubscriptionOptionIDEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldSubscriptionOptionID, v))
+}
+
+// SubscriptionOptionIDNEQ applies the NEQ predicate on the "subscription_option_id" field.
+func SubscriptionOptionIDNEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldSubscriptionOptionID, v))
+}
+
+// SubscriptionOptionIDIn applies the In predicate on the "subscription_option_id" field.
+func SubscriptionOptionIDIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldSubscriptionOptionID, vs...))
+}
+
+// SubscriptionOptionIDNotIn applies the NotIn predicate on the "subscription_option_id" field.
+func SubscriptionOptionIDNotIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldSubscriptionOptionID, vs...))
+}
+
+// SubscriptionOptionIDGT applies the GT predicate on the "subscription_option_id" field.
+func SubscriptionOptionIDGT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldSubscriptionOptionID, v))
+}
+
+// SubscriptionOptionIDGTE applies the GTE predicate on the "subscription_option_id" field.
+func SubscriptionOptionIDGTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldSubscriptionOptionID, v))
+}
+
+// SubscriptionOptionIDLT applies the LT predicate on the "subscription_option_id" field.
+func SubscriptionOptionIDLT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldSubscriptionOptionID, v))
+}
+
+// SubscriptionOptionIDLTE applies the LTE predicate on the "subscription_option_id" field.
+func SubscriptionOptionIDLTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldSubscriptionOptionID, v))
+}
+
+// SubscriptionOptionIDContains applies the Contains predicate on the "subscription_option_id" field.
+func SubscriptionOptionIDContains(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContains(FieldSubscriptionOptionID, v))
+}
+
+// SubscriptionOptionIDHasPrefix applies the HasPrefix predicate on the "subscription_option_id" field.
+func SubscriptionOptionIDHasPrefix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasPrefix(FieldSubscriptionOptionID, v))
+}
+
+// SubscriptionOptionIDHasSuffix applies the HasSuffix predicate on the "subscription_option_id" field.
+func SubscriptionOptionIDHasSuffix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasSuffix(FieldSubscriptionOptionID, v))
+}
+
+// SubscriptionOptionIDIsNil applies the IsNil predicate on the "subscription_option_id" field.
+func SubscriptionOptionIDIsNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldIsNull(FieldSubscriptionOptionID))
+}
+
+// SubscriptionOptionIDNotNil applies the NotNil predicate on the "subscription_option_id" field.
+func SubscriptionOptionIDNotNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotNull(FieldSubscriptionOptionID))
+}
+
+// SubscriptionOptionIDEqualFold applies the EqualFold predicate on the "subscription_option_id" field.
+func SubscriptionOptionIDEqualFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEqualFold(FieldSubscriptionOptionID, v))
+}
+
+// SubscriptionOptionIDContainsFold applies the ContainsFold predicate on the "subscription_option_id" field.
+func SubscriptionOptionIDContainsFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContainsFold(FieldSubscriptionOptionID, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.Subscription {
+	return predicate.Subscription(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.Subscription {
+	return predicate.Subscription(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasModel applies the HasEdge predicate on the "model" edge.
+func HasModel() predicate.Subscription {
+	return predicate.Subscription(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ModelTable, ModelColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasModelWith applies the HasEdge predicate on the "model" edge with a given conditions (other predicates).
+func HasModelWith(preds ...predicate.Model) predicate.Subscription {
+	return predicate.Subscription(func(s *sql.Selector) {
+		step := newModelStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Subscription) predicate.Subscription {
 	return predicate.Subscription(sql.AndPredicates(predicates...))
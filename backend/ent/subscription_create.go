@@ -3,9 +3,13 @@
 package ent
 
 import (
+	"arjunmal1311/fans_flow_on_chain/backend/ent/model"
 	"arjunmal1311/fans_flow_on_chain/backend/ent/subscription"
+	"arjunmal1311/fans_flow_on_chain/backend/ent/user"
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -18,6 +22,186 @@ type SubscriptionCreate struct {
 	hooks    []Hook
 }
 
+// SetChain sets the "chain" field.
+func (sc *SubscriptionCreate) SetChain(s string) *SubscriptionCreate {
+	sc.mutation.SetChain(s)
+	return sc
+}
+
+// SetTokenID sets the "token_id" field.
+func (sc *SubscriptionCreate) SetTokenID(s string) *SubscriptionCreate {
+	sc.mutation.SetTokenID(s)
+	return sc
+}
+
+// SetUserID sets the "user_id" field.
+func (sc *SubscriptionCreate) SetUserID(s string) *SubscriptionCreate {
+	sc.mutation.SetUserID(s)
+	return sc
+}
+
+// SetModelID sets the "model_id" field.
+func (sc *SubscriptionCreate) SetModelID(s string) *SubscriptionCreate {
+	sc.mutation.SetModelID(s)
+	return sc
+}
+
+// SetTxHash sets the "tx_hash" field.
+func (sc *SubscriptionCreate) SetTxHash(s string) *SubscriptionCreate {
+	sc.mutation.SetTxHash(s)
+	return sc
+}
+
+// SetNillableTxHash sets the "tx_hash" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableTxHash(s *string) *SubscriptionCreate {
+	if s != nil {
+		sc.SetTxHash(*s)
+	}
+	return sc
+}
+
+// SetListingID sets the "listing_id" field.
+func (sc *SubscriptionCreate) SetListingID(s string) *SubscriptionCreate {
+	sc.mutation.SetListingID(s)
+	return sc
+}
+
+// SetNillableListingID sets the "listing_id" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableListingID(s *string) *SubscriptionCreate {
+	if s != nil {
+		sc.SetListingID(*s)
+	}
+	return sc
+}
+
+// SetPrice sets the "price" field.
+func (sc *SubscriptionCreate) SetPrice(s string) *SubscriptionCreate {
+	sc.mutation.SetPrice(s)
+	return sc
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillablePrice(s *string) *SubscriptionCreate {
+	if s != nil {
+		sc.SetPrice(*s)
+	}
+	return sc
+}
+
+// SetIsListed sets the "is_listed" field.
+func (sc *SubscriptionCreate) SetIsListed(b bool) *SubscriptionCreate {
+	sc.mutation.SetIsListed(b)
+	return sc
+}
+
+// SetNillableIsListed sets the "is_listed" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableIsListed(b *bool) *SubscriptionCreate {
+	if b != nil {
+		sc.SetIsListed(*b)
+	}
+	return sc
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (sc *SubscriptionCreate) SetStartsAt(t time.Time) *SubscriptionCreate {
+	sc.mutation.SetStartsAt(t)
+	return sc
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableStartsAt(t *time.Time) *SubscriptionCreate {
+	if t != nil {
+		sc.SetStartsAt(*t)
+	}
+	return sc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (sc *SubscriptionCreate) SetExpiresAt(t time.Time) *SubscriptionCreate {
+	sc.mutation.SetExpiresAt(t)
+	return sc
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableExpiresAt(t *time.Time) *SubscriptionCreate {
+	if t != nil {
+		sc.SetExpiresAt(*t)
+	}
+	return sc
+}
+
+// SetExpired sets the "expired" field.
+func (sc *SubscriptionCreate) SetExpired(b bool) *SubscriptionCreate {
+	sc.mutation.SetExpired(b)
+	return sc
+}
+
+// SetNillableExpired sets the "expired" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableExpired(b *bool) *SubscriptionCreate {
+	if b != nil {
+		sc.SetExpired(*b)
+	}
+	return sc
+}
+
+// SetHeldOnChain sets the "held_on_chain" field.
+func (sc *SubscriptionCreate) SetHeldOnChain(b bool) *SubscriptionCreate {
+	sc.mutation.SetHeldOnChain(b)
+	return sc
+}
+
+// SetNillableHeldOnChain sets the "held_on_chain" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableHeldOnChain(b *bool) *SubscriptionCreate {
+	if b != nil {
+		sc.SetHeldOnChain(*b)
+	}
+	return sc
+}
+
+// SetReconciledAt sets the "reconciled_at" field.
+func (sc *SubscriptionCreate) SetReconciledAt(t time.Time) *SubscriptionCreate {
+	sc.mutation.SetReconciledAt(t)
+	return sc
+}
+
+// SetNillableReconciledAt sets the "reconciled_at" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableReconciledAt(t *time.Time) *SubscriptionCreate {
+	if t != nil {
+		sc.SetReconciledAt(*t)
+	}
+	return sc
+}
+
+// SetSubscriptionOptionID sets the "subscription_option_id" field.
+func (sc *SubscriptionCreate) SetSubscriptionOptionID(s string) *SubscriptionCreate {
+	sc.mutation.SetSubscriptionOptionID(s)
+	return sc
+}
+
+// SetNillableSubscriptionOptionID sets the "subscription_option_id" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableSubscriptionOptionID(s *string) *SubscriptionCreate {
+	if s != nil {
+		sc.SetSubscriptionOptionID(*s)
+	}
+	return sc
+}
+
+// SetID sets the "id" field.
+func (sc *SubscriptionCreate) SetID(s string) *SubscriptionCreate {
+	sc.mutation.SetID(s)
+	return sc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (sc *SubscriptionCreate) SetUser(u *User) *SubscriptionCreate {
+	return sc.SetUserID(u.ID)
+}
+
+// SetModel sets the "model" edge to the Model entity.
+func (sc *SubscriptionCreate) SetModel(m *Model) *SubscriptionCreate {
+	return sc.SetModelID(m.ID)
+}
+
 // Mutation returns the SubscriptionMutation object of the builder.
 func (sc *SubscriptionCreate) Mutation() *SubscriptionMutation {
 	return sc.mutation
@@ -25,6 +209,7 @@ func (sc *SubscriptionCreate) Mutation() *SubscriptionMutation {
 
 // Save creates the Subscription in the database.
 func (sc *SubscriptionCreate) Save(ctx context.Context) (*Subscription, error) {
+	sc.defaults()
 	return withHooks(ctx, sc.sqlSave, sc.mutation, sc.hooks)
 }
 
@@ -50,8 +235,49 @@ func (sc *SubscriptionCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (sc *SubscriptionCreate) defaults() {
+	if _, ok := sc.mutation.IsListed(); !ok {
+		v := subscription.DefaultIsListed
+		sc.mutation.SetIsListed(v)
+	}
+	if _, ok := sc.mutation.Expired(); !ok {
+		v := subscription.DefaultExpired
+		sc.mutation.SetExpired(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (sc *SubscriptionCreate) check() error {
+	if _, ok := sc.mutation.Chain(); !ok {
+		return &ValidationError{Name: "chain", err: errors.New(`ent: missing required field "Subscription.chain"`)}
+	}
+	if _, ok := sc.mutation.TokenID(); !ok {
+		return &ValidationError{Name: "token_id", err: errors.New(`ent: missing required field "Subscription.token_id"`)}
+	}
+	if _, ok := sc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Subscription.user_id"`)}
+	}
+	if _, ok := sc.mutation.ModelID(); !ok {
+		return &ValidationError{Name: "model_id", err: errors.New(`ent: missing required field "Subscription.model_id"`)}
+	}
+	if _, ok := sc.mutation.IsListed(); !ok {
+		return &ValidationError{Name: "is_listed", err: errors.New(`ent: missing required field "Subscription.is_listed"`)}
+	}
+	if _, ok := sc.mutation.Expired(); !ok {
+		return &ValidationError{Name: "expired", err: errors.New(`ent: missing required field "Subscription.expired"`)}
+	}
+	if v, ok := sc.mutation.ID(); ok {
+		if err := subscription.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Subscription.id": %w`, err)}
+		}
+	}
+	if len(sc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Subscription.user"`)}
+	}
+	if len(sc.mutation.ModelIDs()) == 0 {
+		return &ValidationError{Name: "model", err: errors.New(`ent: missing required edge "Subscription.model"`)}
+	}
 	return nil
 }
 
@@ -66,8 +292,13 @@ func (sc *SubscriptionCreate) sqlSave(ctx context.Context) (*Subscription, error
 		}
 		return nil, err
 	}
-	id := _spec.ID.Value.(int64)
-	_node.ID = int(id)
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Subscription.ID type: %T", _spec.ID.Value)
+		}
+	}
 	sc.mutation.id = &_node.ID
 	sc.mutation.done = true
 	return _node, nil
@@ -76,8 +307,94 @@ func (sc *SubscriptionCreate) sqlSave(ctx context.Context) (*Subscription, error
 func (sc *SubscriptionCreate) createSpec() (*Subscription, *sqlgraph.CreateSpec) {
 	var (
 		_node = &Subscription{config: sc.config}
-		_spec = sqlgraph.NewCreateSpec(subscription.Table, sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeInt))
+		_spec = sqlgraph.NewCreateSpec(subscription.Table, sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeString))
 	)
+	if id, ok := sc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := sc.mutation.Chain(); ok {
+		_spec.SetField(subscription.FieldChain, field.TypeString, value)
+		_node.Chain = value
+	}
+	if value, ok := sc.mutation.TokenID(); ok {
+		_spec.SetField(subscription.FieldTokenID, field.TypeString, value)
+		_node.TokenID = value
+	}
+	if value, ok := sc.mutation.TxHash(); ok {
+		_spec.SetField(subscription.FieldTxHash, field.TypeString, value)
+		_node.TxHash = value
+	}
+	if value, ok := sc.mutation.ListingID(); ok {
+		_spec.SetField(subscription.FieldListingID, field.TypeString, value)
+		_node.ListingID = value
+	}
+	if value, ok := sc.mutation.Price(); ok {
+		_spec.SetField(subscription.FieldPrice, field.TypeString, value)
+		_node.Price = value
+	}
+	if value, ok := sc.mutation.IsListed(); ok {
+		_spec.SetField(subscription.FieldIsListed, field.TypeBool, value)
+		_node.IsListed = value
+	}
+	if value, ok := sc.mutation.StartsAt(); ok {
+		_spec.SetField(subscription.FieldStartsAt, field.TypeTime, value)
+		_node.StartsAt = &value
+	}
+	if value, ok := sc.mutation.ExpiresAt(); ok {
+		_spec.SetField(subscription.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := sc.mutation.Expired(); ok {
+		_spec.SetField(subscription.FieldExpired, field.TypeBool, value)
+		_node.Expired = value
+	}
+	if value, ok := sc.mutation.HeldOnChain(); ok {
+		_spec.SetField(subscription.FieldHeldOnChain, field.TypeBool, value)
+		_node.HeldOnChain = &value
+	}
+	if value, ok := sc.mutation.ReconciledAt(); ok {
+		_spec.SetField(subscription.FieldReconciledAt, field.TypeTime, value)
+		_node.ReconciledAt = &value
+	}
+	if value, ok := sc.mutation.SubscriptionOptionID(); ok {
+		_spec.SetField(subscription.FieldSubscriptionOptionID, field.TypeString, value)
+		_node.SubscriptionOptionID = &value
+	}
+	if nodes := sc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   subscription.UserTable,
+			Columns: []string{subscription.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sc.mutation.ModelIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   subscription.ModelTable,
+			Columns: []string{subscription.ModelColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(model.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ModelID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -99,6 +416,7 @@ func (scb *SubscriptionCreateBulk) Save(ctx context.Context) ([]*Subscription, e
 	for i := range scb.builders {
 		func(i int, root context.Context) {
 			builder := scb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*SubscriptionMutation)
 				if !ok {
@@ -125,10 +443,6 @@ func (scb *SubscriptionCreateBulk) Save(ctx context.Context) ([]*Subscription, e
 					return nil, err
 				}
 				mutation.id = &nodes[i].ID
-				if specs[i].ID.Value != nil {
-					id := specs[i].ID.Value.(int64)
-					nodes[i].ID = int(id)
-				}
 				mutation.done = true
 				return nodes[i], nil
 			})
@@ -3,11 +3,14 @@
 package ent
 
 import (
+	"arjunmal1311/fans_flow_on_chain/backend/ent/model"
 	"arjunmal1311/fans_flow_on_chain/backend/ent/predicate"
 	"arjunmal1311/fans_flow_on_chain/backend/ent/subscription"
+	"arjunmal1311/fans_flow_on_chain/backend/ent/user"
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -27,11 +30,277 @@ func (su *SubscriptionUpdate) Where(ps ...predicate.Subscription) *SubscriptionU
 	return su
 }
 
+// SetChain sets the "chain" field.
+func (su *SubscriptionUpdate) SetChain(s string) *SubscriptionUpdate {
+	su.mutation.SetChain(s)
+	return su
+}
+
+// SetNillableChain sets the "chain" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableChain(s *string) *SubscriptionUpdate {
+	if s != nil {
+		su.SetChain(*s)
+	}
+	return su
+}
+
+// SetTokenID sets the "token_id" field.
+func (su *SubscriptionUpdate) SetTokenID(s string) *SubscriptionUpdate {
+	su.mutation.SetTokenID(s)
+	return su
+}
+
+// SetNillableTokenID sets the "token_id" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableTokenID(s *string) *SubscriptionUpdate {
+	if s != nil {
+		su.SetTokenID(*s)
+	}
+	return su
+}
+
+// SetUserID sets the "user_id" field.
+func (su *SubscriptionUpdate) SetUserID(s string) *SubscriptionUpdate {
+	su.mutation.SetUserID(s)
+	return su
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableUserID(s *string) *SubscriptionUpdate {
+	if s != nil {
+		su.SetUserID(*s)
+	}
+	return su
+}
+
+// SetModelID sets the "model_id" field.
+func (su *SubscriptionUpdate) SetModelID(s string) *SubscriptionUpdate {
+	su.mutation.SetModelID(s)
+	return su
+}
+
+// SetNillableModelID sets the "model_id" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableModelID(s *string) *SubscriptionUpdate {
+	if s != nil {
+		su.SetModelID(*s)
+	}
+	return su
+}
+
+// SetTxHash sets the "tx_hash" field.
+func (su *SubscriptionUpdate) SetTxHash(s string) *SubscriptionUpdate {
+	su.mutation.SetTxHash(s)
+	return su
+}
+
+// SetNillableTxHash sets the "tx_hash" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableTxHash(s *string) *SubscriptionUpdate {
+	if s != nil {
+		su.SetTxHash(*s)
+	}
+	return su
+}
+
+// ClearTxHash clears the value of the "tx_hash" field.
+func (su *SubscriptionUpdate) ClearTxHash() *SubscriptionUpdate {
+	su.mutation.ClearTxHash()
+	return su
+}
+
+// SetListingID sets the "listing_id" field.
+func (su *SubscriptionUpdate) SetListingID(s string) *SubscriptionUpdate {
+	su.mutation.SetListingID(s)
+	return su
+}
+
+// SetNillableListingID sets the "listing_id" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableListingID(s *string) *SubscriptionUpdate {
+	if s != nil {
+		su.SetListingID(*s)
+	}
+	return su
+}
+
+// ClearListingID clears the value of the "listing_id" field.
+func (su *SubscriptionUpdate) ClearListingID() *SubscriptionUpdate {
+	su.mutation.ClearListingID()
+	return su
+}
+
+// SetPrice sets the "price" field.
+func (su *SubscriptionUpdate) SetPrice(s string) *SubscriptionUpdate {
+	su.mutation.SetPrice(s)
+	return su
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillablePrice(s *string) *SubscriptionUpdate {
+	if s != nil {
+		su.SetPrice(*s)
+	}
+	return su
+}
+
+// ClearPrice clears the value of the "price" field.
+func (su *SubscriptionUpdate) ClearPrice() *SubscriptionUpdate {
+	su.mutation.ClearPrice()
+	return su
+}
+
+// SetIsListed sets the "is_listed" field.
+func (su *SubscriptionUpdate) SetIsListed(b bool) *SubscriptionUpdate {
+	su.mutation.SetIsListed(b)
+	return su
+}
+
+// SetNillableIsListed sets the "is_listed" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableIsListed(b *bool) *SubscriptionUpdate {
+	if b != nil {
+		su.SetIsListed(*b)
+	}
+	return su
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (su *SubscriptionUpdate) SetStartsAt(t time.Time) *SubscriptionUpdate {
+	su.mutation.SetStartsAt(t)
+	return su
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableStartsAt(t *time.Time) *SubscriptionUpdate {
+	if t != nil {
+		su.SetStartsAt(*t)
+	}
+	return su
+}
+
+// ClearStartsAt clears the value of the "starts_at" field.
+func (su *SubscriptionUpdate) ClearStartsAt() *SubscriptionUpdate {
+	su.mutation.ClearStartsAt()
+	return su
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (su *SubscriptionUpdate) SetExpiresAt(t time.Time) *SubscriptionUpdate {
+	su.mutation.SetExpiresAt(t)
+	return su
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableExpiresAt(t *time.Time) *SubscriptionUpdate {
+	if t != nil {
+		su.SetExpiresAt(*t)
+	}
+	return su
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (su *SubscriptionUpdate) ClearExpiresAt() *SubscriptionUpdate {
+	su.mutation.ClearExpiresAt()
+	return su
+}
+
+// SetExpired sets the "expired" field.
+func (su *SubscriptionUpdate) SetExpired(b bool) *SubscriptionUpdate {
+	su.mutation.SetExpired(b)
+	return su
+}
+
+// SetNillableExpired sets the "expired" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableExpired(b *bool) *SubscriptionUpdate {
+	if b != nil {
+		su.SetExpired(*b)
+	}
+	return su
+}
+
+// SetHeldOnChain sets the "held_on_chain" field.
+func (su *SubscriptionUpdate) SetHeldOnChain(b bool) *SubscriptionUpdate {
+	su.mutation.SetHeldOnChain(b)
+	return su
+}
+
+// SetNillableHeldOnChain sets the "held_on_chain" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableHeldOnChain(b *bool) *SubscriptionUpdate {
+	if b != nil {
+		su.SetHeldOnChain(*b)
+	}
+	return su
+}
+
+// ClearHeldOnChain clears the value of the "held_on_chain" field.
+func (su *SubscriptionUpdate) ClearHeldOnChain() *SubscriptionUpdate {
+	su.mutation.ClearHeldOnChain()
+	return su
+}
+
+// SetReconciledAt sets the "reconciled_at" field.
+func (su *SubscriptionUpdate) SetReconciledAt(t time.Time) *SubscriptionUpdate {
+	su.mutation.SetReconciledAt(t)
+	return su
+}
+
+// SetNillableReconciledAt sets the "reconciled_at" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableReconciledAt(t *time.Time) *SubscriptionUpdate {
+	if t != nil {
+		su.SetReconciledAt(*t)
+	}
+	return su
+}
+
+// ClearReconciledAt clears the value of the "reconciled_at" field.
+func (su *SubscriptionUpdate) ClearReconciledAt() *SubscriptionUpdate {
+	su.mutation.ClearReconciledAt()
+	return su
+}
+
+// SetSubscriptionOptionID sets the "subscription_option_id" field.
+func (su *SubscriptionUpdate) SetSubscriptionOptionID(s string) *SubscriptionUpdate {
+	su.mutation.SetSubscriptionOptionID(s)
+	return su
+}
+
+// SetNillableSubscriptionOptionID sets the "subscription_option_id" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableSubscriptionOptionID(s *string) *SubscriptionUpdate {
+	if s != nil {
+		su.SetSubscriptionOptionID(*s)
+	}
+	return su
+}
+
+// ClearSubscriptionOptionID clears the value of the "subscription_option_id" field.
+func (su *SubscriptionUpdate) ClearSubscriptionOptionID() *SubscriptionUpdate {
+	su.mutation.ClearSubscriptionOptionID()
+	return su
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (su *SubscriptionUpdate) SetUser(u *User) *SubscriptionUpdate {
+	return su.SetUserID(u.ID)
+}
+
+// SetModel sets the "model" edge to the Model entity.
+func (su *SubscriptionUpdate) SetModel(m *Model) *SubscriptionUpdate {
+	return su.SetModelID(m.ID)
+}
+
 // Mutation returns the SubscriptionMutation object of the builder.
 func (su *SubscriptionUpdate) Mutation() *SubscriptionMutation {
 	return su.mutation
 }
 
+// ClearUser clears the "user" edge to the User entity.
+func (su *SubscriptionUpdate) ClearUser() *SubscriptionUpdate {
+	su.mutation.ClearUser()
+	return su
+}
+
+// ClearModel clears the "model" edge to the Model entity.
+func (su *SubscriptionUpdate) ClearModel() *SubscriptionUpdate {
+	su.mutation.ClearModel()
+	return su
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (su *SubscriptionUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, su.sqlSave, su.mutation, su.hooks)
@@ -59,8 +328,22 @@ func (su *SubscriptionUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (su *SubscriptionUpdate) check() error {
+	if su.mutation.UserCleared() && len(su.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Subscription.user"`)
+	}
+	if su.mutation.ModelCleared() && len(su.mutation.ModelIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Subscription.model"`)
+	}
+	return nil
+}
+
 func (su *SubscriptionUpdate) sqlSave(ctx context.Context) (n int, err error) {
-	_spec := sqlgraph.NewUpdateSpec(subscription.Table, subscription.Columns, sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeInt))
+	if err := su.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(subscription.Table, subscription.Columns, sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeString))
 	if ps := su.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
@@ -68,6 +351,124 @@ func (su *SubscriptionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if value, ok := su.mutation.Chain(); ok {
+		_spec.SetField(subscription.FieldChain, field.TypeString, value)
+	}
+	if value, ok := su.mutation.TokenID(); ok {
+		_spec.SetField(subscription.FieldTokenID, field.TypeString, value)
+	}
+	if value, ok := su.mutation.TxHash(); ok {
+		_spec.SetField(subscription.FieldTxHash, field.TypeString, value)
+	}
+	if su.mutation.TxHashCleared() {
+		_spec.ClearField(subscription.FieldTxHash, field.TypeString)
+	}
+	if value, ok := su.mutation.ListingID(); ok {
+		_spec.SetField(subscription.FieldListingID, field.TypeString, value)
+	}
+	if su.mutation.ListingIDCleared() {
+		_spec.ClearField(subscription.FieldListingID, field.TypeString)
+	}
+	if value, ok := su.mutation.Price(); ok {
+		_spec.SetField(subscription.FieldPrice, field.TypeString, value)
+	}
+	if su.mutation.PriceCleared() {
+		_spec.ClearField(subscription.FieldPrice, field.TypeString)
+	}
+	if value, ok := su.mutation.IsListed(); ok {
+		_spec.SetField(subscription.FieldIsListed, field.TypeBool, value)
+	}
+	if value, ok := su.mutation.StartsAt(); ok {
+		_spec.SetField(subscription.FieldStartsAt, field.TypeTime, value)
+	}
+	if su.mutation.StartsAtCleared() {
+		_spec.ClearField(subscription.FieldStartsAt, field.TypeTime)
+	}
+	if value, ok := su.mutation.ExpiresAt(); ok {
+		_spec.SetField(subscription.FieldExpiresAt, field.TypeTime, value)
+	}
+	if su.mutation.ExpiresAtCleared() {
+		_spec.ClearField(subscription.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := su.mutation.Expired(); ok {
+		_spec.SetField(subscription.FieldExpired, field.TypeBool, value)
+	}
+	if value, ok := su.mutation.HeldOnChain(); ok {
+		_spec.SetField(subscription.FieldHeldOnChain, field.TypeBool, value)
+	}
+	if su.mutation.HeldOnChainCleared() {
+		_spec.ClearField(subscription.FieldHeldOnChain, field.TypeBool)
+	}
+	if value, ok := su.mutation.ReconciledAt(); ok {
+		_spec.SetField(subscription.FieldReconciledAt, field.TypeTime, value)
+	}
+	if su.mutation.ReconciledAtCleared() {
+		_spec.ClearField(subscription.FieldReconciledAt, field.TypeTime)
+	}
+	if value, ok := su.mutation.SubscriptionOptionID(); ok {
+		_spec.SetField(subscription.FieldSubscriptionOptionID, field.TypeString, value)
+	}
+	if su.mutation.SubscriptionOptionIDCleared() {
+		_spec.ClearField(subscription.FieldSubscriptionOptionID, field.TypeString)
+	}
+	if su.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   subscription.UserTable,
+			Columns: []string{subscription.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   subscription.UserTable,
+			Columns: []string{subscription.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if su.mutation.ModelCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   subscription.ModelTable,
+			Columns: []string{subscription.ModelColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(model.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.ModelIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   subscription.ModelTable,
+			Columns: []string{subscription.ModelColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(model.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{subscription.Label}
@@ -88,11 +489,277 @@ type SubscriptionUpdateOne struct {
 	mutation *SubscriptionMutation
 }
 
+// SetChain sets the "chain" field.
+func (suo *SubscriptionUpdateOne) SetChain(s string) *SubscriptionUpdateOne {
+	suo.mutation.SetChain(s)
+	return suo
+}
+
+// SetNillableChain sets the "chain" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableChain(s *string) *SubscriptionUpdateOne {
+	if s != nil {
+		suo.SetChain(*s)
+	}
+	return suo
+}
+
+// SetTokenID sets the "token_id" field.
+func (suo *SubscriptionUpdateOne) SetTokenID(s string) *SubscriptionUpdateOne {
+	suo.mutation.SetTokenID(s)
+	return suo
+}
+
+// SetNillableTokenID sets the "token_id" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableTokenID(s *string) *SubscriptionUpdateOne {
+	if s != nil {
+		suo.SetTokenID(*s)
+	}
+	return suo
+}
+
+// SetUserID sets the "user_id" field.
+func (suo *SubscriptionUpdateOne) SetUserID(s string) *SubscriptionUpdateOne {
+	suo.mutation.SetUserID(s)
+	return suo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableUserID(s *string) *SubscriptionUpdateOne {
+	if s != nil {
+		suo.SetUserID(*s)
+	}
+	return suo
+}
+
+// SetModelID sets the "model_id" field.
+func (suo *SubscriptionUpdateOne) SetModelID(s string) *SubscriptionUpdateOne {
+	suo.mutation.SetModelID(s)
+	return suo
+}
+
+// SetNillableModelID sets the "model_id" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableModelID(s *string) *SubscriptionUpdateOne {
+	if s != nil {
+		suo.SetModelID(*s)
+	}
+	return suo
+}
+
+// SetTxHash sets the "tx_hash" field.
+func (suo *SubscriptionUpdateOne) SetTxHash(s string) *SubscriptionUpdateOne {
+	suo.mutation.SetTxHash(s)
+	return suo
+}
+
+// SetNillableTxHash sets the "tx_hash" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableTxHash(s *string) *SubscriptionUpdateOne {
+	if s != nil {
+		suo.SetTxHash(*s)
+	}
+	return suo
+}
+
+// ClearTxHash clears the value of the "tx_hash" field.
+func (suo *SubscriptionUpdateOne) ClearTxHash() *SubscriptionUpdateOne {
+	suo.mutation.ClearTxHash()
+	return suo
+}
+
+// SetListingID sets the "listing_id" field.
+func (suo *SubscriptionUpdateOne) SetListingID(s string) *SubscriptionUpdateOne {
+	suo.mutation.SetListingID(s)
+	return suo
+}
+
+// SetNillableListingID sets the "listing_id" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableListingID(s *string) *SubscriptionUpdateOne {
+	if s != nil {
+		suo.SetListingID(*s)
+	}
+	return suo
+}
+
+// ClearListingID clears the value of the "listing_id" field.
+func (suo *SubscriptionUpdateOne) ClearListingID() *SubscriptionUpdateOne {
+	suo.mutation.ClearListingID()
+	return suo
+}
+
+// SetPrice sets the "price" field.
+func (suo *SubscriptionUpdateOne) SetPrice(s string) *SubscriptionUpdateOne {
+	suo.mutation.SetPrice(s)
+	return suo
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillablePrice(s *string) *SubscriptionUpdateOne {
+	if s != nil {
+		suo.SetPrice(*s)
+	}
+	return suo
+}
+
+// ClearPrice clears the value of the "price" field.
+func (suo *SubscriptionUpdateOne) ClearPrice() *SubscriptionUpdateOne {
+	suo.mutation.ClearPrice()
+	return suo
+}
+
+// SetIsListed sets the "is_listed" field.
+func (suo *SubscriptionUpdateOne) SetIsListed(b bool) *SubscriptionUpdateOne {
+	suo.mutation.SetIsListed(b)
+	return suo
+}
+
+// SetNillableIsListed sets the "is_listed" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableIsListed(b *bool) *SubscriptionUpdateOne {
+	if b != nil {
+		suo.SetIsListed(*b)
+	}
+	return suo
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (suo *SubscriptionUpdateOne) SetStartsAt(t time.Time) *SubscriptionUpdateOne {
+	suo.mutation.SetStartsAt(t)
+	return suo
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableStartsAt(t *time.Time) *SubscriptionUpdateOne {
+	if t != nil {
+		suo.SetStartsAt(*t)
+	}
+	return suo
+}
+
+// ClearStartsAt clears the value of the "starts_at" field.
+func (suo *SubscriptionUpdateOne) ClearStartsAt() *SubscriptionUpdateOne {
+	suo.mutation.ClearStartsAt()
+	return suo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (suo *SubscriptionUpdateOne) SetExpiresAt(t time.Time) *SubscriptionUpdateOne {
+	suo.mutation.SetExpiresAt(t)
+	return suo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableExpiresAt(t *time.Time) *SubscriptionUpdateOne {
+	if t != nil {
+		suo.SetExpiresAt(*t)
+	}
+	return suo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (suo *SubscriptionUpdateOne) ClearExpiresAt() *SubscriptionUpdateOne {
+	suo.mutation.ClearExpiresAt()
+	return suo
+}
+
+// SetExpired sets the "expired" field.
+func (suo *SubscriptionUpdateOne) SetExpired(b bool) *SubscriptionUpdateOne {
+	suo.mutation.SetExpired(b)
+	return suo
+}
+
+// SetNillableExpired sets the "expired" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableExpired(b *bool) *SubscriptionUpdateOne {
+	if b != nil {
+		suo.SetExpired(*b)
+	}
+	return suo
+}
+
+// SetHeldOnChain sets the "held_on_chain" field.
+func (suo *SubscriptionUpdateOne) SetHeldOnChain(b bool) *SubscriptionUpdateOne {
+	suo.mutation.SetHeldOnChain(b)
+	return suo
+}
+
+// SetNillableHeldOnChain sets the "held_on_chain" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableHeldOnChain(b *bool) *SubscriptionUpdateOne {
+	if b != nil {
+		suo.SetHeldOnChain(*b)
+	}
+	return suo
+}
+
+// ClearHeldOnChain clears the value of the "held_on_chain" field.
+func (suo *SubscriptionUpdateOne) ClearHeldOnChain() *SubscriptionUpdateOne {
+	suo.mutation.ClearHeldOnChain()
+	return suo
+}
+
+// SetReconciledAt sets the "reconciled_at" field.
+func (suo *SubscriptionUpdateOne) SetReconciledAt(t time.Time) *SubscriptionUpdateOne {
+	suo.mutation.SetReconciledAt(t)
+	return suo
+}
+
+// SetNillableReconciledAt sets the "reconciled_at" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableReconciledAt(t *time.Time) *SubscriptionUpdateOne {
+	if t != nil {
+		suo.SetReconciledAt(*t)
+	}
+	return suo
+}
+
+// ClearReconciledAt clears the value of the "reconciled_at" field.
+func (suo *SubscriptionUpdateOne) ClearReconciledAt() *SubscriptionUpdateOne {
+	suo.mutation.ClearReconciledAt()
+	return suo
+}
+
+// SetSubscriptionOptionID sets the "subscription_option_id" field.
+func (suo *SubscriptionUpdateOne) SetSubscriptionOptionID(s string) *SubscriptionUpdateOne {
+	suo.mutation.SetSubscriptionOptionID(s)
+	return suo
+}
+
+// SetNillableSubscriptionOptionID sets the "subscription_option_id" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableSubscriptionOptionID(s *string) *SubscriptionUpdateOne {
+	if s != nil {
+		suo.SetSubscriptionOptionID(*s)
+	}
+	return suo
+}
+
+// ClearSubscriptionOptionID clears the value of the "subscription_option_id" field.
+func (suo *SubscriptionUpdateOne) ClearSubscriptionOptionID() *SubscriptionUpdateOne {
+	suo.mutation.ClearSubscriptionOptionID()
+	return suo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (suo *SubscriptionUpdateOne) SetUser(u *User) *SubscriptionUpdateOne {
+	return suo.SetUserID(u.ID)
+}
+
+// SetModel sets the "model" edge to the Model entity.
+func (suo *SubscriptionUpdateOne) SetModel(m *Model) *SubscriptionUpdateOne {
+	return suo.SetModelID(m.ID)
+}
+
 // Mutation returns the SubscriptionMutation object of the builder.
 func (suo *SubscriptionUpdateOne) Mutation() *SubscriptionMutation {
 	return suo.mutation
 }
 
+// ClearUser clears the "user" edge to the User entity.
+func (suo *SubscriptionUpdateOne) ClearUser() *SubscriptionUpdateOne {
+	suo.mutation.ClearUser()
+	return suo
+}
+
+// ClearModel clears the "model" edge to the Model entity.
+func (suo *SubscriptionUpdateOne) ClearModel() *SubscriptionUpdateOne {
+	suo.mutation.ClearModel()
+	return suo
+}
+
 // Where appends a list predicates to the SubscriptionUpdate builder.
 func (suo *SubscriptionUpdateOne) Where(ps ...predicate.Subscription) *SubscriptionUpdateOne {
 	suo.mutation.Where(ps...)
@@ -133,8 +800,22 @@ func (suo *SubscriptionUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (suo *SubscriptionUpdateOne) check() error {
+	if suo.mutation.UserCleared() && len(suo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Subscription.user"`)
+	}
+	if suo.mutation.ModelCleared() && len(suo.mutation.ModelIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Subscription.model"`)
+	}
+	return nil
+}
+
 func (suo *SubscriptionUpdateOne) sqlSave(ctx context.Context) (_node *Subscription, err error) {
-	_spec := sqlgraph.NewUpdateSpec(subscription.Table, subscription.Columns, sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeInt))
+	if err := suo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(subscription.Table, subscription.Columns, sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeString))
 	id, ok := suo.mutation.ID()
 	if !ok {
 		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Subscription.id" for update`)}
@@ -159,6 +840,124 @@ func (suo *SubscriptionUpdateOne) sqlSave(ctx context.Context) (_node *Subscript
 			}
 		}
 	}
+	if value, ok := suo.mutation.Chain(); ok {
+		_spec.SetField(subscription.FieldChain, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.TokenID(); ok {
+		_spec.SetField(subscription.FieldTokenID, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.TxHash(); ok {
+		_spec.SetField(subscription.FieldTxHash, field.TypeString, value)
+	}
+	if suo.mutation.TxHashCleared() {
+		_spec.ClearField(subscription.FieldTxHash, field.TypeString)
+	}
+	if value, ok := suo.mutation.ListingID(); ok {
+		_spec.SetField(subscription.FieldListingID, field.TypeString, value)
+	}
+	if suo.mutation.ListingIDCleared() {
+		_spec.ClearField(subscription.FieldListingID, field.TypeString)
+	}
+	if value, ok := suo.mutation.Price(); ok {
+		_spec.SetField(subscription.FieldPrice, field.TypeString, value)
+	}
+	if suo.mutation.PriceCleared() {
+		_spec.ClearField(subscription.FieldPrice, field.TypeString)
+	}
+	if value, ok := suo.mutation.IsListed(); ok {
+		_spec.SetField(subscription.FieldIsListed, field.TypeBool, value)
+	}
+	if value, ok := suo.mutation.StartsAt(); ok {
+		_spec.SetField(subscription.FieldStartsAt, field.TypeTime, value)
+	}
+	if suo.mutation.StartsAtCleared() {
+		_spec.ClearField(subscription.FieldStartsAt, field.TypeTime)
+	}
+	if value, ok := suo.mutation.ExpiresAt(); ok {
+		_spec.SetField(subscription.FieldExpiresAt, field.TypeTime, value)
+	}
+	if suo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(subscription.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := suo.mutation.Expired(); ok {
+		_spec.SetField(subscription.FieldExpired, field.TypeBool, value)
+	}
+	if value, ok := suo.mutation.HeldOnChain(); ok {
+		_spec.SetField(subscription.FieldHeldOnChain, field.TypeBool, value)
+	}
+	if suo.mutation.HeldOnChainCleared() {
+		_spec.ClearField(subscription.FieldHeldOnChain, field.TypeBool)
+	}
+	if value, ok := suo.mutation.ReconciledAt(); ok {
+		_spec.SetField(subscription.FieldReconciledAt, field.TypeTime, value)
+	}
+	if suo.mutation.ReconciledAtCleared() {
+		_spec.ClearField(subscription.FieldReconciledAt, field.TypeTime)
+	}
+	if value, ok := suo.mutation.SubscriptionOptionID(); ok {
+		_spec.SetField(subscription.FieldSubscriptionOptionID, field.TypeString, value)
+	}
+	if suo.mutation.SubscriptionOptionIDCleared() {
+		_spec.ClearField(subscription.FieldSubscriptionOptionID, field.TypeString)
+	}
+	if suo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   subscription.UserTable,
+			Columns: []string{subscription.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   subscription.UserTable,
+			Columns: []string{subscription.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if suo.mutation.ModelCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   subscription.ModelTable,
+			Columns: []string{subscription.ModelColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(model.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.ModelIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   subscription.ModelTable,
+			Columns: []string{subscription.ModelColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(model.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Subscription{config: suo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
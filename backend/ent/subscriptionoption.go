@@ -0,0 +1,179 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"arjunmal1311/fans_flow_on_chain/backend/ent/model"
+	"arjunmal1311/fans_flow_on_chain/backend/ent/subscriptionoption"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// SubscriptionOption is the model entity for the SubscriptionOption schema.
+type SubscriptionOption struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// ModelID holds the value of the "model_id" field.
+	ModelID string `json:"model_id,omitempty"`
+	// Price holds the value of the "price" field.
+	Price string `json:"price,omitempty"`
+	// Duration holds the value of the "duration" field.
+	Duration int `json:"duration,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SubscriptionOptionQuery when eager-loading is set.
+	Edges        SubscriptionOptionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SubscriptionOptionEdges holds the relations/edges for other nodes in the graph.
+type SubscriptionOptionEdges struct {
+	// Model holds the value of the model edge.
+	Model *Model `json:"model,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ModelOrErr returns the Model value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SubscriptionOptionEdges) ModelOrErr() (*Model, error) {
+	if e.Model != nil {
+		return e.Model, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: model.Label}
+	}
+	return nil, &NotLoadedError{edge: "model"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SubscriptionOption) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case subscriptionoption.FieldDuration:
+			values[i] = new(sql.NullInt64)
+		case subscriptionoption.FieldID, subscriptionoption.FieldModelID, subscriptionoption.FieldPrice, subscriptionoption.FieldDescription:
+			values[i] = new(sql.NullString)
+		case subscriptionoption.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SubscriptionOption fields.
+func (so *SubscriptionOption) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case subscriptionoption.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				so.ID = value.String
+			}
+		case subscriptionoption.FieldModelID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field model_id", values[i])
+			} else if value.Valid {
+				so.ModelID = value.String
+			}
+		case subscriptionoption.FieldPrice:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field price", values[i])
+			} else if value.Valid {
+				so.Price = value.String
+			}
+		case subscriptionoption.FieldDuration:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field duration", values[i])
+			} else if value.Valid {
+				so.Duration = int(value.Int64)
+			}
+		case subscriptionoption.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				so.Description = value.String
+			}
+		case subscriptionoption.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				so.CreatedAt = value.Time
+			}
+		default:
+			so.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SubscriptionOption.
+// This includes values selected through modifiers, order, etc.
+func (so *SubscriptionOption) Value(name string) (ent.Value, error) {
+	return so.selectValues.Get(name)
+}
+
+// QueryModel queries the "model" edge of the SubscriptionOption entity.
+func (so *SubscriptionOption) QueryModel() *ModelQuery {
+	return NewSubscriptionOptionClient(so.config).QueryModel(so)
+}
+
+// Update returns a builder for updating this SubscriptionOption.
+// Note that you need to call SubscriptionOption.Unwrap() before calling this method if this SubscriptionOption
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (so *SubscriptionOption) Update() *SubscriptionOptionUpdateOne {
+	return NewSubscriptionOptionClient(so.config).UpdateOne(so)
+}
+
+// Unwrap unwraps the SubscriptionOption entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (so *SubscriptionOption) Unwrap() *SubscriptionOption {
+	_tx, ok := so.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SubscriptionOption is not a transactional entity")
+	}
+	so.config.driver = _tx.drv
+	return so
+}
+
+// String implements the fmt.Stringer.
+func (so *SubscriptionOption) String() string {
+	var builder strings.Builder
+	builder.WriteString("SubscriptionOption(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", so.ID))
+	builder.WriteString("model_id=")
+	builder.WriteString(so.ModelID)
+	builder.WriteString(", ")
+	builder.WriteString("price=")
+	builder.WriteString(so.Price)
+	builder.WriteString(", ")
+	builder.WriteString("duration=")
+	builder.WriteString(fmt.Sprintf("%v", so.Duration))
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(so.Description)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(so.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SubscriptionOptions is a parsable slice of SubscriptionOption.
+type SubscriptionOptions []*SubscriptionOption
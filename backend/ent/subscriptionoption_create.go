@@ -0,0 +1,297 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"arjunmal1311/fans_flow_on_chain/backend/ent/model"
+	"arjunmal1311/fans_flow_on_chain/backend/ent/subscriptionoption"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SubscriptionOptionCreate is the builder for creating a SubscriptionOption entity.
+type SubscriptionOptionCreate struct {
+	config
+	mutation *SubscriptionOptionMutation
+	hooks    []Hook
+}
+
+// SetModelID sets the "model_id" field.
+func (soc *SubscriptionOptionCreate) SetModelID(s string) *SubscriptionOptionCreate {
+	soc.mutation.SetModelID(s)
+	return soc
+}
+
+// SetPrice sets the "price" field.
+func (soc *SubscriptionOptionCreate) SetPrice(s string) *SubscriptionOptionCreate {
+	soc.mutation.SetPrice(s)
+	return soc
+}
+
+// SetDuration sets the "duration" field.
+func (soc *SubscriptionOptionCreate) SetDuration(i int) *SubscriptionOptionCreate {
+	soc.mutation.SetDuration(i)
+	return soc
+}
+
+// SetDescription sets the "description" field.
+func (soc *SubscriptionOptionCreate) SetDescription(s string) *SubscriptionOptionCreate {
+	soc.mutation.SetDescription(s)
+	return soc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (soc *SubscriptionOptionCreate) SetNillableDescription(s *string) *SubscriptionOptionCreate {
+	if s != nil {
+		soc.SetDescription(*s)
+	}
+	return soc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (soc *SubscriptionOptionCreate) SetCreatedAt(t time.Time) *SubscriptionOptionCreate {
+	soc.mutation.SetCreatedAt(t)
+	return soc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (soc *SubscriptionOptionCreate) SetNillableCreatedAt(t *time.Time) *SubscriptionOptionCreate {
+	if t != nil {
+		soc.SetCreatedAt(*t)
+	}
+	return soc
+}
+
+// SetID sets the "id" field.
+func (soc *SubscriptionOptionCreate) SetID(s string) *SubscriptionOptionCreate {
+	soc.mutation.SetID(s)
+	return soc
+}
+
+// SetModel sets the "model" edge to the Model entity.
+func (soc *SubscriptionOptionCreate) SetModel(m *Model) *SubscriptionOptionCreate {
+	return soc.SetModelID(m.ID)
+}
+
+// Mutation returns the SubscriptionOptionMutation object of the builder.
+func (soc *SubscriptionOptionCreate) Mutation() *SubscriptionOptionMutation {
+	return soc.mutation
+}
+
+// Save creates the SubscriptionOption in the database.
+func (soc *SubscriptionOptionCreate) Save(ctx context.Context) (*SubscriptionOption, error) {
+	soc.defaults()
+	return withHooks(ctx, soc.sqlSave, soc.mutation, soc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (soc *SubscriptionOptionCreate) SaveX(ctx context.Context) *SubscriptionOption {
+	v, err := soc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (soc *SubscriptionOptionCreate) Exec(ctx context.Context) error {
+	_, err := soc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (soc *SubscriptionOptionCreate) ExecX(ctx context.Context) {
+	if err := soc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (soc *SubscriptionOptionCreate) defaults() {
+	if _, ok := soc.mutation.CreatedAt(); !ok {
+		v := subscriptionoption.DefaultCreatedAt()
+		soc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (soc *SubscriptionOptionCreate) check() error {
+	if _, ok := soc.mutation.ModelID(); !ok {
+		return &ValidationError{Name: "model_id", err: errors.New(`ent: missing required field "SubscriptionOption.model_id"`)}
+	}
+	if _, ok := soc.mutation.Price(); !ok {
+		return &ValidationError{Name: "price", err: errors.New(`ent: missing required field "SubscriptionOption.price"`)}
+	}
+	if _, ok := soc.mutation.Duration(); !ok {
+		return &ValidationError{Name: "duration", err: errors.New(`ent: missing required field "SubscriptionOption.duration"`)}
+	}
+	if _, ok := soc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SubscriptionOption.created_at"`)}
+	}
+	if v, ok := soc.mutation.ID(); ok {
+		if err := subscriptionoption.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "SubscriptionOption.id": %w`, err)}
+		}
+	}
+	if len(soc.mutation.ModelIDs()) == 0 {
+		return &ValidationError{Name: "model", err: errors.New(`ent: missing required edge "SubscriptionOption.model"`)}
+	}
+	return nil
+}
+
+func (soc *SubscriptionOptionCreate) sqlSave(ctx context.Context) (*SubscriptionOption, error) {
+	if err := soc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := soc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, soc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected SubscriptionOption.ID type: %T", _spec.ID.Value)
+		}
+	}
+	soc.mutation.id = &_node.ID
+	soc.mutation.done = true
+	return _node, nil
+}
+
+func (soc *SubscriptionOptionCreate) createSpec() (*SubscriptionOption, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SubscriptionOption{config: soc.config}
+		_spec = sqlgraph.NewCreateSpec(subscriptionoption.Table, sqlgraph.NewFieldSpec(subscriptionoption.FieldID, field.TypeString))
+	)
+	if id, ok := soc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := soc.mutation.Price(); ok {
+		_spec.SetField(subscriptionoption.FieldPrice, field.TypeString, value)
+		_node.Price = value
+	}
+	if value, ok := soc.mutation.Duration(); ok {
+		_spec.SetField(subscriptionoption.FieldDuration, field.TypeInt, value)
+		_node.Duration = value
+	}
+	if value, ok := soc.mutation.Description(); ok {
+		_spec.SetField(subscriptionoption.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := soc.mutation.CreatedAt(); ok {
+		_spec.SetField(subscriptionoption.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := soc.mutation.ModelIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   subscriptionoption.ModelTable,
+			Columns: []string{subscriptionoption.ModelColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(model.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ModelID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SubscriptionOptionCreateBulk is the builder for creating many SubscriptionOption entities in bulk.
+type SubscriptionOptionCreateBulk struct {
+	config
+	err      error
+	builders []*SubscriptionOptionCreate
+}
+
+// Save creates the SubscriptionOption entities in the database.
+func (socb *SubscriptionOptionCreateBulk) Save(ctx context.Context) ([]*SubscriptionOption, error) {
+	if socb.err != nil {
+		return nil, socb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(socb.builders))
+	nodes := make([]*SubscriptionOption, len(socb.builders))
+	mutators := make([]Mutator, len(socb.builders))
+	for i := range socb.builders {
+		func(i int, root context.Context) {
+			builder := socb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SubscriptionOptionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, socb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, socb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, socb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (socb *SubscriptionOptionCreateBulk) SaveX(ctx context.Context) []*SubscriptionOption {
+	v, err := socb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (socb *SubscriptionOptionCreateBulk) Exec(ctx context.Context) error {
+	_, err := socb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (socb *SubscriptionOptionCreateBulk) ExecX(ctx context.Context) {
+	if err := socb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"arjunmal1311/fans_flow_on_chain/backend/ent/predicate"
+	"arjunmal1311/fans_flow_on_chain/backend/ent/subscriptionoption"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SubscriptionOptionDelete is the builder for deleting a SubscriptionOption entity.
+type SubscriptionOptionDelete struct {
+	config
+	hooks    []Hook
+	mutation *SubscriptionOptionMutation
+}
+
+// Where appends a list predicates to the SubscriptionOptionDelete builder.
+func (sod *SubscriptionOptionDelete) Where(ps ...predicate.SubscriptionOption) *SubscriptionOptionDelete {
+	sod.mutation.Where(ps...)
+	return sod
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (sod *SubscriptionOptionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, sod.sqlExec, sod.mutation, sod.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sod *SubscriptionOptionDelete) ExecX(ctx context.Context) int {
+	n, err := sod.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (sod *SubscriptionOptionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(subscriptionoption.Table, sqlgraph.NewFieldSpec(subscriptionoption.FieldID, field.TypeString))
+	if ps := sod.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, sod.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	sod.mutation.done = true
+	return affected, err
+}
+
+// SubscriptionOptionDeleteOne is the builder for deleting a single SubscriptionOption entity.
+type SubscriptionOptionDeleteOne struct {
+	sod *SubscriptionOptionDelete
+}
+
+// Where appends a list predicates to the SubscriptionOptionDelete builder.
+func (sodo *SubscriptionOptionDeleteOne) Where(ps ...predicate.SubscriptionOption) *SubscriptionOptionDeleteOne {
+	sodo.sod.mutation.Where(ps...)
+	return sodo
+}
+
+// Exec executes the deletion query.
+func (sodo *SubscriptionOptionDeleteOne) Exec(ctx context.Context) error {
+	n, err := sodo.sod.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{subscriptionoption.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sodo *SubscriptionOptionDeleteOne) ExecX(ctx context.Context) {
+	if err := sodo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,606 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"arjunmal1311/fans_flow_on_chain/backend/ent/model"
+	"arjunmal1311/fans_flow_on_chain/backend/ent/predicate"
+	"arjunmal1311/fans_flow_on_chain/backend/ent/subscriptionoption"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SubscriptionOptionQuery is the builder for querying SubscriptionOption entities.
+type SubscriptionOptionQuery struct {
+	config
+	ctx        *QueryContext
+	order      []subscriptionoption.OrderOption
+	inters     []Interceptor
+	predicates []predicate.SubscriptionOption
+	withModel  *ModelQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SubscriptionOptionQuery builder.
+func (soq *SubscriptionOptionQuery) Where(ps ...predicate.SubscriptionOption) *SubscriptionOptionQuery {
+	soq.predicates = append(soq.predicates, ps...)
+	return soq
+}
+
+// Limit the number of records to be returned by this query.
+func (soq *SubscriptionOptionQuery) Limit(limit int) *SubscriptionOptionQuery {
+	soq.ctx.Limit = &limit
+	return soq
+}
+
+// Offset to start from.
+func (soq *SubscriptionOptionQuery) Offset(offset int) *SubscriptionOptionQuery {
+	soq.ctx.Offset = &offset
+	return soq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (soq *SubscriptionOptionQuery) Unique(unique bool) *SubscriptionOptionQuery {
+	soq.ctx.Unique = &unique
+	return soq
+}
+
+// Order specifies how the records should be ordered.
+func (soq *SubscriptionOptionQuery) Order(o ...subscriptionoption.OrderOption) *SubscriptionOptionQuery {
+	soq.order = append(soq.order, o...)
+	return soq
+}
+
+// QueryModel chains the current query on the "model" edge.
+func (soq *SubscriptionOptionQuery) QueryModel() *ModelQuery {
+	query := (&ModelClient{config: soq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := soq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := soq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(subscriptionoption.Table, subscriptionoption.FieldID, selector),
+			sqlgraph.To(model.Table, model.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, subscriptionoption.ModelTable, subscriptionoption.ModelColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(soq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first SubscriptionOption entity from the query.
+// Returns a *NotFoundError when no SubscriptionOption was found.
+func (soq *SubscriptionOptionQuery) First(ctx context.Context) (*SubscriptionOption, error) {
+	nodes, err := soq.Limit(1).All(setContextOp(ctx, soq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{subscriptionoption.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (soq *SubscriptionOptionQuery) FirstX(ctx context.Context) *SubscriptionOption {
+	node, err := soq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SubscriptionOption ID from the query.
+// Returns a *NotFoundError when no SubscriptionOption ID was found.
+func (soq *SubscriptionOptionQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = soq.Limit(1).IDs(setContextOp(ctx, soq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{subscriptionoption.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (soq *SubscriptionOptionQuery) FirstIDX(ctx context.Context) string {
+	id, err := soq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SubscriptionOption entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SubscriptionOption entity is found.
+// Returns a *NotFoundError when no SubscriptionOption entities are found.
+func (soq *SubscriptionOptionQuery) Only(ctx context.Context) (*SubscriptionOption, error) {
+	nodes, err := soq.Limit(2).All(setContextOp(ctx, soq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{subscriptionoption.Label}
+	default:
+		return nil, &NotSingularError{subscriptionoption.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (soq *SubscriptionOptionQuery) OnlyX(ctx context.Context) *SubscriptionOption {
+	node, err := soq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SubscriptionOption ID in the query.
+// Returns a *NotSingularError when more than one SubscriptionOption ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (soq *SubscriptionOptionQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = soq.Limit(2).IDs(setContextOp(ctx, soq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{subscriptionoption.Label}
+	default:
+		err = &NotSingularError{subscriptionoption.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (soq *SubscriptionOptionQuery) OnlyIDX(ctx context.Context) string {
+	id, err := soq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SubscriptionOptions.
+func (soq *SubscriptionOptionQuery) All(ctx context.Context) ([]*SubscriptionOption, error) {
+	ctx = setContextOp(ctx, soq.ctx, ent.OpQueryAll)
+	if err := soq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*SubscriptionOption, *SubscriptionOptionQuery]()
+	return withInterceptors[[]*SubscriptionOption](ctx, soq, qr, soq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (soq *SubscriptionOptionQuery) AllX(ctx context.Context) []*SubscriptionOption {
+	nodes, err := soq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SubscriptionOption IDs.
+func (soq *SubscriptionOptionQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if soq.ctx.Unique == nil && soq.path != nil {
+		soq.Unique(true)
+	}
+	ctx = setContextOp(ctx, soq.ctx, ent.OpQueryIDs)
+	if err = soq.Select(subscriptionoption.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (soq *SubscriptionOptionQuery) IDsX(ctx context.Context) []string {
+	ids, err := soq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (soq *SubscriptionOptionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, soq.ctx, ent.OpQueryCount)
+	if err := soq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, soq, querierCount[*SubscriptionOptionQuery](), soq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (soq *SubscriptionOptionQuery) CountX(ctx context.Context) int {
+	count, err := soq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (soq *SubscriptionOptionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, soq.ctx, ent.OpQueryExist)
+	switch _, err := soq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (soq *SubscriptionOptionQuery) ExistX(ctx context.Context) bool {
+	exist, err := soq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SubscriptionOptionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (soq *SubscriptionOptionQuery) Clone() *SubscriptionOptionQuery {
+	if soq == nil {
+		return nil
+	}
+	return &SubscriptionOptionQuery{
+		config:     soq.config,
+		ctx:        soq.ctx.Clone(),
+		order:      append([]subscriptionoption.OrderOption{}, soq.order...),
+		inters:     append([]Interceptor{}, soq.inters...),
+		predicates: append([]predicate.SubscriptionOption{}, soq.predicates...),
+		withModel:  soq.withModel.Clone(),
+		// clone intermediate query.
+		sql:  soq.sql.Clone(),
+		path: soq.path,
+	}
+}
+
+// WithModel tells the query-builder to eager-load the nodes that are connected to
+// the "model" edge. The optional arguments are used to configure the query builder of the edge.
+func (soq *SubscriptionOptionQuery) WithModel(opts ...func(*ModelQuery)) *SubscriptionOptionQuery {
+	query := (&ModelClient{config: soq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	soq.withModel = query
+	return soq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ModelID string `json:"model_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SubscriptionOption.Query().
+//		GroupBy(subscriptionoption.FieldModelID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (soq *SubscriptionOptionQuery) GroupBy(field string, fields ...string) *SubscriptionOptionGroupBy {
+	soq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &SubscriptionOptionGroupBy{build: soq}
+	grbuild.flds = &soq.ctx.Fields
+	grbuild.label = subscriptionoption.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ModelID string `json:"model_id,omitempty"`
+//	}
+//
+//	client.SubscriptionOption.Query().
+//		Select(subscriptionoption.FieldModelID).
+//		Scan(ctx, &v)
+func (soq *SubscriptionOptionQuery) Select(fields ...string) *SubscriptionOptionSelect {
+	soq.ctx.Fields = append(soq.ctx.Fields, fields...)
+	sbuild := &SubscriptionOptionSelect{SubscriptionOptionQuery: soq}
+	sbuild.label = subscriptionoption.Label
+	sbuild.flds, sbuild.scan = &soq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a SubscriptionOptionSelect configured with the given aggregations.
+func (soq *SubscriptionOptionQuery) Aggregate(fns ...AggregateFunc) *SubscriptionOptionSelect {
+	return soq.Select().Aggregate(fns...)
+}
+
+func (soq *SubscriptionOptionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range soq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, soq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range soq.ctx.Fields {
+		if !subscriptionoption.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if soq.path != nil {
+		prev, err := soq.path(ctx)
+		if err != nil {
+			return err
+		}
+		soq.sql = prev
+	}
+	return nil
+}
+
+func (soq *SubscriptionOptionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SubscriptionOption, error) {
+	var (
+		nodes       = []*SubscriptionOption{}
+		_spec       = soq.querySpec()
+		loadedTypes = [1]bool{
+			soq.withModel != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SubscriptionOption).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SubscriptionOption{config: soq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, soq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := soq.withModel; query != nil {
+		if err := soq.loadModel(ctx, query, nodes, nil,
+			func(n *SubscriptionOption, e *Model) { n.Edges.Model = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (soq *SubscriptionOptionQuery) loadModel(ctx context.Context, query *ModelQuery, nodes []*SubscriptionOption, init func(*SubscriptionOption), assign func(*SubscriptionOption, *Model)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*SubscriptionOption)
+	for i := range nodes {
+		fk := nodes[i].ModelID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(model.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "model_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (soq *SubscriptionOptionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := soq.querySpec()
+	_spec.Node.Columns = soq.ctx.Fields
+	if len(soq.ctx.Fields) > 0 {
+		_spec.Unique = soq.ctx.Unique != nil && *soq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, soq.driver, _spec)
+}
+
+func (soq *SubscriptionOptionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(subscriptionoption.Table, subscriptionoption.Columns, sqlgraph.NewFieldSpec(subscriptionoption.FieldID, field.TypeString))
+	_spec.From = soq.sql
+	if unique := soq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if soq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := soq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, subscriptionoption.FieldID)
+		for i := range fields {
+			if fields[i] != subscriptionoption.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if soq.withModel != nil {
+			_spec.Node.AddColumnOnce(subscriptionoption.FieldModelID)
+		}
+	}
+	if ps := soq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := soq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := soq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := soq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (soq *SubscriptionOptionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(soq.driver.Dialect())
+	t1 := builder.Table(subscriptionoption.Table)
+	columns := soq.ctx.Fields
+	if len(columns) == 0 {
+		columns = subscriptionoption.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if soq.sql != nil {
+		selector = soq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if soq.ctx.Unique != nil && *soq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range soq.predicates {
+		p(selector)
+	}
+	for _, p := range soq.order {
+		p(selector)
+	}
+	if offset := soq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := soq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// SubscriptionOptionGroupBy is the group-by builder for SubscriptionOption entities.
+type SubscriptionOptionGroupBy struct {
+	selector
+	build *SubscriptionOptionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (sogb *SubscriptionOptionGroupBy) Aggregate(fns ...AggregateFunc) *SubscriptionOptionGroupBy {
+	sogb.fns = append(sogb.fns, fns...)
+	return sogb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sogb *SubscriptionOptionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sogb.build.ctx, ent.OpQueryGroupBy)
+	if err := sogb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SubscriptionOptionQuery, *SubscriptionOptionGroupBy](ctx, sogb.build, sogb, sogb.build.inters, v)
+}
+
+func (sogb *SubscriptionOptionGroupBy) sqlScan(ctx context.Context, root *SubscriptionOptionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(sogb.fns))
+	for _, fn := range sogb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*sogb.flds)+len(sogb.fns))
+		for _, f := range *sogb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*sogb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sogb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// SubscriptionOptionSelect is the builder for selecting fields of SubscriptionOption entities.
+type SubscriptionOptionSelect struct {
+	*SubscriptionOptionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (sos *SubscriptionOptionSelect) Aggregate(fns ...AggregateFunc) *SubscriptionOptionSelect {
+	sos.fns = append(sos.fns, fns...)
+	return sos
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sos *SubscriptionOptionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sos.ctx, ent.OpQuerySelect)
+	if err := sos.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SubscriptionOptionQuery, *SubscriptionOptionSelect](ctx, sos.SubscriptionOptionQuery, sos, sos.inters, v)
+}
+
+func (sos *SubscriptionOptionSelect) sqlScan(ctx context.Context, root *SubscriptionOptionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(sos.fns))
+	for _, fn := range sos.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*sos.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sos.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
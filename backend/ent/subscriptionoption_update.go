@@ -0,0 +1,446 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"arjunmal1311/fans_flow_on_chain/backend/ent/model"
+	"arjunmal1311/fans_flow_on_chain/backend/ent/predicate"
+	"arjunmal1311/fans_flow_on_chain/backend/ent/subscriptionoption"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SubscriptionOptionUpdate is the builder for updating SubscriptionOption entities.
+type SubscriptionOptionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SubscriptionOptionMutation
+}
+
+// Where appends a list predicates to the SubscriptionOptionUpdate builder.
+func (sou *SubscriptionOptionUpdate) Where(ps ...predicate.SubscriptionOption) *SubscriptionOptionUpdate {
+	sou.mutation.Where(ps...)
+	return sou
+}
+
+// SetModelID sets the "model_id" field.
+func (sou *SubscriptionOptionUpdate) SetModelID(s string) *SubscriptionOptionUpdate {
+	sou.mutation.SetModelID(s)
+	return sou
+}
+
+// SetNillableModelID sets the "model_id" field if the given value is not nil.
+func (sou *SubscriptionOptionUpdate) SetNillableModelID(s *string) *SubscriptionOptionUpdate {
+	if s != nil {
+		sou.SetModelID(*s)
+	}
+	return sou
+}
+
+// SetPrice sets the "price" field.
+func (sou *SubscriptionOptionUpdate) SetPrice(s string) *SubscriptionOptionUpdate {
+	sou.mutation.SetPrice(s)
+	return sou
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (sou *SubscriptionOptionUpdate) SetNillablePrice(s *string) *SubscriptionOptionUpdate {
+	if s != nil {
+		sou.SetPrice(*s)
+	}
+	return sou
+}
+
+// SetDuration sets the "duration" field.
+func (sou *SubscriptionOptionUpdate) SetDuration(i int) *SubscriptionOptionUpdate {
+	sou.mutation.ResetDuration()
+	sou.mutation.SetDuration(i)
+	return sou
+}
+
+// SetNillableDuration sets the "duration" field if the given value is not nil.
+func (sou *SubscriptionOptionUpdate) SetNillableDuration(i *int) *SubscriptionOptionUpdate {
+	if i != nil {
+		sou.SetDuration(*i)
+	}
+	return sou
+}
+
+// AddDuration adds i to the "duration" field.
+func (sou *SubscriptionOptionUpdate) AddDuration(i int) *SubscriptionOptionUpdate {
+	sou.mutation.AddDuration(i)
+	return sou
+}
+
+// SetDescription sets the "description" field.
+func (sou *SubscriptionOptionUpdate) SetDescription(s string) *SubscriptionOptionUpdate {
+	sou.mutation.SetDescription(s)
+	return sou
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (sou *SubscriptionOptionUpdate) SetNillableDescription(s *string) *SubscriptionOptionUpdate {
+	if s != nil {
+		sou.SetDescription(*s)
+	}
+	return sou
+}
+
+// ClearDescription clears the value of the "description" field.
+func (sou *SubscriptionOptionUpdate) ClearDescription() *SubscriptionOptionUpdate {
+	sou.mutation.ClearDescription()
+	return sou
+}
+
+// SetModel sets the "model" edge to the Model entity.
+func (sou *SubscriptionOptionUpdate) SetModel(m *Model) *SubscriptionOptionUpdate {
+	return sou.SetModelID(m.ID)
+}
+
+// Mutation returns the SubscriptionOptionMutation object of the builder.
+func (sou *SubscriptionOptionUpdate) Mutation() *SubscriptionOptionMutation {
+	return sou.mutation
+}
+
+// ClearModel clears the "model" edge to the Model entity.
+func (sou *SubscriptionOptionUpdate) ClearModel() *SubscriptionOptionUpdate {
+	sou.mutation.ClearModel()
+	return sou
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sou *SubscriptionOptionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, sou.sqlSave, sou.mutation, sou.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sou *SubscriptionOptionUpdate) SaveX(ctx context.Context) int {
+	affected, err := sou.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sou *SubscriptionOptionUpdate) Exec(ctx context.Context) error {
+	_, err := sou.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sou *SubscriptionOptionUpdate) ExecX(ctx context.Context) {
+	if err := sou.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sou *SubscriptionOptionUpdate) check() error {
+	if sou.mutation.ModelCleared() && len(sou.mutation.ModelIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SubscriptionOption.model"`)
+	}
+	return nil
+}
+
+func (sou *SubscriptionOptionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := sou.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(subscriptionoption.Table, subscriptionoption.Columns, sqlgraph.NewFieldSpec(subscriptionoption.FieldID, field.TypeString))
+	if ps := sou.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sou.mutation.Price(); ok {
+		_spec.SetField(subscriptionoption.FieldPrice, field.TypeString, value)
+	}
+	if value, ok := sou.mutation.Duration(); ok {
+		_spec.SetField(subscriptionoption.FieldDuration, field.TypeInt, value)
+	}
+	if value, ok := sou.mutation.AddedDuration(); ok {
+		_spec.AddField(subscriptionoption.FieldDuration, field.TypeInt, value)
+	}
+	if value, ok := sou.mutation.Description(); ok {
+		_spec.SetField(subscriptionoption.FieldDescription, field.TypeString, value)
+	}
+	if sou.mutation.DescriptionCleared() {
+		_spec.ClearField(subscriptionoption.FieldDescription, field.TypeString)
+	}
+	if sou.mutation.ModelCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   subscriptionoption.ModelTable,
+			Columns: []string{subscriptionoption.ModelColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(model.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sou.mutation.ModelIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   subscriptionoption.ModelTable,
+			Columns: []string{subscriptionoption.ModelColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(model.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, sou.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{subscriptionoption.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	sou.mutation.done = true
+	return n, nil
+}
+
+// SubscriptionOptionUpdateOne is the builder for updating a single SubscriptionOption entity.
+type SubscriptionOptionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SubscriptionOptionMutation
+}
+
+// SetModelID sets the "model_id" field.
+func (souo *SubscriptionOptionUpdateOne) SetModelID(s string) *SubscriptionOptionUpdateOne {
+	souo.mutation.SetModelID(s)
+	return souo
+}
+
+// SetNillableModelID sets the "model_id" field if the given value is not nil.
+func (souo *SubscriptionOptionUpdateOne) SetNillableModelID(s *string) *SubscriptionOptionUpdateOne {
+	if s != nil {
+		souo.SetModelID(*s)
+	}
+	return souo
+}
+
+// SetPrice sets the "price" field.
+func (souo *SubscriptionOptionUpdateOne) SetPrice(s string) *SubscriptionOptionUpdateOne {
+	souo.mutation.SetPrice(s)
+	return souo
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (souo *SubscriptionOptionUpdateOne) SetNillablePrice(s *string) *SubscriptionOptionUpdateOne {
+	if s != nil {
+		souo.SetPrice(*s)
+	}
+	return souo
+}
+
+// SetDuration sets the "duration" field.
+func (souo *SubscriptionOptionUpdateOne) SetDuration(i int) *SubscriptionOptionUpdateOne {
+	souo.mutation.ResetDuration()
+	souo.mutation.SetDuration(i)
+	return souo
+}
+
+// SetNillableDuration sets the "duration" field if the given value is not nil.
+func (souo *SubscriptionOptionUpdateOne) SetNillableDuration(i *int) *SubscriptionOptionUpdateOne {
+	if i != nil {
+		souo.SetDuration(*i)
+	}
+	return souo
+}
+
+// AddDuration adds i to the "duration" field.
+func (souo *SubscriptionOptionUpdateOne) AddDuration(i int) *SubscriptionOptionUpdateOne {
+	souo.mutation.AddDuration(i)
+	return souo
+}
+
+// SetDescription sets the "description" field.
+func (souo *SubscriptionOptionUpdateOne) SetDescription(s string) *SubscriptionOptionUpdateOne {
+	souo.mutation.SetDescription(s)
+	return souo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (souo *SubscriptionOptionUpdateOne) SetNillableDescription(s *string) *SubscriptionOptionUpdateOne {
+	if s != nil {
+		souo.SetDescription(*s)
+	}
+	return souo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (souo *SubscriptionOptionUpdateOne) ClearDescription() *SubscriptionOptionUpdateOne {
+	souo.mutation.ClearDescription()
+	return souo
+}
+
+// SetModel sets the "model" edge to the Model entity.
+func (souo *SubscriptionOptionUpdateOne) SetModel(m *Model) *SubscriptionOptionUpdateOne {
+	return souo.SetModelID(m.ID)
+}
+
+// Mutation returns the SubscriptionOptionMutation object of the builder.
+func (souo *SubscriptionOptionUpdateOne) Mutation() *SubscriptionOptionMutation {
+	return souo.mutation
+}
+
+// ClearModel clears the "model" edge to the Model entity.
+func (souo *SubscriptionOptionUpdateOne) ClearModel() *SubscriptionOptionUpdateOne {
+	souo.mutation.ClearModel()
+	return souo
+}
+
+// Where appends a list predicates to the SubscriptionOptionUpdate builder.
+func (souo *SubscriptionOptionUpdateOne) Where(ps ...predicate.SubscriptionOption) *SubscriptionOptionUpdateOne {
+	souo.mutation.Where(ps...)
+	return souo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (souo *SubscriptionOptionUpdateOne) Select(field string, fields ...string) *SubscriptionOptionUpdateOne {
+	souo.fields = append([]string{field}, fields...)
+	return souo
+}
+
+// Save executes the query and returns the updated SubscriptionOption entity.
+func (souo *SubscriptionOptionUpdateOne) Save(ctx context.Context) (*SubscriptionOption, error) {
+	return withHooks(ctx, souo.sqlSave, souo.mutation, souo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (souo *SubscriptionOptionUpdateOne) SaveX(ctx context.Context) *SubscriptionOption {
+	node, err := souo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (souo *SubscriptionOptionUpdateOne) Exec(ctx context.Context) error {
+	_, err := souo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (souo *SubscriptionOptionUpdateOne) ExecX(ctx context.Context) {
+	if err := souo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (souo *SubscriptionOptionUpdateOne) check() error {
+	if souo.mutation.ModelCleared() && len(souo.mutation.ModelIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SubscriptionOption.model"`)
+	}
+	return nil
+}
+
+func (souo *SubscriptionOptionUpdateOne) sqlSave(ctx context.Context) (_node *SubscriptionOption, err error) {
+	if err := souo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(subscriptionoption.Table, subscriptionoption.Columns, sqlgraph.NewFieldSpec(subscriptionoption.FieldID, field.TypeString))
+	id, ok := souo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SubscriptionOption.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := souo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, subscriptionoption.FieldID)
+		for _, f := range fields {
+			if !subscriptionoption.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != subscriptionoption.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := souo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := souo.mutation.Price(); ok {
+		_spec.SetField(subscriptionoption.FieldPrice, field.TypeString, value)
+	}
+	if value, ok := souo.mutation.Duration(); ok {
+		_spec.SetField(subscriptionoption.FieldDuration, field.TypeInt, value)
+	}
+	if value, ok := souo.mutation.AddedDuration(); ok {
+		_spec.AddField(subscriptionoption.FieldDuration, field.TypeInt, value)
+	}
+	if value, ok := souo.mutation.Description(); ok {
+		_spec.SetField(subscriptionoption.FieldDescription, field.TypeString, value)
+	}
+	if souo.mutation.DescriptionCleared() {
+		_spec.ClearField(subscriptionoption.FieldDescription, field.TypeString)
+	}
+	if souo.mutation.ModelCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   subscriptionoption.ModelTable,
+			Columns: []string{subscriptionoption.ModelColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(model.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := souo.mutation.ModelIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   subscriptionoption.ModelTable,
+			Columns: []string{subscriptionoption.ModelColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(model.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &SubscriptionOption{config: souo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, souo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{subscriptionoption.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	souo.mutation.done = true
+	return _node, nil
+}
@@ -16,12 +16,8 @@ type Tx struct {
 	Model *ModelClient
 	// Subscription is the client for interacting with the Subscription builders.
 	Subscription *SubscriptionClient
-	// SubscriptionMetis is the client for interacting with the SubscriptionMetis builders.
-	SubscriptionMetis *SubscriptionMetisClient
-	// SubscriptionMoonbeam is the client for interacting with the SubscriptionMoonbeam builders.
-	SubscriptionMoonbeam *SubscriptionMoonbeamClient
-	// SubscriptionZkEVM is the client for interacting with the SubscriptionZkEVM builders.
-	SubscriptionZkEVM *SubscriptionZkEVMClient
+	// SubscriptionOption is the client for interacting with the SubscriptionOption builders.
+	SubscriptionOption *SubscriptionOptionClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 
@@ -157,9 +153,7 @@ func (tx *Tx) Client() *Client {
 func (tx *Tx) init() {
 	tx.Model = NewModelClient(tx.config)
 	tx.Subscription = NewSubscriptionClient(tx.config)
-	tx.SubscriptionMetis = NewSubscriptionMetisClient(tx.config)
-	tx.SubscriptionMoonbeam = NewSubscriptionMoonbeamClient(tx.config)
-	tx.SubscriptionZkEVM = NewSubscriptionZkEVMClient(tx.config)
+	tx.SubscriptionOption = NewSubscriptionOptionClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 }
 
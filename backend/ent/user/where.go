@@ -6,53 +6,457 @@ import (
 	"arjunmal1311/fans_flow_on_chain/backend/ent/predicate"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 )
 
 // ID filters vertices based on their ID field.
-func ID(id int) predicate.User {
+func ID(id string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldID, id))
 }
 
 // IDEQ applies the EQ predicate on the ID field.
-func IDEQ(id int) predicate.User {
+func IDEQ(id string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldID, id))
 }
 
 // IDNEQ applies the NEQ predicate on the ID field.
-func IDNEQ(id int) predicate.User {
+func IDNEQ(id string) predicate.User {
 	return predicate.User(sql.FieldNEQ(FieldID, id))
 }
 
 // IDIn applies the In predicate on the ID field.
-func IDIn(ids ...int) predicate.User {
+func IDIn(ids ...string) predicate.User {
 	return predicate.User(sql.FieldIn(FieldID, ids...))
 }
 
 // IDNotIn applies the NotIn predicate on the ID field.
-func IDNotIn(ids ...int) predicate.User {
+func IDNotIn(ids ...string) predicate.User {
 	return predicate.User(sql.FieldNotIn(FieldID, ids...))
 }
 
 // IDGT applies the GT predicate on the ID field.
-func IDGT(id int) predicate.User {
+func IDGT(id string) predicate.User {
 	return predicate.User(sql.FieldGT(FieldID, id))
 }
 
 // IDGTE applies the GTE predicate on the ID field.
-func IDGTE(id int) predicate.User {
+func IDGTE(id string) predicate.User {
 	return predicate.User(sql.FieldGTE(FieldID, id))
 }
 
 // IDLT applies the LT predicate on the ID field.
-func IDLT(id int) predicate.User {
+func IDLT(id string) predicate.User {
 	return predicate.User(sql.FieldLT(FieldID, id))
 }
 
 // IDLTE applies the LTE predicate on the ID field.
-func IDLTE(id int) predicate.User {
+func IDLTE(id string) predicate.User {
 	return predicate.User(sql.FieldLTE(FieldID, id))
 }
 
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldID, id))
+}
+
+// Username applies equality check predicate on the "username" field. It's identical to UsernameEQ.
+func Username(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldUsername, v))
+}
+
+// Email applies equality check predicate on the "email" field. It's identical to EmailEQ.
+func Email(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldEmail, v))
+}
+
+// WalletAddress applies equality check predicate on the "wallet_address" field. It's identical to WalletAddressEQ.
+func WalletAddress(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldWalletAddress, v))
+}
+
+// IpfsURL applies equality check predicate on the "ipfs_url" field. It's identical to IpfsURLEQ.
+func IpfsURL(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldIpfsURL, v))
+}
+
+// OpenaiTokenID applies equality check predicate on the "openai_token_id" field. It's identical to OpenaiTokenIDEQ.
+func OpenaiTokenID(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldOpenaiTokenID, v))
+}
+
+// UsernameEQ applies the EQ predicate on the "username" field.
+func UsernameEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldUsername, v))
+}
+
+// UsernameNEQ applies the NEQ predicate on the "username" field.
+func UsernameNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldUsername, v))
+}
+
+// UsernameIn applies the In predicate on the "username" field.
+func UsernameIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldUsername, vs...))
+}
+
+// UsernameNotIn applies the NotIn predicate on the "username" field.
+func UsernameNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldUsername, vs...))
+}
+
+// UsernameGT applies the GT predicate on the "username" field.
+func UsernameGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldUsername, v))
+}
+
+// UsernameGTE applies the GTE predicate on the "username" field.
+func UsernameGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldUsername, v))
+}
+
+// UsernameLT applies the LT predicate on the "username" field.
+func UsernameLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldUsername, v))
+}
+
+// UsernameLTE applies the LTE predicate on the "username" field.
+func UsernameLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldUsername, v))
+}
+
+// UsernameContains applies the Contains predicate on the "username" field.
+func UsernameContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldUsername, v))
+}
+
+// UsernameHasPrefix applies the HasPrefix predicate on the "username" field.
+func UsernameHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldUsername, v))
+}
+
+// UsernameHasSuffix applies the HasSuffix predicate on the "username" field.
+func UsernameHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldUsername, v))
+}
+
+// UsernameEqualFold applies the EqualFold predicate on the "username" field.
+func UsernameEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldUsername, v))
+}
+
+// UsernameContainsFold applies the ContainsFold predicate on the "username" field.
+func UsernameContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldUsername, v))
+}
+
+// EmailEQ applies the EQ predicate on the "email" field.
+func EmailEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldEmail, v))
+}
+
+// EmailNEQ applies the NEQ predicate on the "email" field.
+func EmailNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldEmail, v))
+}
+
+// EmailIn applies the In predicate on the "email" field.
+func EmailIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldEmail, vs...))
+}
+
+// EmailNotIn applies the NotIn predicate on the "email" field.
+func EmailNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldEmail, vs...))
+}
+
+// EmailGT applies the GT predicate on the "email" field.
+func EmailGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldEmail, v))
+}
+
+// EmailGTE applies the GTE predicate on the "email" field.
+func EmailGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldEmail, v))
+}
+
+// EmailLT applies the LT predicate on the "email" field.
+func EmailLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldEmail, v))
+}
+
+// EmailLTE applies the LTE predicate on the "email" field.
+func EmailLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldEmail, v))
+}
+
+// EmailContains applies the Contains predicate on the "email" field.
+func EmailContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldEmail, v))
+}
+
+// EmailHasPrefix applies the HasPrefix predicate on the "email" field.
+func EmailHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldEmail, v))
+}
+
+// EmailHasSuffix applies the HasSuffix predicate on the "email" field.
+func EmailHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldEmail, v))
+}
+
+// EmailEqualFold applies the EqualFold predicate on the "email" field.
+func EmailEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldEmail, v))
+}
+
+// EmailContainsFold applies the ContainsFold predicate on the "email" field.
+func EmailContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldEmail, v))
+}
+
+// WalletAddressEQ applies the EQ predicate on the "wallet_address" field.
+func WalletAddressEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldWalletAddress, v))
+}
+
+// WalletAddressNEQ applies the NEQ predicate on the "wallet_address" field.
+func WalletAddressNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldWalletAddress, v))
+}
+
+// WalletAddressIn applies the In predicate on the "wallet_address" field.
+func WalletAddressIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldWalletAddress, vs...))
+}
+
+// WalletAddressNotIn applies the NotIn predicate on the "wallet_address" field.
+func WalletAddressNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldWalletAddress, vs...))
+}
+
+// WalletAddressGT applies the GT predicate on the "wallet_address" field.
+func WalletAddressGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldWalletAddress, v))
+}
+
+// WalletAddressGTE applies the GTE predicate on the "wallet_address" field.
+func WalletAddressGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldWalletAddress, v))
+}
+
+// WalletAddressLT applies the LT predicate on the "wallet_address" field.
+func WalletAddressLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldWalletAddress, v))
+}
+
+// WalletAddressLTE applies the LTE predicate on the "wallet_address" field.
+func WalletAddressLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldWalletAddress, v))
+}
+
+// WalletAddressContains applies the Contains predicate on the "wallet_address" field.
+func WalletAddressContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldWalletAddress, v))
+}
+
+// WalletAddressHasPrefix applies the HasPrefix predicate on the "wallet_address" field.
+func WalletAddressHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldWalletAddress, v))
+}
+
+// WalletAddressHasSuffix applies the HasSuffix predicate on the "wallet_address" field.
+func WalletAddressHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldWalletAddress, v))
+}
+
+// WalletAddressEqualFold applies the EqualFold predicate on the "wallet_address" field.
+func WalletAddressEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldWalletAddress, v))
+}
+
+// WalletAddressContainsFold applies the ContainsFold predicate on the "wallet_address" field.
+func WalletAddressContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldWalletAddress, v))
+}
+
+// IpfsURLEQ applies the EQ predicate on the "ipfs_url" field.
+func IpfsURLEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldIpfsURL, v))
+}
+
+// IpfsURLNEQ applies the NEQ predicate on the "ipfs_url" field.
+func IpfsURLNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldIpfsURL, v))
+}
+
+// IpfsURLIn applies the In predicate on the "ipfs_url" field.
+func IpfsURLIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldIpfsURL, vs...))
+}
+
+// IpfsURLNotIn applies the NotIn predicate on the "ipfs_url" field.
+func IpfsURLNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldIpfsURL, vs...))
+}
+
+// IpfsURLGT applies the GT predicate on the "ipfs_url" field.
+func IpfsURLGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldIpfsURL, v))
+}
+
+// IpfsURLGTE applies the GTE predicate on the "ipfs_url" field.
+func IpfsURLGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldIpfsURL, v))
+}
+
+// IpfsURLLT applies the LT predicate on the "ipfs_url" field.
+func IpfsURLLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldIpfsURL, v))
+}
+
+// IpfsURLLTE applies the LTE predicate on the "ipfs_url" field.
+func IpfsURLLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldIpfsURL, v))
+}
+
+// IpfsURLContains applies the Contains predicate on the "ipfs_url" field.
+func IpfsURLContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldIpfsURL, v))
+}
+
+// IpfsURLHasPrefix applies the HasPrefix predicate on the "ipfs_url" field.
+func IpfsURLHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldIpfsURL, v))
+}
+
+// IpfsURLHasSuffix applies the HasSuffix predicate on the "ipfs_url" field.
+func IpfsURLHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldIpfsURL, v))
+}
+
+// IpfsURLIsNil applies the IsNil predicate on the "ipfs_url" field.
+func IpfsURLIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldIpfsURL))
+}
+
+// IpfsURLNotNil applies the NotNil predicate on the "ipfs_url" field.
+func IpfsURLNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldIpfsURL))
+}
+
+// IpfsURLEqualFold applies the EqualFold predicate on the "ipfs_url" field.
+func IpfsURLEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldIpfsURL, v))
+}
+
+// IpfsURLContainsFold applies the ContainsFold predicate on the "ipfs_url" field.
+func IpfsURLContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldIpfsURL, v))
+}
+
+// OpenaiTokenIDEQ applies the EQ predicate on the "openai_token_id" field.
+func OpenaiTokenIDEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldOpenaiTokenID, v))
+}
+
+// OpenaiTokenIDNEQ applies the NEQ predicate on the "openai_token_id" field.
+func OpenaiTokenIDNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldOpenaiTokenID, v))
+}
+
+// OpenaiTokenIDIn applies the In predicate on the "openai_token_id" field.
+func OpenaiTokenIDIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldOpenaiTokenID, vs...))
+}
+
+// OpenaiTokenIDNotIn applies the NotIn predicate on the "openai_token_id" field.
+func OpenaiTokenIDNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldOpenaiTokenID, vs...))
+}
+
+// OpenaiTokenIDGT applies the GT predicate on the "openai_token_id" field.
+func OpenaiTokenIDGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldOpenaiTokenID, v))
+}
+
+// OpenaiTokenIDGTE applies the GTE predicate on the "openai_token_id" field.
+func OpenaiTokenIDGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldOpenaiTokenID, v))
+}
+
+// OpenaiTokenIDLT applies the LT predicate on the "openai_token_id" field.
+func OpenaiTokenIDLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldOpenaiTokenID, v))
+}
+
+// OpenaiTokenIDLTE applies the LTE predicate on the "openai_token_id" field.
+func OpenaiTokenIDLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldOpenaiTokenID, v))
+}
+
+// OpenaiTokenIDContains applies the Contains predicate on the "openai_token_id" field.
+func OpenaiTokenIDContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldOpenaiTokenID, v))
+}
+
+// OpenaiTokenIDHasPrefix applies the HasPrefix predicate on the "openai_token_id" field.
+func OpenaiTokenIDHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldOpenaiTokenID, v))
+}
+
+// OpenaiTokenIDHasSuffix applies the HasSuffix predicate on the "openai_token_id" field.
+func OpenaiTokenIDHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldOpenaiTokenID, v))
+}
+
+// OpenaiTokenIDIsNil applies the IsNil predicate on the "openai_token_id" field.
+func OpenaiTokenIDIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldOpenaiTokenID))
+}
+
+// OpenaiTokenIDNotNil applies the NotNil predicate on the "openai_token_id" field.
+func OpenaiTokenIDNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldOpenaiTokenID))
+}
+
+// OpenaiTokenIDEqualFold applies the EqualFold predicate on the "openai_token_id" field.
+func OpenaiTokenIDEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldOpenaiTokenID, v))
+}
+
+// OpenaiTokenIDContainsFold applies the ContainsFold predicate on the "openai_token_id" field.
+func OpenaiTokenIDContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldOpenaiTokenID, v))
+}
+
+// HasSubscriptions applies the HasEdge predicate on the "subscriptions" edge.
+func HasSubscriptions() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SubscriptionsTable, SubscriptionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSubscriptionsWith applies the HasEdge predicate on the "subscriptions" edge with a given conditions (other predicates).
+func HasSubscriptionsWith(preds ...predicate.Subscription) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newSubscriptionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.User) predicate.User {
 	return predicate.User(sql.AndPredicates(predicates...))
@@ -4,6 +4,7 @@ package ent
 
 import (
 	"arjunmal1311/fans_flow_on_chain/backend/ent/predicate"
+	"arjunmal1311/fans_flow_on_chain/backend/ent/subscription"
 	"arjunmal1311/fans_flow_on_chain/backend/ent/user"
 	"context"
 	"errors"
@@ -27,11 +28,129 @@ func (uu *UserUpdate) Where(ps ...predicate.User) *UserUpdate {
 	return uu
 }
 
+// SetUsername sets the "username" field.
+func (uu *UserUpdate) SetUsername(s string) *UserUpdate {
+	uu.mutation.SetUsername(s)
+	return uu
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableUsername(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetUsername(*s)
+	}
+	return uu
+}
+
+// SetEmail sets the "email" field.
+func (uu *UserUpdate) SetEmail(s string) *UserUpdate {
+	uu.mutation.SetEmail(s)
+	return uu
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableEmail(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetEmail(*s)
+	}
+	return uu
+}
+
+// SetWalletAddress sets the "wallet_address" field.
+func (uu *UserUpdate) SetWalletAddress(s string) *UserUpdate {
+	uu.mutation.SetWalletAddress(s)
+	return uu
+}
+
+// SetNillableWalletAddress sets the "wallet_address" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableWalletAddress(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetWalletAddress(*s)
+	}
+	return uu
+}
+
+// SetIpfsURL sets the "ipfs_url" field.
+func (uu *UserUpdate) SetIpfsURL(s string) *UserUpdate {
+	uu.mutation.SetIpfsURL(s)
+	return uu
+}
+
+// SetNillableIpfsURL sets the "ipfs_url" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableIpfsURL(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetIpfsURL(*s)
+	}
+	return uu
+}
+
+// ClearIpfsURL clears the value of the "ipfs_url" field.
+func (uu *UserUpdate) ClearIpfsURL() *UserUpdate {
+	uu.mutation.ClearIpfsURL()
+	return uu
+}
+
+// SetOpenaiTokenID sets the "openai_token_id" field.
+func (uu *UserUpdate) SetOpenaiTokenID(s string) *UserUpdate {
+	uu.mutation.SetOpenaiTokenID(s)
+	return uu
+}
+
+// SetNillableOpenaiTokenID sets the "openai_token_id" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableOpenaiTokenID(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetOpenaiTokenID(*s)
+	}
+	return uu
+}
+
+// ClearOpenaiTokenID clears the value of the "openai_token_id" field.
+func (uu *UserUpdate) ClearOpenaiTokenID() *UserUpdate {
+	uu.mutation.ClearOpenaiTokenID()
+	return uu
+}
+
+// AddSubscriptionIDs adds the "subscriptions" edge to the Subscription entity by IDs.
+func (uu *UserUpdate) AddSubscriptionIDs(ids ...string) *UserUpdate {
+	uu.mutation.AddSubscriptionIDs(ids...)
+	return uu
+}
+
+// AddSubscriptions adds the "subscriptions" edges to the Subscription entity.
+func (uu *UserUpdate) AddSubscriptions(s ...*Subscription) *UserUpdate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uu.AddSubscriptionIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uu *UserUpdate) Mutation() *UserMutation {
 	return uu.mutation
 }
 
+// ClearSubscriptions clears all "subscriptions" edges to the Subscription entity.
+func (uu *UserUpdate) ClearSubscriptions() *UserUpdate {
+	uu.mutation.ClearSubscriptions()
+	return uu
+}
+
+// RemoveSubscriptionIDs removes the "subscriptions" edge to Subscription entities by IDs.
+func (uu *UserUpdate) RemoveSubscriptionIDs(ids ...string) *UserUpdate {
+	uu.mutation.RemoveSubscriptionIDs(ids...)
+	return uu
+}
+
+// RemoveSubscriptions removes "subscriptions" edges to Subscription entities.
+func (uu *UserUpdate) RemoveSubscriptions(s ...*Subscription) *UserUpdate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uu.RemoveSubscriptionIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, uu.sqlSave, uu.mutation, uu.hooks)
@@ -60,7 +179,7 @@ func (uu *UserUpdate) ExecX(ctx context.Context) {
 }
 
 func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
-	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt))
+	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeString))
 	if ps := uu.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
@@ -68,6 +187,72 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if value, ok := uu.mutation.Username(); ok {
+		_spec.SetField(user.FieldUsername, field.TypeString, value)
+	}
+	if value, ok := uu.mutation.Email(); ok {
+		_spec.SetField(user.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := uu.mutation.WalletAddress(); ok {
+		_spec.SetField(user.FieldWalletAddress, field.TypeString, value)
+	}
+	if value, ok := uu.mutation.IpfsURL(); ok {
+		_spec.SetField(user.FieldIpfsURL, field.TypeString, value)
+	}
+	if uu.mutation.IpfsURLCleared() {
+		_spec.ClearField(user.FieldIpfsURL, field.TypeString)
+	}
+	if value, ok := uu.mutation.OpenaiTokenID(); ok {
+		_spec.SetField(user.FieldOpenaiTokenID, field.TypeString, value)
+	}
+	if uu.mutation.OpenaiTokenIDCleared() {
+		_spec.ClearField(user.FieldOpenaiTokenID, field.TypeString)
+	}
+	if uu.mutation.SubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SubscriptionsTable,
+			Columns: []string{user.SubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedSubscriptionsIDs(); len(nodes) > 0 && !uu.mutation.SubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SubscriptionsTable,
+			Columns: []string{user.SubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.SubscriptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SubscriptionsTable,
+			Columns: []string{user.SubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{user.Label}
@@ -88,11 +273,129 @@ type UserUpdateOne struct {
 	mutation *UserMutation
 }
 
+// SetUsername sets the "username" field.
+func (uuo *UserUpdateOne) SetUsername(s string) *UserUpdateOne {
+	uuo.mutation.SetUsername(s)
+	return uuo
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableUsername(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetUsername(*s)
+	}
+	return uuo
+}
+
+// SetEmail sets the "email" field.
+func (uuo *UserUpdateOne) SetEmail(s string) *UserUpdateOne {
+	uuo.mutation.SetEmail(s)
+	return uuo
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableEmail(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetEmail(*s)
+	}
+	return uuo
+}
+
+// SetWalletAddress sets the "wallet_address" field.
+func (uuo *UserUpdateOne) SetWalletAddress(s string) *UserUpdateOne {
+	uuo.mutation.SetWalletAddress(s)
+	return uuo
+}
+
+// SetNillableWalletAddress sets the "wallet_address" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableWalletAddress(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetWalletAddress(*s)
+	}
+	return uuo
+}
+
+// SetIpfsURL sets the "ipfs_url" field.
+func (uuo *UserUpdateOne) SetIpfsURL(s string) *UserUpdateOne {
+	uuo.mutation.SetIpfsURL(s)
+	return uuo
+}
+
+// SetNillableIpfsURL sets the "ipfs_url" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableIpfsURL(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetIpfsURL(*s)
+	}
+	return uuo
+}
+
+// ClearIpfsURL clears the value of the "ipfs_url" field.
+func (uuo *UserUpdateOne) ClearIpfsURL() *UserUpdateOne {
+	uuo.mutation.ClearIpfsURL()
+	return uuo
+}
+
+// SetOpenaiTokenID sets the "openai_token_id" field.
+func (uuo *UserUpdateOne) SetOpenaiTokenID(s string) *UserUpdateOne {
+	uuo.mutation.SetOpenaiTokenID(s)
+	return uuo
+}
+
+// SetNillableOpenaiTokenID sets the "openai_token_id" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableOpenaiTokenID(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetOpenaiTokenID(*s)
+	}
+	return uuo
+}
+
+// ClearOpenaiTokenID clears the value of the "openai_token_id" field.
+func (uuo *UserUpdateOne) ClearOpenaiTokenID() *UserUpdateOne {
+	uuo.mutation.ClearOpenaiTokenID()
+	return uuo
+}
+
+// AddSubscriptionIDs adds the "subscriptions" edge to the Subscription entity by IDs.
+func (uuo *UserUpdateOne) AddSubscriptionIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.AddSubscriptionIDs(ids...)
+	return uuo
+}
+
+// AddSubscriptions adds the "subscriptions" edges to the Subscription entity.
+func (uuo *UserUpdateOne) AddSubscriptions(s ...*Subscription) *UserUpdateOne {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uuo.AddSubscriptionIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uuo *UserUpdateOne) Mutation() *UserMutation {
 	return uuo.mutation
 }
 
+// ClearSubscriptions clears all "subscriptions" edges to the Subscription entity.
+func (uuo *UserUpdateOne) ClearSubscriptions() *UserUpdateOne {
+	uuo.mutation.ClearSubscriptions()
+	return uuo
+}
+
+// RemoveSubscriptionIDs removes the "subscriptions" edge to Subscription entities by IDs.
+func (uuo *UserUpdateOne) RemoveSubscriptionIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.RemoveSubscriptionIDs(ids...)
+	return uuo
+}
+
+// RemoveSubscriptions removes "subscriptions" edges to Subscription entities.
+func (uuo *UserUpdateOne) RemoveSubscriptions(s ...*Subscription) *UserUpdateOne {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uuo.RemoveSubscriptionIDs(ids...)
+}
+
 // Where appends a list predicates to the UserUpdate builder.
 func (uuo *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
 	uuo.mutation.Where(ps...)
@@ -134,7 +437,7 @@ func (uuo *UserUpdateOne) ExecX(ctx context.Context) {
 }
 
 func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
-	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt))
+	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeString))
 	id, ok := uuo.mutation.ID()
 	if !ok {
 		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "User.id" for update`)}
@@ -159,6 +462,72 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 			}
 		}
 	}
+	if value, ok := uuo.mutation.Username(); ok {
+		_spec.SetField(user.FieldUsername, field.TypeString, value)
+	}
+	if value, ok := uuo.mutation.Email(); ok {
+		_spec.SetField(user.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := uuo.mutation.WalletAddress(); ok {
+		_spec.SetField(user.FieldWalletAddress, field.TypeString, value)
+	}
+	if value, ok := uuo.mutation.IpfsURL(); ok {
+		_spec.SetField(user.FieldIpfsURL, field.TypeString, value)
+	}
+	if uuo.mutation.IpfsURLCleared() {
+		_spec.ClearField(user.FieldIpfsURL, field.TypeString)
+	}
+	if value, ok := uuo.mutation.OpenaiTokenID(); ok {
+		_spec.SetField(user.FieldOpenaiTokenID, field.TypeString, value)
+	}
+	if uuo.mutation.OpenaiTokenIDCleared() {
+		_spec.ClearField(user.FieldOpenaiTokenID, field.TypeString)
+	}
+	if uuo.mutation.SubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SubscriptionsTable,
+			Columns: []string{user.SubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedSubscriptionsIDs(); len(nodes) > 0 && !uuo.mutation.SubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SubscriptionsTable,
+			Columns: []string{user.SubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.SubscriptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SubscriptionsTable,
+			Columns: []string{user.SubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &User{config: uuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,54 @@
+// Package imagegen generates avatar images from text prompts through a
+// configurable provider.
+package imagegen
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+)
+
+// ImageGenerator turns a prompt into an image.
+type ImageGenerator interface {
+	// Name identifies the provider, e.g. in logs and job records.
+	Name() string
+	Generate(ctx context.Context, prompt string) (*Image, error)
+}
+
+type Image struct {
+	Data        []byte
+	ContentType string
+}
+
+// httpClient is shared by the HTTP providers; generation can take a while.
+var httpClient = &http.Client{Timeout: 3 * time.Minute}
+
+// FromEnv builds the generator named by IMAGE_GENERATOR: "imagepig",
+// "openai", "sdwebui" or "local". When unset, ImagePig is used if IMAGE_PIG
+// is configured. The local stub is only used when asked for by name, so a
+// deployment that forgot its API key fails at startup instead of handing
+// out placeholder art.
+func FromEnv() (ImageGenerator, error) {
+	provider := os.Getenv("IMAGE_GENERATOR")
+	if provider == "" {
+		if os.Getenv("IMAGE_PIG") == "" {
+			return nil, fmt.Errorf("no image generator configured: set IMAGE_PIG, or IMAGE_GENERATOR to imagepig, openai, sdwebui or local")
+		}
+		provider = "imagepig"
+	}
+
+	switch provider {
+	case "imagepig":
+		return NewImagePig(os.Getenv("IMAGE_PIG"))
+	case "openai":
+		return NewOpenAI(os.Getenv("OPENAI_BASE_URL"), os.Getenv("OPENAI_API_KEY"), os.Getenv("OPENAI_IMAGE_MODEL"), os.Getenv("OPENAI_IMAGE_SIZE"))
+	case "sdwebui":
+		return NewSDWebUI(os.Getenv("SD_WEBUI_URL")), nil
+	case "local":
+		return NewLocal(), nil
+	default:
+		return nil, fmt.Errorf("unknown IMAGE_GENERATOR %q", provider)
+	}
+}
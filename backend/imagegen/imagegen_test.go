@@ -0,0 +1,70 @@
+package imagegen
+
+import (
+	"bytes"
+	"context"
+	"image/jpeg"
+	"testing"
+)
+
+func TestFromEnv(t *testing.T) {
+	tests := []struct {
+		generator, imagePig string
+		// want is the provider's name, empty when FromEnv must fail.
+		want string
+	}{
+		{"", "", ""},
+		{"", "pig-key", "imagepig"},
+		{"local", "", "local"},
+		{"local", "pig-key", "local"},
+		{"imagepig", "", ""},
+		{"sdwebui", "", "sdwebui"},
+		{"dall-e", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Setenv("IMAGE_GENERATOR", tt.generator)
+		t.Setenv("IMAGE_PIG", tt.imagePig)
+
+		generator, err := FromEnv()
+		switch {
+		case tt.want == "" && err == nil:
+			t.Errorf("IMAGE_GENERATOR=%q IMAGE_PIG=%q: %s, want an error", tt.generator, tt.imagePig, generator.Name())
+		case tt.want != "" && err != nil:
+			t.Errorf("IMAGE_GENERATOR=%q IMAGE_PIG=%q: %v", tt.generator, tt.imagePig, err)
+		case tt.want != "" && generator.Name() != tt.want:
+			t.Errorf("IMAGE_GENERATOR=%q IMAGE_PIG=%q: %s, want %s", tt.generator, tt.imagePig, generator.Name(), tt.want)
+		}
+	}
+}
+
+func TestLocalDeterministic(t *testing.T) {
+	ctx := context.Background()
+	generate := func(prompt string) *Image {
+		t.Helper()
+		img, err := NewLocal().Generate(ctx, prompt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return img
+	}
+
+	first := generate("a fox in the snow")
+	if again := generate("a fox in the snow"); !bytes.Equal(first.Data, again.Data) {
+		t.Error("the same prompt drew two different images")
+	}
+	if other := generate("a fox in the rain"); bytes.Equal(first.Data, other.Data) {
+		t.Error("two prompts drew the same image")
+	}
+
+	if first.ContentType != "image/jpeg" {
+		t.Errorf("content type %s, want image/jpeg", first.ContentType)
+	}
+	config, err := jpeg.DecodeConfig(bytes.NewReader(first.Data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.Width != localImageSize || config.Height != localImageSize {
+		t.Errorf("%dx%d, want %dx%d", config.Width, config.Height, localImageSize, localImageSize)
+	}
+}
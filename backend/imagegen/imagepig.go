@@ -0,0 +1,75 @@
+package imagegen
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+const imagePigEndpoint = "https://api.imagepig.com/xl"
+
+// ImagePig uses the ImagePig XL API.
+type ImagePig struct {
+	apiKey   string
+	endpoint string
+}
+
+func NewImagePig(apiKey string) (*ImagePig, error) {
+	if apiKey == "" {
+		return nil, fmt.Errorf("IMAGE_PIG environment variable not set")
+	}
+	return &ImagePig{apiKey: apiKey, endpoint: imagePigEndpoint}, nil
+}
+
+func (p *ImagePig) Name() string {
+	return "imagepig"
+}
+
+type imagePigRequest struct {
+	Prompt string `json:"prompt"`
+}
+
+type imagePigResponse struct {
+	ImageData string `json:"image_data"`
+}
+
+func (p *ImagePig) Generate(ctx context.Context, prompt string) (*Image, error) {
+	jsonData, err := json.Marshal(imagePigRequest{Prompt: prompt})
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling request: %v", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", p.endpoint, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Api-Key", p.apiKey)
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("ImagePig API error: %s", string(body))
+	}
+
+	var imagePigResp imagePigResponse
+	if err := json.NewDecoder(resp.Body).Decode(&imagePigResp); err != nil {
+		return nil, fmt.Errorf("error decoding response: %v", err)
+	}
+
+	data, err := base64.StdEncoding.DecodeString(imagePigResp.ImageData)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding base64 image: %v", err)
+	}
+
+	return &Image{Data: data, ContentType: "image/jpeg"}, nil
+}
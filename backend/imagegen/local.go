@@ -0,0 +1,82 @@
+package imagegen
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"math"
+	"math/rand"
+)
+
+const localImageSize = 512
+
+// Local draws a procedural image seeded from the prompt, so the same prompt
+// always yields the same picture. It needs no network or API key and is
+// meant for development and tests.
+type Local struct {
+	size int
+}
+
+func NewLocal() *Local {
+	return &Local{size: localImageSize}
+}
+
+func (l *Local) Name() string {
+	return "local"
+}
+
+func (l *Local) Generate(ctx context.Context, prompt string) (*Image, error) {
+	sum := sha256.Sum256([]byte(prompt))
+	rng := rand.New(rand.NewSource(int64(binary.BigEndian.Uint64(sum[:8]))))
+
+	top := randomColor(rng)
+	bottom := randomColor(rng)
+
+	type blob struct {
+		x, y, r float64
+		c       color.RGBA
+	}
+	blobs := make([]blob, 4+rng.Intn(5))
+	for i := range blobs {
+		blobs[i] = blob{
+			x: rng.Float64() * float64(l.size),
+			y: rng.Float64() * float64(l.size),
+			r: float64(l.size) * (0.08 + rng.Float64()*0.25),
+			c: randomColor(rng),
+		}
+	}
+
+	img := image.NewRGBA(image.Rect(0, 0, l.size, l.size))
+	for y := 0; y < l.size; y++ {
+		t := float64(y) / float64(l.size-1)
+		for x := 0; x < l.size; x++ {
+			c := mix(top, bottom, t)
+			for _, b := range blobs {
+				d := math.Hypot(float64(x)-b.x, float64(y)-b.y)
+				if d < b.r {
+					c = mix(c, b.c, 0.7*(1-d/b.r))
+				}
+			}
+			img.SetRGBA(x, y, c)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: 90}); err != nil {
+		return nil, err
+	}
+	return &Image{Data: buf.Bytes(), ContentType: "image/jpeg"}, nil
+}
+
+func randomColor(rng *rand.Rand) color.RGBA {
+	return color.RGBA{R: uint8(rng.Intn(256)), G: uint8(rng.Intn(256)), B: uint8(rng.Intn(256)), A: 255}
+}
+
+func mix(a, b color.RGBA, t float64) color.RGBA {
+	lerp := func(x, y uint8) uint8 { return uint8(float64(x) + (float64(y)-float64(x))*t) }
+	return color.RGBA{R: lerp(a.R, b.R), G: lerp(a.G, b.G), B: lerp(a.B, b.B), A: 255}
+}
@@ -0,0 +1,109 @@
+package imagegen
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+const (
+	defaultOpenAIBaseURL = "https://api.openai.com"
+	defaultOpenAIModel   = "dall-e-3"
+	defaultOpenAISize    = "1024x1024"
+)
+
+// OpenAI uses an OpenAI-compatible /v1/images/generations endpoint, which
+// covers OpenAI itself and most self-hosted gateways.
+type OpenAI struct {
+	baseURL string
+	apiKey  string
+	model   string
+	size    string
+}
+
+func NewOpenAI(baseURL, apiKey, model, size string) (*OpenAI, error) {
+	if baseURL == "" {
+		baseURL = defaultOpenAIBaseURL
+	}
+	if apiKey == "" && baseURL == defaultOpenAIBaseURL {
+		return nil, fmt.Errorf("OPENAI_API_KEY environment variable not set")
+	}
+	if model == "" {
+		model = defaultOpenAIModel
+	}
+	if size == "" {
+		size = defaultOpenAISize
+	}
+	return &OpenAI{baseURL: strings.TrimRight(baseURL, "/"), apiKey: apiKey, model: model, size: size}, nil
+}
+
+func (o *OpenAI) Name() string {
+	return "openai"
+}
+
+type openAIRequest struct {
+	Model          string `json:"model"`
+	Prompt         string `json:"prompt"`
+	N              int    `json:"n"`
+	Size           string `json:"size"`
+	ResponseFormat string `json:"response_format"`
+}
+
+type openAIResponse struct {
+	Data []struct {
+		B64JSON string `json:"b64_json"`
+	} `json:"data"`
+}
+
+func (o *OpenAI) Generate(ctx context.Context, prompt string) (*Image, error) {
+	jsonData, err := json.Marshal(openAIRequest{
+		Model:          o.model,
+		Prompt:         prompt,
+		N:              1,
+		Size:           o.size,
+		ResponseFormat: "b64_json",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling request: %v", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", o.baseURL+"/v1/images/generations", bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if o.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+o.apiKey)
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("image API error: %s", string(body))
+	}
+
+	var openAIResp openAIResponse
+	if err := json.NewDecoder(resp.Body).Decode(&openAIResp); err != nil {
+		return nil, fmt.Errorf("error decoding response: %v", err)
+	}
+	if len(openAIResp.Data) == 0 || openAIResp.Data[0].B64JSON == "" {
+		return nil, fmt.Errorf("image API returned no image")
+	}
+
+	data, err := base64.StdEncoding.DecodeString(openAIResp.Data[0].B64JSON)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding base64 image: %v", err)
+	}
+
+	return &Image{Data: data, ContentType: "image/png"}, nil
+}
@@ -0,0 +1,84 @@
+package imagegen
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+const defaultSDWebUIURL = "http://127.0.0.1:7860"
+
+// SDWebUI uses the txt2img API of a Stable Diffusion WebUI (AUTOMATIC1111
+// or compatible) server started with --api.
+type SDWebUI struct {
+	baseURL string
+	steps   int
+	width   int
+	height  int
+}
+
+func NewSDWebUI(baseURL string) *SDWebUI {
+	if baseURL == "" {
+		baseURL = defaultSDWebUIURL
+	}
+	return &SDWebUI{baseURL: strings.TrimRight(baseURL, "/"), steps: 25, width: 512, height: 512}
+}
+
+func (s *SDWebUI) Name() string {
+	return "sdwebui"
+}
+
+type sdWebUIRequest struct {
+	Prompt string `json:"prompt"`
+	Steps  int    `json:"steps"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+}
+
+type sdWebUIResponse struct {
+	Images []string `json:"images"`
+}
+
+func (s *SDWebUI) Generate(ctx context.Context, prompt string) (*Image, error) {
+	jsonData, err := json.Marshal(sdWebUIRequest{Prompt: prompt, Steps: s.steps, Width: s.width, Height: s.height})
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling request: %v", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", s.baseURL+"/sdapi/v1/txt2img", bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("Stable Diffusion WebUI error: %s", string(body))
+	}
+
+	var sdResp sdWebUIResponse
+	if err := json.NewDecoder(resp.Body).Decode(&sdResp); err != nil {
+		return nil, fmt.Errorf("error decoding response: %v", err)
+	}
+	if len(sdResp.Images) == 0 {
+		return nil, fmt.Errorf("Stable Diffusion WebUI returned no image")
+	}
+
+	data, err := base64.StdEncoding.DecodeString(sdResp.Images[0])
+	if err != nil {
+		return nil, fmt.Errorf("error decoding base64 image: %v", err)
+	}
+
+	return &Image{Data: data, ContentType: "image/png"}, nil
+}
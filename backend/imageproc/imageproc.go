@@ -0,0 +1,257 @@
+// Package imageproc validates uploaded and generated images and turns them
+// into the renditions the platform serves. Every rendition is re-encoded
+// from decoded pixels, so EXIF and other embedded metadata never survive.
+package imageproc
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/HugoSmits86/nativewebp"
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+// Rendition names. Icon becomes Model.Icon, card Model.Image and full is
+// what NFT metadata points at.
+const (
+	Icon = "icon"
+	Card = "card"
+	Full = "full"
+)
+
+const (
+	FitMode  = "fit"
+	CropMode = "crop"
+
+	defaultMaxPixels = 40_000_000
+	defaultMinSide   = 64
+	defaultMaxSide   = 8192
+	defaultCells     = 24
+	jpegQuality      = 88
+)
+
+var ErrInvalidImage = errors.New("invalid image")
+
+// Spec describes one rendition: the box it must fit in, whether it is
+// center-cropped to fill that box, and its output format.
+type Spec struct {
+	Name   string
+	Width  int
+	Height int
+	Mode   string
+	Format string
+}
+
+var defaultSpecs = []Spec{
+	{Name: Icon, Width: 256, Height: 256, Mode: CropMode, Format: "jpeg"},
+	{Name: Card, Width: 640, Height: 640, Mode: FitMode, Format: "jpeg"},
+	{Name: Full, Width: 1536, Height: 1536, Mode: FitMode, Format: "jpeg"},
+}
+
+// Pipeline validates images and renders them to the configured specs.
+type Pipeline struct {
+	Specs []Spec
+	// MaxPixels bounds width*height before anything is decoded, so a small
+	// file cannot claim gigabytes of pixels.
+	MaxPixels int
+	MinSide   int
+	MaxSide   int
+	// PreviewCells is the number of cells across the longer side of a
+	// preview; fewer cells blur more.
+	PreviewCells int
+}
+
+// Output is one rendered image.
+type Output struct {
+	Name        string
+	Data        []byte
+	ContentType string
+	Width       int
+	Height      int
+}
+
+// FromEnv builds the pipeline from IMAGE_RENDITION_ICON, _CARD and _FULL
+// ("WIDTHxHEIGHT:mode:format", e.g. "256x256:crop:webp") and the
+// IMAGE_MAX_PIXELS, IMAGE_MIN_SIDE and IMAGE_MAX_SIDE limits. Previews
+// use IMAGE_PREVIEW_CELLS.
+func FromEnv() (*Pipeline, error) {
+	p := &Pipeline{
+		MaxPixels:    defaultMaxPixels,
+		MinSide:      defaultMinSide,
+		MaxSide:      defaultMaxSide,
+		PreviewCells: defaultCells,
+	}
+
+	for _, spec := range defaultSpecs {
+		env := "IMAGE_RENDITION_" + strings.ToUpper(spec.Name)
+		if v := os.Getenv(env); v != "" {
+			parsed, err := parseSpec(spec.Name, v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s: %v", env, err)
+			}
+			spec = parsed
+		}
+		p.Specs = append(p.Specs, spec)
+	}
+
+	for env, limit := range map[string]*int{
+		"IMAGE_MAX_PIXELS":    &p.MaxPixels,
+		"IMAGE_MIN_SIDE":      &p.MinSide,
+		"IMAGE_MAX_SIDE":      &p.MaxSide,
+		"IMAGE_PREVIEW_CELLS": &p.PreviewCells,
+	} {
+		if v := os.Getenv(env); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil || n <= 0 {
+				return nil, fmt.Errorf("invalid %s %q", env, v)
+			}
+			*limit = n
+		}
+	}
+	return p, nil
+}
+
+func parseSpec(name, v string) (Spec, error) {
+	parts := strings.Split(v, ":")
+	if len(parts) != 3 {
+		return Spec{}, fmt.Errorf("%q is not WIDTHxHEIGHT:mode:format", v)
+	}
+
+	var width, height int
+	if _, err := fmt.Sscanf(parts[0], "%dx%d", &width, &height); err != nil || width <= 0 || height <= 0 {
+		return Spec{}, fmt.Errorf("invalid size %q", parts[0])
+	}
+	if parts[1] != FitMode && parts[1] != CropMode {
+		return Spec{}, fmt.Errorf("mode must be fit or crop, not %q", parts[1])
+	}
+	if _, ok := contentTypes[parts[2]]; !ok {
+		return Spec{}, fmt.Errorf("format must be jpeg, png or webp, not %q", parts[2])
+	}
+	return Spec{Name: name, Width: width, Height: height, Mode: parts[1], Format: parts[2]}, nil
+}
+
+var contentTypes = map[string]string{
+	"jpeg": "image/jpeg",
+	"png":  "image/png",
+	"webp": "image/webp",
+}
+
+// Validate checks the image's format and dimensions from its header alone.
+func (p *Pipeline) Validate(data []byte) (image.Config, error) {
+	config, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return config, fmt.Errorf("%w: unsupported or corrupt image: %v", ErrInvalidImage, err)
+	}
+	if _, ok := contentTypes[format]; !ok {
+		return config, fmt.Errorf("%w: %s images are not accepted", ErrInvalidImage, format)
+	}
+
+	switch {
+	case config.Width < p.MinSide || config.Height < p.MinSide:
+		return config, fmt.Errorf("%w: %dx%d is smaller than %dpx", ErrInvalidImage, config.Width, config.Height, p.MinSide)
+	case config.Width > p.MaxSide || config.Height > p.MaxSide:
+		return config, fmt.Errorf("%w: %dx%d is larger than %dpx", ErrInvalidImage, config.Width, config.Height, p.MaxSide)
+	case config.Width*config.Height > p.MaxPixels:
+		return config, fmt.Errorf("%w: %dx%d exceeds %d pixels", ErrInvalidImage, config.Width, config.Height, p.MaxPixels)
+	}
+	return config, nil
+}
+
+// Process validates data and renders every configured rendition, upright
+// according to its EXIF orientation.
+func (p *Pipeline) Process(data []byte) ([]Output, error) {
+	if _, err := p.Validate(data); err != nil {
+		return nil, err
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidImage, err)
+	}
+	src = orient(src, exifOrientation(data))
+
+	outputs := make([]Output, 0, len(p.Specs))
+	for _, spec := range p.Specs {
+		rendered := render(src, spec)
+		encoded, err := encode(rendered, spec.Format)
+		if err != nil {
+			return nil, fmt.Errorf("error encoding %s rendition: %v", spec.Name, err)
+		}
+		outputs = append(outputs, Output{
+			Name:        spec.Name,
+			Data:        encoded,
+			ContentType: contentTypes[spec.Format],
+			Width:       rendered.Bounds().Dx(),
+			Height:      rendered.Bounds().Dy(),
+		})
+	}
+	return outputs, nil
+}
+
+// render scales src into the spec's box without enlarging it. Crop mode
+// first cuts the largest centered region with the box's aspect ratio.
+func render(src image.Image, spec Spec) image.Image {
+	bounds := src.Bounds()
+	w, h := bounds.Dx(), bounds.Dy()
+
+	if spec.Mode == CropMode {
+		if w*spec.Height > h*spec.Width {
+			cw := h * spec.Width / spec.Height
+			bounds.Min.X += (w - cw) / 2
+			bounds.Max.X = bounds.Min.X + cw
+		} else {
+			ch := w * spec.Height / spec.Width
+			bounds.Min.Y += (h - ch) / 2
+			bounds.Max.Y = bounds.Min.Y + ch
+		}
+		w, h = bounds.Dx(), bounds.Dy()
+	}
+
+	scale := 1.0
+	if sx := float64(spec.Width) / float64(w); sx < scale {
+		scale = sx
+	}
+	if sy := float64(spec.Height) / float64(h); sy < scale {
+		scale = sy
+	}
+	dw, dh := int(float64(w)*scale+0.5), int(float64(h)*scale+0.5)
+	if dw < 1 {
+		dw = 1
+	}
+	if dh < 1 {
+		dh = 1
+	}
+
+	dst := image.NewNRGBA(image.Rect(0, 0, dw, dh))
+	if spec.Format == "jpeg" {
+		// JPEG has no alpha channel; flatten transparency onto white.
+		draw.Draw(dst, dst.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+		draw.CatmullRom.Scale(dst, dst.Bounds(), src, bounds, draw.Over, nil)
+	} else {
+		draw.CatmullRom.Scale(dst, dst.Bounds(), src, bounds, draw.Src, nil)
+	}
+	return dst
+}
+
+func encode(img image.Image, format string) ([]byte, error) {
+	var buf bytes.Buffer
+	var err error
+	switch format {
+	case "png":
+		err = png.Encode(&buf, img)
+	case "webp":
+		err = nativewebp.Encode(&buf, img, nil)
+	default:
+		err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: jpegQuality})
+	}
+	return buf.Bytes(), err
+}
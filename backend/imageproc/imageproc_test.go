@@ -0,0 +1,245 @@
+package imageproc
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+)
+
+var (
+	red  = color.NRGBA{R: 255, A: 255}
+	blue = color.NRGBA{B: 255, A: 255}
+)
+
+// halves returns a w x h image whose left half is red and right half blue.
+func halves(w, h int) image.Image {
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			if x < w/2 {
+				img.Set(x, y, red)
+			} else {
+				img.Set(x, y, blue)
+			}
+		}
+	}
+	return img
+}
+
+func encodeJPEG(t testing.TB, img image.Image) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: 95}); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func encodePNG(t testing.TB, img image.Image) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// withAPP1 inserts an APP1 segment holding payload right after the JPEG's
+// SOI marker, where cameras put EXIF.
+func withAPP1(jpg, payload []byte) []byte {
+	n := len(payload) + 2
+	out := append([]byte{}, jpg[:2]...)
+	out = append(out, 0xFF, 0xE1, byte(n>>8), byte(n))
+	out = append(out, payload...)
+	return append(out, jpg[2:]...)
+}
+
+// exif returns an APP1 payload with a big-endian TIFF header, an
+// Orientation tag and a Make tag naming the camera.
+func exif(orientation uint16, camera string) []byte {
+	payload := []byte("Exif\x00\x00")
+	payload = append(payload, 'M', 'M', 0, 42, 0, 0, 0, 8)
+	payload = append(payload, 0, 2)
+	// Orientation, SHORT, count 1, value.
+	payload = append(payload, 0x01, 0x12, 0, 3, 0, 0, 0, 1, byte(orientation>>8), byte(orientation), 0, 0)
+	// Make, ASCII, count, offset of the string after the IFD.
+	offset := 8 + 2 + 2*12 + 4
+	payload = append(payload, 0x01, 0x0F, 0, 2, 0, 0, 0, byte(len(camera)+1), 0, 0, 0, byte(offset))
+	payload = append(payload, 0, 0, 0, 0)
+	payload = append(payload, camera...)
+	return append(payload, 0)
+}
+
+// near reports whether c is within a JPEG's error of want.
+func near(c color.Color, want color.NRGBA) bool {
+	r, g, b, _ := c.RGBA()
+	diff := func(got uint32, want uint8) bool {
+		d := int(got>>8) - int(want)
+		return d > -48 && d < 48
+	}
+	return diff(r, want.R) && diff(g, want.G) && diff(b, want.B)
+}
+
+func testPipeline(specs ...Spec) *Pipeline {
+	return &Pipeline{Specs: specs, MaxPixels: defaultMaxPixels, MinSide: defaultMinSide, MaxSide: defaultMaxSide, PreviewCells: defaultCells}
+}
+
+func TestProcessOrientation(t *testing.T) {
+	// Stored landscape with red on the left; orientation 6 means the camera
+	// was turned clockwise, so upright the red half is on top.
+	data := withAPP1(encodeJPEG(t, halves(200, 100)), exif(6, "SecretCam 3000"))
+	if got := exifOrientation(data); got != 6 {
+		t.Fatalf("exifOrientation = %d, want 6", got)
+	}
+
+	for _, format := range []string{"jpeg", "png", "webp"} {
+		outputs, err := testPipeline(Spec{Name: Full, Width: 1000, Height: 1000, Mode: FitMode, Format: format}).Process(data)
+		if err != nil {
+			t.Fatalf("%s: Process: %v", format, err)
+		}
+		out := outputs[0]
+		if out.Width != 100 || out.Height != 200 {
+			t.Fatalf("%s: %dx%d, want 100x200", format, out.Width, out.Height)
+		}
+		img, _, err := image.Decode(bytes.NewReader(out.Data))
+		if err != nil {
+			t.Fatalf("%s: decoding the rendition: %v", format, err)
+		}
+		if b := img.Bounds(); b.Dx() != 100 || b.Dy() != 200 {
+			t.Fatalf("%s: decoded %v, want 100x200", format, b)
+		}
+		if top, bottom := img.At(50, 20), img.At(50, 180); !near(top, red) || !near(bottom, blue) {
+			t.Errorf("%s: top %v, bottom %v, want red over blue", format, top, bottom)
+		}
+	}
+}
+
+func TestOrient(t *testing.T) {
+	// A 2x1 image, red then blue, and where each orientation puts the red
+	// pixel once upright.
+	src := halves(2, 1)
+	tests := []struct {
+		orientation int
+		w, h        int
+		red         image.Point
+	}{
+		{1, 2, 1, image.Pt(0, 0)},
+		{2, 2, 1, image.Pt(1, 0)},
+		{3, 2, 1, image.Pt(1, 0)},
+		{4, 2, 1, image.Pt(0, 0)},
+		{5, 1, 2, image.Pt(0, 0)},
+		{6, 1, 2, image.Pt(0, 0)},
+		{7, 1, 2, image.Pt(0, 1)},
+		{8, 1, 2, image.Pt(0, 1)},
+		{9, 2, 1, image.Pt(0, 0)},
+	}
+	for _, tt := range tests {
+		got := orient(src, tt.orientation)
+		if b := got.Bounds(); b.Dx() != tt.w || b.Dy() != tt.h {
+			t.Errorf("orientation %d: %v, want %dx%d", tt.orientation, b, tt.w, tt.h)
+			continue
+		}
+		if c := got.At(tt.red.X, tt.red.Y); !near(c, red) {
+			t.Errorf("orientation %d: %v at %v, want red", tt.orientation, c, tt.red)
+		}
+	}
+}
+
+func TestProcessStripsEXIF(t *testing.T) {
+	data := withAPP1(encodeJPEG(t, halves(200, 100)), exif(1, "SecretCam 3000"))
+	outputs, err := testPipeline(defaultSpecs...).Process(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	preview, err := testPipeline(defaultSpecs...).Preview(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, out := range append(outputs, *preview) {
+		if bytes.Contains(out.Data, []byte("Exif\x00\x00")) || bytes.Contains(out.Data, []byte("SecretCam")) {
+			t.Errorf("%s rendition kept the EXIF segment", out.Name)
+		}
+		for i := 2; i+4 <= len(out.Data) && out.Data[i] == 0xFF && out.Data[i+1] != 0xDA; i += 2 + int(out.Data[i+2])<<8 | int(out.Data[i+3]) {
+			if out.Data[i+1] == 0xE1 {
+				t.Errorf("%s rendition has an APP1 segment", out.Name)
+			}
+		}
+	}
+}
+
+func TestRenderSizes(t *testing.T) {
+	landscape := halves(400, 200)
+	tests := []struct {
+		name string
+		src  image.Image
+		spec Spec
+		w, h int
+	}{
+		{"crop to a square", landscape, Spec{Width: 100, Height: 100, Mode: CropMode}, 100, 100},
+		{"crop to a wider box", landscape, Spec{Width: 300, Height: 100, Mode: CropMode}, 300, 100},
+		{"crop to a taller box", landscape, Spec{Width: 100, Height: 300, Mode: CropMode}, 66, 200},
+		{"crop without enlarging", landscape, Spec{Width: 1000, Height: 1000, Mode: CropMode}, 200, 200},
+		{"fit a square", landscape, Spec{Width: 100, Height: 100, Mode: FitMode}, 100, 50},
+		{"fit a tall box", landscape, Spec{Width: 100, Height: 1000, Mode: FitMode}, 100, 50},
+		{"fit without enlarging", landscape, Spec{Width: 1000, Height: 1000, Mode: FitMode}, 400, 200},
+		{"fit a portrait", halves(200, 400), Spec{Width: 640, Height: 100, Mode: FitMode}, 50, 100},
+		{"fit a sliver", halves(8000, 64), Spec{Width: 256, Height: 256, Mode: FitMode}, 256, 2},
+	}
+	for _, tt := range tests {
+		got := render(tt.src, tt.spec).Bounds()
+		if got.Dx() != tt.w || got.Dy() != tt.h {
+			t.Errorf("%s: %dx%d, want %dx%d", tt.name, got.Dx(), got.Dy(), tt.w, tt.h)
+		}
+	}
+
+	// The center is kept when cropping.
+	cropped := render(halves(400, 100), Spec{Width: 100, Height: 100, Mode: CropMode, Format: "png"})
+	if left, right := cropped.At(10, 50), cropped.At(90, 50); !near(left, red) || !near(right, blue) {
+		t.Errorf("center crop: left %v, right %v, want red and blue", left, right)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	p := &Pipeline{MaxPixels: 100 * 150, MinSide: 64, MaxSide: 200}
+
+	tests := []struct {
+		name  string
+		data  []byte
+		valid bool
+	}{
+		{"within the limits", encodePNG(t, halves(100, 100)), true},
+		{"smallest side", encodeJPEG(t, halves(64, 64)), true},
+		{"narrower than MinSide", encodePNG(t, halves(63, 100)), false},
+		{"shorter than MinSide", encodeJPEG(t, halves(100, 10)), false},
+		{"wider than MaxSide", encodePNG(t, halves(201, 64)), false},
+		{"taller than MaxSide", encodePNG(t, halves(64, 201)), false},
+		{"more than MaxPixels", encodePNG(t, halves(150, 101)), false},
+		{"exactly MaxPixels", encodePNG(t, halves(150, 100)), true},
+		{"GIF", []byte("GIF89a\x40\x00\x40\x00\x00\x00\x00;"), false},
+		{"not an image", []byte("hello"), false},
+		{"truncated JPEG", encodeJPEG(t, halves(100, 100))[:20], false},
+	}
+	for _, tt := range tests {
+		_, err := p.Validate(tt.data)
+		switch {
+		case tt.valid && err != nil:
+			t.Errorf("%s: %v", tt.name, err)
+		case !tt.valid && !errors.Is(err, ErrInvalidImage):
+			t.Errorf("%s: error %v, want ErrInvalidImage", tt.name, err)
+		}
+	}
+
+	// Process and Preview reject the same images.
+	tooBig := encodePNG(t, halves(150, 101))
+	if _, err := p.Process(tooBig); !errors.Is(err, ErrInvalidImage) {
+		t.Errorf("Process: error %v, want ErrInvalidImage", err)
+	}
+	if _, err := p.Preview(tooBig); !errors.Is(err, ErrInvalidImage) {
+		t.Errorf("Preview: error %v, want ErrInvalidImage", err)
+	}
+}
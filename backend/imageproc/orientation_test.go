@@ -0,0 +1,44 @@
+package imageproc
+
+import (
+	"image"
+	"testing"
+)
+
+func FuzzEXIF(f *testing.F) {
+	f.Add(exif(6, "SecretCam 3000"))
+	f.Add([]byte("Exif\x00\x00"))
+	f.Add([]byte("Exif\x00\x00II*\x00"))
+	// The IFD offset points past the end.
+	f.Add([]byte("Exif\x00\x00MM\x00\x2a\xff\xff\xff\xff"))
+	// The IFD claims more entries than there are.
+	f.Add([]byte("Exif\x00\x00II*\x00\x08\x00\x00\x00\xff\xff\x12\x01"))
+	f.Add([]byte("Exif\x00\x00MM\x00\x2a\x00\x00\x00\x08\x00\x01\x01\x12\x00\x03\x00\x00\x00\x01\x00\x63\x00\x00"))
+
+	jpg := encodeJPEG(f, halves(8, 4))
+	p := &Pipeline{Specs: []Spec{{Name: Icon, Width: 4, Height: 4, Mode: CropMode, Format: "jpeg"}}, MaxPixels: 1 << 20, MinSide: 1, MaxSide: 64}
+
+	f.Fuzz(func(t *testing.T, payload []byte) {
+		if len(payload) > 0xFFFF-2 {
+			t.Skip()
+		}
+		data := withAPP1(jpg, payload)
+
+		orientation := exifOrientation(data)
+		if orientation < 1 || orientation > 8 {
+			t.Fatalf("exifOrientation = %d", orientation)
+		}
+		// A segment whose length runs past the end must not be read either.
+		exifOrientation(data[:len(jpg)/2])
+
+		outputs, err := p.Process(data)
+		if err != nil {
+			// The decoder may reject what the APP1 segment did to the file.
+			return
+		}
+		want := image.Pt(4, 4)
+		if got := image.Pt(outputs[0].Width, outputs[0].Height); got != want {
+			t.Fatalf("icon %v, want %v", got, want)
+		}
+	})
+}
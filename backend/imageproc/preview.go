@@ -0,0 +1,57 @@
+package imageproc
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+
+	"golang.org/x/image/draw"
+)
+
+// Preview is the rendition served to viewers without access to the
+// original.
+const Preview = "preview"
+
+// Preview renders a blurred JPEG of data at the card rendition's size. The
+// image is shrunk to PreviewCells across and scaled back up, which keeps
+// colours and composition but none of the detail.
+func (p *Pipeline) Preview(data []byte) (*Output, error) {
+	if _, err := p.Validate(data); err != nil {
+		return nil, err
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidImage, err)
+	}
+	src = orient(src, exifOrientation(data))
+
+	box := Spec{Name: Preview, Width: 640, Height: 640, Mode: FitMode, Format: "jpeg"}
+	for _, spec := range p.Specs {
+		if spec.Name == Card {
+			box.Width, box.Height = spec.Width, spec.Height
+		}
+	}
+	sized := render(src, box)
+
+	cells := p.PreviewCells
+	if cells <= 0 {
+		cells = defaultCells
+	}
+	small := render(sized, Spec{Width: cells, Height: cells, Mode: FitMode, Format: "jpeg"})
+
+	blurred := image.NewNRGBA(sized.Bounds())
+	draw.BiLinear.Scale(blurred, blurred.Bounds(), small, small.Bounds(), draw.Src, nil)
+
+	encoded, err := encode(blurred, "jpeg")
+	if err != nil {
+		return nil, fmt.Errorf("error encoding preview: %v", err)
+	}
+	return &Output{
+		Name:        Preview,
+		Data:        encoded,
+		ContentType: contentTypes["jpeg"],
+		Width:       blurred.Bounds().Dx(),
+		Height:      blurred.Bounds().Dy(),
+	}, nil
+}
@@ -0,0 +1,79 @@
+package indexer
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"arjunmal1311/fans_flow_on_chain/backend/db"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const cursorsCollection = "indexer_cursors"
+
+// Cursors keeps the last block the indexer processed on each chain.
+type Cursors interface {
+	// Load returns the last block processed on chainName and whether there
+	// is one.
+	Load(ctx context.Context, chainName string) (uint64, bool, error)
+	Save(ctx context.Context, chainName string, block uint64) error
+}
+
+type cursor struct {
+	Chain     string    `bson:"_id"`
+	LastBlock uint64    `bson:"last_block"`
+	UpdatedAt time.Time `bson:"updated_at"`
+}
+
+// mongoCursors keeps the cursors in "indexer_cursors", one document per
+// chain.
+type mongoCursors struct{}
+
+func (mongoCursors) Load(ctx context.Context, chainName string) (uint64, bool, error) {
+	var c cursor
+	err := db.GetCollection(cursorsCollection).FindOne(ctx, bson.M{"_id": chainName}).Decode(&c)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return 0, false, nil
+		}
+		return 0, false, err
+	}
+	return c.LastBlock, true, nil
+}
+
+func (mongoCursors) Save(ctx context.Context, chainName string, block uint64) error {
+	_, err := db.GetCollection(cursorsCollection).UpdateOne(
+		ctx,
+		bson.M{"_id": chainName},
+		bson.M{"$set": bson.M{"last_block": block, "updated_at": time.Now()}},
+		options.Update().SetUpsert(true),
+	)
+	return err
+}
+
+// NewMemoryCursors returns Cursors held in memory.
+func NewMemoryCursors() Cursors {
+	return &memoryCursors{last: map[string]uint64{}}
+}
+
+type memoryCursors struct {
+	mu   sync.Mutex
+	last map[string]uint64
+}
+
+func (c *memoryCursors) Load(ctx context.Context, chainName string) (uint64, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	block, ok := c.last[chainName]
+	return block, ok, nil
+}
+
+func (c *memoryCursors) Save(ctx context.Context, chainName string, block uint64) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.last[chainName] = block
+	return nil
+}
@@ -0,0 +1,282 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"arjunmal1311/fans_flow_on_chain/backend/chain"
+	"arjunmal1311/fans_flow_on_chain/backend/models"
+	"arjunmal1311/fans_flow_on_chain/backend/storage"
+	"arjunmal1311/fans_flow_on_chain/backend/tokenid"
+)
+
+const (
+	defaultBatchSize    = 2000
+	defaultPollInterval = 15 * time.Second
+)
+
+// Indexer tails MarketPlace events on one network and applies them to that
+// network's subscriptions.
+type Indexer struct {
+	network      chain.Network
+	client       *chain.Client
+	repos        storage.Repos
+	BatchSize    uint64
+	PollInterval time.Duration
+	// Cursors defaults to the "indexer_cursors" collection in MongoDB.
+	Cursors Cursors
+}
+
+func New(network chain.Network, repos storage.Repos) *Indexer {
+	return &Indexer{
+		network:      network,
+		client:       chain.NewClient(network.RPCURL),
+		repos:        repos,
+		BatchSize:    defaultBatchSize,
+		PollInterval: defaultPollInterval,
+		Cursors:      mongoCursors{},
+	}
+}
+
+func (i *Indexer) Run(ctx context.Context) {
+	log.Printf("Indexer[%s]: watching marketplace %s", i.network.Name, i.network.Marketplace)
+
+	ticker := time.NewTicker(i.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := i.Sync(ctx); err != nil {
+			log.Printf("Indexer[%s]: sync failed: %v", i.network.Name, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Sync processes every confirmed block after the stored cursor, committing
+// the cursor after each batch so a restart resumes where it left off.
+func (i *Indexer) Sync(ctx context.Context) error {
+	head, err := i.client.BlockNumber(ctx)
+	if err != nil {
+		return err
+	}
+	if head < i.network.Confirmations {
+		return nil
+	}
+	safeHead := head - i.network.Confirmations
+
+	from, err := i.nextBlock(ctx)
+	if err != nil {
+		return err
+	}
+
+	for from <= safeHead {
+		to := from + i.BatchSize - 1
+		if to > safeHead {
+			to = safeHead
+		}
+
+		logs, err := i.client.GetLogs(ctx, chain.LogFilter{
+			FromBlock: from,
+			ToBlock:   to,
+			Address:   i.network.Marketplace,
+			Topics: []string{
+				chain.SubscriptionPurchasedTopic,
+				chain.NFTListedTopic,
+				chain.NFTSoldTopic,
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, l := range logs {
+			if l.Removed {
+				continue
+			}
+			if err := i.apply(ctx, l); err != nil {
+				return fmt.Errorf("error applying log %s:%d: %v", l.TxHash, l.LogIndex, err)
+			}
+		}
+
+		if err := i.Cursors.Save(ctx, i.network.Name, to); err != nil {
+			return err
+		}
+		from = to + 1
+	}
+
+	return nil
+}
+
+func (i *Indexer) nextBlock(ctx context.Context) (uint64, error) {
+	last, found, err := i.Cursors.Load(ctx, i.network.Name)
+	if err != nil {
+		return 0, err
+	}
+	if !found {
+		return i.network.StartBlock, nil
+	}
+	return last + 1, nil
+}
+
+func (i *Indexer) apply(ctx context.Context, l chain.Log) error {
+	if len(l.Topics) == 0 {
+		return nil
+	}
+
+	switch strings.ToLower(l.Topics[0]) {
+	case chain.SubscriptionPurchasedTopic:
+		ev, err := chain.DecodeSubscriptionPurchased(l)
+		if err != nil {
+			return err
+		}
+		return i.applyPurchase(ctx, ev)
+	case chain.NFTListedTopic:
+		ev, err := chain.DecodeNFTListed(l)
+		if err != nil {
+			return err
+		}
+		return i.applyListing(ctx, ev)
+	case chain.NFTSoldTopic:
+		ev, err := chain.DecodeNFTSold(l)
+		if err != nil {
+			return err
+		}
+		return i.applySale(ctx, ev)
+	}
+
+	return nil
+}
+
+func (i *Indexer) applyPurchase(ctx context.Context, ev *chain.SubscriptionPurchased) error {
+	if tokenID, err := tokenid.EncodeTokenID(ev.ModelID, ev.SubscriptionID); err != nil || tokenID.Cmp(ev.TokenID) != 0 {
+		log.Printf("Indexer[%s]: skipping purchase of token %s that does not encode model %s/subscription %s", i.network.Name, ev.TokenID, ev.ModelID, ev.SubscriptionID)
+		return nil
+	}
+
+	user, err := i.findUser(ctx, ev.Buyer)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		log.Printf("Indexer[%s]: skipping purchase of token %s by unknown wallet %s", i.network.Name, ev.TokenID, ev.Buyer)
+		return nil
+	}
+
+	model, err := i.repos.Models.FindByModelID(ctx, ev.ModelID.String())
+	if err == storage.ErrNotFound {
+		log.Printf("Indexer[%s]: skipping purchase of token %s for unknown model %s", i.network.Name, ev.TokenID, ev.ModelID)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	startsAt, expiresAt, err := chain.PurchaseTerm(ctx, i.network, ev)
+	if err != nil {
+		return err
+	}
+	sub := models.Subscription{
+		Chain:    i.network.Name,
+		TokenID:  ev.TokenID.String(),
+		UserID:   user.ID,
+		ModelID:  model.ID,
+		StartsAt: &startsAt,
+	}
+	if !expiresAt.IsZero() {
+		sub.ExpiresAt = &expiresAt
+	}
+
+	// ERC-1155 token ids identify a model's subscription option rather than
+	// a single holder, so a subscription is keyed by token and holder.
+	return i.repos.Subscriptions.RecordPurchase(ctx, sub)
+}
+
+func (i *Indexer) applyListing(ctx context.Context, ev *chain.NFTListed) error {
+	seller, err := i.findUser(ctx, ev.Seller)
+	if err != nil {
+		return err
+	}
+	if seller == nil {
+		log.Printf("Indexer[%s]: skipping listing of token %s by unknown wallet %s", i.network.Name, ev.TokenID, ev.Seller)
+		return nil
+	}
+
+	sub, err := i.repos.Subscriptions.FindOne(ctx, storage.SubscriptionFilter{
+		Chain:   i.network.Name,
+		TokenID: ev.TokenID.String(),
+		UserID:  &seller.ID,
+	})
+	if err == storage.ErrNotFound {
+		log.Printf("Indexer[%s]: listed token %s has no subscription for %s", i.network.Name, ev.TokenID, ev.Seller)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	price := chain.FormatUnits(ev.Price, i.network.PaymentDecimals)
+	listed := true
+	_, err = i.repos.Subscriptions.Update(ctx, sub.ID, storage.SubscriptionUpdate{
+		Price:    &price,
+		IsListed: &listed,
+	})
+	return err
+}
+
+func (i *Indexer) applySale(ctx context.Context, ev *chain.NFTSold) error {
+	// Every holder of a model's option shares its token id, so the sold
+	// subscription can only be told apart by its seller.
+	seller, err := i.findUser(ctx, ev.Seller)
+	if err != nil {
+		return err
+	}
+	if seller == nil {
+		log.Printf("Indexer[%s]: skipping sale of token %s by unknown wallet %s", i.network.Name, ev.TokenID, ev.Seller)
+		return nil
+	}
+	buyer, err := i.findUser(ctx, ev.Buyer)
+	if err != nil {
+		return err
+	}
+
+	sub, err := i.repos.Subscriptions.FindOne(ctx, storage.SubscriptionFilter{
+		Chain:   i.network.Name,
+		TokenID: ev.TokenID.String(),
+		UserID:  &seller.ID,
+	})
+	if err == storage.ErrNotFound {
+		log.Printf("Indexer[%s]: sold token %s has no subscription for %s", i.network.Name, ev.TokenID, ev.Seller)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if buyer == nil {
+		log.Printf("Indexer[%s]: token %s sold to unknown wallet %s", i.network.Name, ev.TokenID, ev.Buyer)
+		listed := false
+		_, err = i.repos.Subscriptions.Update(ctx, sub.ID, storage.SubscriptionUpdate{IsListed: &listed})
+		return err
+	}
+
+	// A buyer who already holds the token has the two merged.
+	_, err = i.repos.Subscriptions.Transfer(ctx, sub.ID, buyer.ID)
+	return err
+}
+
+// findUser returns the user with walletAddress, or nil if there is none.
+func (i *Indexer) findUser(ctx context.Context, walletAddress string) (*models.User, error) {
+	user, err := i.repos.Users.FindByWallet(ctx, walletAddress)
+	if err == storage.ErrNotFound {
+		return nil, nil
+	}
+	return user, err
+}
@@ -0,0 +1,238 @@
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+
+	"arjunmal1311/fans_flow_on_chain/backend/chain"
+	"arjunmal1311/fans_flow_on_chain/backend/models"
+	"arjunmal1311/fans_flow_on_chain/backend/storage"
+)
+
+const (
+	testChain   = "testnet"
+	testTokenID = "7000000000000000001"
+	aliceWallet = "0x70997970C51812dc3A010C7d01b50e0d17dc79C8"
+	bobWallet   = "0x3C44CdDdB6a900fa2b585dd299e03d12FA4293BC"
+	marketplace = "0xe7f1725e7734ce288f8367e1bb143e90bb3f0512"
+	nft         = "0x5fbdb2315678afecb367f032d93f642f64180aa3"
+
+	// blockTime is the timestamp of every block, expiresAt the
+	// expirationTimes the NFT contract reports.
+	blockTime = 1700000000
+	expiresAt = 1702592000
+)
+
+// rpcStub is a JSON-RPC node serving the marketplace fixtures up to head.
+type rpcStub struct {
+	mu   sync.Mutex
+	head uint64
+	logs []chain.Log
+	// ranges has the [fromBlock, toBlock] of every eth_getLogs request.
+	ranges [][2]uint64
+}
+
+func newRPCStub(t *testing.T) (*rpcStub, *httptest.Server) {
+	data, err := os.ReadFile("../chain/testdata/marketplace_logs.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	stub := &rpcStub{}
+	if err := json.Unmarshal(data, &stub.logs); err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewServer(stub)
+	t.Cleanup(server.Close)
+	return stub, server
+}
+
+func (s *rpcStub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		ID     uint64            `json:"id"`
+		Method string            `json:"method"`
+		Params []json.RawMessage `json:"params"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var result interface{}
+	switch req.Method {
+	case "eth_blockNumber":
+		result = chain.EncodeQuantity(s.head)
+	case "eth_getLogs":
+		var filter struct {
+			FromBlock string `json:"fromBlock"`
+			ToBlock   string `json:"toBlock"`
+			Address   string `json:"address"`
+		}
+		json.Unmarshal(req.Params[0], &filter)
+		from, _ := chain.ParseQuantity(filter.FromBlock)
+		to, _ := chain.ParseQuantity(filter.ToBlock)
+		s.ranges = append(s.ranges, [2]uint64{from, to})
+
+		// Quantities go back hex-encoded, as a node sends them.
+		logs := []map[string]interface{}{}
+		for _, l := range s.logs {
+			if uint64(l.BlockNumber) >= from && uint64(l.BlockNumber) <= to && strings.EqualFold(l.Address, filter.Address) {
+				logs = append(logs, map[string]interface{}{
+					"address":         l.Address,
+					"topics":          l.Topics,
+					"data":            l.Data,
+					"blockNumber":     chain.EncodeQuantity(uint64(l.BlockNumber)),
+					"transactionHash": l.TxHash,
+					"logIndex":        chain.EncodeQuantity(uint64(l.LogIndex)),
+					"removed":         false,
+				})
+			}
+		}
+		result = logs
+	case "eth_getBlockByNumber":
+		result = map[string]string{"timestamp": chain.EncodeQuantity(blockTime)}
+	case "eth_call":
+		result = fmt.Sprintf("0x%064x", expiresAt)
+	default:
+		json.NewEncoder(w).Encode(map[string]interface{}{"id": req.ID, "error": map[string]interface{}{"code": -32601, "message": "method not found"}})
+		return
+	}
+	json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": req.ID, "result": result})
+}
+
+// advance moves the head to block and forgets the eth_getLogs requests so
+// far.
+func (s *rpcStub) advance(head uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.head = head
+	s.ranges = nil
+}
+
+func (s *rpcStub) requested() [][2]uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.ranges
+}
+
+func TestSync(t *testing.T) {
+	ctx := context.Background()
+	stub, server := newRPCStub(t)
+
+	repos := storage.NewMemory()
+	alice := models.User{Username: "alice", Email: "alice@example.com", WalletAddress: aliceWallet}
+	bob := models.User{Username: "bob", Email: "bob@example.com", WalletAddress: bobWallet}
+	for _, u := range []*models.User{&alice, &bob} {
+		if err := repos.Users.Create(ctx, u); err != nil {
+			t.Fatal(err)
+		}
+	}
+	model := models.Model{Name: "Model", ModelID: "7", Email: "model@example.com", WalletAddress: "0x0000000000000000000000000000000000000007", Slug: "model"}
+	if err := repos.Models.Create(ctx, &model); err != nil {
+		t.Fatal(err)
+	}
+
+	network := chain.Network{
+		Name:            testChain,
+		ChainID:         31337,
+		RPCURL:          server.URL,
+		Marketplace:     marketplace,
+		NFT:             nft,
+		StartBlock:      5,
+		Confirmations:   2,
+		PaymentDecimals: 8,
+	}
+	cursors := NewMemoryCursors()
+	newIndexer := func() *Indexer {
+		i := New(network, repos)
+		i.BatchSize = 4
+		i.Cursors = cursors
+		return i
+	}
+
+	holding := func(user models.User) *models.Subscription {
+		t.Helper()
+		sub, err := repos.Subscriptions.FindOne(ctx, storage.SubscriptionFilter{Chain: testChain, TokenID: testTokenID, UserID: &user.ID})
+		if err == storage.ErrNotFound {
+			return nil
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		return sub
+	}
+
+	steps := []struct {
+		name string
+		head uint64
+		// ranges are the blocks fetched, cursor the block stored after.
+		ranges [][2]uint64
+		cursor uint64
+		check  func() error
+	}{
+		{"purchase", 12, [][2]uint64{{5, 8}, {9, 10}}, 10, func() error {
+			sub := holding(alice)
+			if sub == nil || sub.ModelID != model.ID || sub.IsListed {
+				return fmt.Errorf("alice's subscription %+v", sub)
+			}
+			if sub.StartsAt == nil || sub.StartsAt.Unix() != blockTime || sub.ExpiresAt == nil || sub.ExpiresAt.Unix() != expiresAt {
+				return fmt.Errorf("term %v to %v", sub.StartsAt, sub.ExpiresAt)
+			}
+			return nil
+		}},
+		{"listing", 14, [][2]uint64{{11, 12}}, 12, func() error {
+			if sub := holding(alice); sub == nil || !sub.IsListed || sub.Price != "25" {
+				return fmt.Errorf("alice's subscription %+v", sub)
+			}
+			return nil
+		}},
+		{"nothing new", 14, nil, 12, func() error { return nil }},
+		{"sale", 20, [][2]uint64{{13, 16}, {17, 18}}, 18, func() error {
+			if sub := holding(alice); sub != nil {
+				return fmt.Errorf("alice still holds %+v", sub)
+			}
+			if sub := holding(bob); sub == nil || sub.IsListed || sub.ExpiresAt == nil || sub.ExpiresAt.Unix() != expiresAt {
+				return fmt.Errorf("bob's subscription %+v", sub)
+			}
+			return nil
+		}},
+	}
+
+	for _, step := range steps {
+		stub.advance(step.head)
+		// Each step starts afresh, as after a restart, from the stored cursor.
+		if err := newIndexer().Sync(ctx); err != nil {
+			t.Fatalf("%s: Sync: %v", step.name, err)
+		}
+		if got := stub.requested(); fmt.Sprint(got) != fmt.Sprint(step.ranges) {
+			t.Errorf("%s: fetched %v, want %v", step.name, got, step.ranges)
+		}
+		if last, ok, _ := cursors.Load(ctx, testChain); !ok || last != step.cursor {
+			t.Errorf("%s: cursor %d (%t), want %d", step.name, last, ok, step.cursor)
+		}
+		if err := step.check(); err != nil {
+			t.Errorf("%s: %v", step.name, err)
+		}
+	}
+
+	// Replaying the blocks changes nothing.
+	before := holding(bob)
+	if err := cursors.Save(ctx, testChain, 4); err != nil {
+		t.Fatal(err)
+	}
+	if err := newIndexer().Sync(ctx); err != nil {
+		t.Fatalf("replay: Sync: %v", err)
+	}
+	if after := holding(bob); after == nil || after.ID != before.ID || holding(alice) != nil {
+		t.Errorf("after replay: bob %+v, alice %+v", after, holding(alice))
+	}
+}
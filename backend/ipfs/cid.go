@@ -0,0 +1,208 @@
+package ipfs
+
+import (
+	"crypto/sha256"
+	"encoding/base32"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// The CIDs computed here match `ipfs add` with its defaults: 256 KiB
+// chunks, a balanced DAG of at most 174 links per node and sha2-256.
+// CIDv0 uses UnixFS leaves; CIDv1 uses raw leaves, as `ipfs add
+// --cid-version=1` and Pinata do.
+const (
+	chunkSize   = 256 * 1024
+	maxLinks    = 174
+	codecRaw    = 0x55
+	codecDagPB  = 0x70
+	sha256Code  = 0x12
+	unixfsFile  = 2
+	base58Chars = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+)
+
+var ErrCIDMismatch = errors.New("pinned CID does not match the content")
+
+var base32Lower = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(base32.NoPadding)
+
+// CIDv0 returns the "Qm..." CID of data added as a file.
+func CIDv0(data []byte) string {
+	root := buildDAG(data, false)
+	return base58Encode(root.multihash)
+}
+
+// CIDv1 returns the base32 "baf..." CID of data added as a file.
+func CIDv1(data []byte) string {
+	root := buildDAG(data, true)
+	return "b" + base32Lower.EncodeToString(root.cidBytes(true))
+}
+
+// VerifyCID checks that cid, as returned by a pinning provider, is the CID
+// of data. Both versions are accepted.
+func VerifyCID(data []byte, cid string) error {
+	var expected string
+	switch {
+	case strings.HasPrefix(cid, "Qm"):
+		expected = CIDv0(data)
+	case strings.HasPrefix(cid, "b"):
+		expected = CIDv1(data)
+	default:
+		return fmt.Errorf("%w: unsupported CID %q", ErrCIDMismatch, cid)
+	}
+
+	if cid != expected {
+		return fmt.Errorf("%w: provider returned %s, content hashes to %s", ErrCIDMismatch, cid, expected)
+	}
+	return nil
+}
+
+// dagNode is a block of the file DAG.
+type dagNode struct {
+	multihash []byte
+	raw       bool
+	// fileSize is the number of file bytes below the node; treeSize is the
+	// size of every block below and including it, as recorded in links.
+	fileSize uint64
+	treeSize uint64
+}
+
+func (n dagNode) cidBytes(v1 bool) []byte {
+	if !v1 {
+		return n.multihash
+	}
+	codec := byte(codecDagPB)
+	if n.raw {
+		codec = codecRaw
+	}
+	return append([]byte{1, codec}, n.multihash...)
+}
+
+func buildDAG(data []byte, rawLeaves bool) dagNode {
+	var level []dagNode
+	for offset := 0; offset < len(data) || offset == 0; offset += chunkSize {
+		end := offset + chunkSize
+		if end > len(data) {
+			end = len(data)
+		}
+		level = append(level, leafNode(data[offset:end], rawLeaves))
+		if end == len(data) {
+			break
+		}
+	}
+
+	for len(level) > 1 {
+		var parents []dagNode
+		for start := 0; start < len(level); start += maxLinks {
+			end := start + maxLinks
+			if end > len(level) {
+				end = len(level)
+			}
+			parents = append(parents, parentNode(level[start:end], rawLeaves))
+		}
+		level = parents
+	}
+	return level[0]
+}
+
+func leafNode(chunk []byte, raw bool) dagNode {
+	if raw {
+		return dagNode{
+			multihash: sha256Multihash(chunk),
+			raw:       true,
+			fileSize:  uint64(len(chunk)),
+			treeSize:  uint64(len(chunk)),
+		}
+	}
+
+	unixfs := appendVarintField(nil, 1, unixfsFile)
+	if len(chunk) > 0 {
+		unixfs = appendBytesField(unixfs, 2, chunk)
+	}
+	unixfs = appendVarintField(unixfs, 3, uint64(len(chunk)))
+
+	block := appendBytesField(nil, 1, unixfs)
+	return dagNode{
+		multihash: sha256Multihash(block),
+		fileSize:  uint64(len(chunk)),
+		treeSize:  uint64(len(block)),
+	}
+}
+
+func parentNode(children []dagNode, v1 bool) dagNode {
+	var fileSize, treeSize uint64
+	unixfs := appendVarintField(nil, 1, unixfsFile)
+	for _, child := range children {
+		fileSize += child.fileSize
+	}
+	unixfs = appendVarintField(unixfs, 3, fileSize)
+	for _, child := range children {
+		unixfs = appendVarintField(unixfs, 4, child.fileSize)
+	}
+
+	// dag-pb writes links before data.
+	var block []byte
+	for _, child := range children {
+		var link []byte
+		link = appendBytesField(link, 1, child.cidBytes(v1))
+		link = appendBytesField(link, 2, nil)
+		link = appendVarintField(link, 3, child.treeSize)
+		block = appendBytesField(block, 2, link)
+		treeSize += child.treeSize
+	}
+	block = appendBytesField(block, 1, unixfs)
+
+	return dagNode{
+		multihash: sha256Multihash(block),
+		fileSize:  fileSize,
+		treeSize:  treeSize + uint64(len(block)),
+	}
+}
+
+func sha256Multihash(data []byte) []byte {
+	sum := sha256.Sum256(data)
+	return append([]byte{sha256Code, sha256.Size}, sum[:]...)
+}
+
+func appendVarint(b []byte, v uint64) []byte {
+	for v >= 0x80 {
+		b = append(b, byte(v)|0x80)
+		v >>= 7
+	}
+	return append(b, byte(v))
+}
+
+func appendVarintField(b []byte, field int, v uint64) []byte {
+	b = appendVarint(b, uint64(field<<3))
+	return appendVarint(b, v)
+}
+
+func appendBytesField(b []byte, field int, data []byte) []byte {
+	b = appendVarint(b, uint64(field<<3|2))
+	b = appendVarint(b, uint64(len(data)))
+	return append(b, data...)
+}
+
+func base58Encode(data []byte) string {
+	n := new(big.Int).SetBytes(data)
+	radix := big.NewInt(58)
+	mod := new(big.Int)
+
+	var out []byte
+	for n.Sign() > 0 {
+		n.DivMod(n, radix, mod)
+		out = append(out, base58Chars[mod.Int64()])
+	}
+	for _, b := range data {
+		if b != 0 {
+			break
+		}
+		out = append(out, base58Chars[0])
+	}
+
+	for i, j := 0, len(out)-1; i < j; i, j = i+1, j-1 {
+		out[i], out[j] = out[j], out[i]
+	}
+	return string(out)
+}
@@ -0,0 +1,81 @@
+package ipfs
+
+import (
+	"errors"
+	"testing"
+)
+
+// pattern returns n bytes that do not repeat within a chunk.
+func pattern(n int) []byte {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = byte(i % 251)
+	}
+	return b
+}
+
+// The expected CIDs are what `ipfs add` (CIDv0) and `ipfs add
+// --cid-version=1` report for the same content.
+var cidTests = []struct {
+	name string
+	data []byte
+	v0   string
+	v1   string
+}{
+	{
+		name: "empty",
+		data: []byte{},
+		v0:   "QmbFMke1KXqnYyBBWxB74N4c5SBnJMVAiMNRcGu6x1AwQH",
+		v1:   "bafkreihdwdcefgh4dqkjv67uzcmw7ojee6xedzdetojuzjevtenxquvyku",
+	},
+	{
+		name: "small",
+		data: []byte("hello world\n"),
+		v0:   "QmT78zSuBmuS4z925WZfrqQ1qHaJ56DQaTfyMUF7F8ff5o",
+		v1:   "bafkreifjjcie6lypi6ny7amxnfftagclbuxndqonfipmb64f2km2devei4",
+	},
+	{
+		name: "one full chunk",
+		data: pattern(chunkSize),
+		v0:   "QmeqfRyS3vkku7n6krqC3DgGMex3x2sCpSeKMDmrG13QQq",
+		v1:   "bafkreibruh455iawsviqslif5c7uurdcfdemh22mtnytyzvnzn75kpejxy",
+	},
+	{
+		name: "two chunks",
+		data: pattern(chunkSize + 1),
+		v0:   "QmUSjGawaz4ptvREcMKSMJneWCa5j8dAz2wSAAvHtW2rnB",
+		v1:   "bafybeiexg2oqkfnj56l7fcmawswqbijt5shq4b5rg6a546uwpkqqzwjioi",
+	},
+	{
+		name: "two levels",
+		data: pattern(maxLinks*chunkSize + 1),
+		v0:   "QmTedsTekQQkgACJXb1sPZSW8bLdS9LPMrT7L4YdjNRd4n",
+		v1:   "bafybeib4y7ghw2rq7bracc4xwtxrbzo7cfvagdpte2tmrkgwl6dyard3cm",
+	},
+}
+
+func TestCID(t *testing.T) {
+	for _, tt := range cidTests {
+		if got := CIDv0(tt.data); got != tt.v0 {
+			t.Errorf("CIDv0(%s) = %s, want %s", tt.name, got, tt.v0)
+		}
+		if got := CIDv1(tt.data); got != tt.v1 {
+			t.Errorf("CIDv1(%s) = %s, want %s", tt.name, got, tt.v1)
+		}
+	}
+}
+
+func TestVerifyCID(t *testing.T) {
+	data := []byte("hello world\n")
+	for _, cid := range []string{cidTests[1].v0, cidTests[1].v1} {
+		if err := VerifyCID(data, cid); err != nil {
+			t.Errorf("VerifyCID(%s): %v", cid, err)
+		}
+	}
+
+	for _, cid := range []string{cidTests[0].v0, cidTests[0].v1, "zb2rhe5P4gXftAwvA4eXQ5HJwsER2owDyS9sKaQRRVQPn93bA", ""} {
+		if err := VerifyCID(data, cid); !errors.Is(err, ErrCIDMismatch) {
+			t.Errorf("VerifyCID(%q) error = %v, want ErrCIDMismatch", cid, err)
+		}
+	}
+}
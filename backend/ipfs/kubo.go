@@ -0,0 +1,98 @@
+package ipfs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const defaultKuboAPIURL = "http://127.0.0.1:5001"
+
+// Kubo pins on an IPFS node through its HTTP RPC API.
+type Kubo struct {
+	apiURL string
+}
+
+func NewKubo(apiURL string) *Kubo {
+	if apiURL == "" {
+		apiURL = defaultKuboAPIURL
+	}
+	return &Kubo{apiURL: strings.TrimRight(apiURL, "/")}
+}
+
+func (k *Kubo) Name() string {
+	return "kubo"
+}
+
+type kuboAddResponse struct {
+	Name string `json:"Name"`
+	Hash string `json:"Hash"`
+	Size string `json:"Size"`
+}
+
+func (k *Kubo) Pin(ctx context.Context, data []byte, filename, name string) (*PinResult, error) {
+	body, contentType, err := multipartFile(data, filename, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// cid-version=0 matches what Pinata returns, so links look the same
+	// whichever provider pinned them.
+	endpoint := k.apiURL + "/api/v0/add?pin=true&cid-version=0"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, body)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %v", err)
+	}
+	req.Header.Set("Content-Type", contentType)
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("kubo API error: %s", string(respBody))
+	}
+
+	var added kuboAddResponse
+	if err := json.NewDecoder(resp.Body).Decode(&added); err != nil {
+		return nil, fmt.Errorf("error decoding response: %v", err)
+	}
+	if added.Hash == "" {
+		return nil, errors.New("kubo API returned no Hash")
+	}
+
+	size, _ := strconv.ParseInt(added.Size, 10, 64)
+	return &PinResult{CID: added.Hash, Size: size}, nil
+}
+
+func (k *Kubo) Unpin(ctx context.Context, cid string) error {
+	endpoint := k.apiURL + "/api/v0/pin/rm?arg=" + url.QueryEscape(cid)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("error creating request: %v", err)
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error making request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		if strings.Contains(string(respBody), "not pinned") {
+			return nil
+		}
+		return fmt.Errorf("kubo API error: %s", string(respBody))
+	}
+	return nil
+}
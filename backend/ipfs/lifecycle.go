@@ -0,0 +1,353 @@
+package ipfs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"arjunmal1311/fans_flow_on_chain/backend/models"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+var ErrPinInUse = errors.New("pin is referenced by a registered user or model")
+
+// stillPinned matches the status of pin records that have not been
+// unpinned.
+var stillPinned = bson.M{"$ne": models.PinUnpinned}
+
+var (
+	pinnersMu sync.Mutex
+	pinners   = map[string]Pinner{}
+)
+
+// pinnerFor returns the pinner for provider, which need not be the one
+// currently configured: pins keep the provider they were made with.
+func pinnerFor(provider string) (Pinner, error) {
+	if pinner != nil && pinner.Name() == provider {
+		return pinner, nil
+	}
+
+	pinnersMu.Lock()
+	defer pinnersMu.Unlock()
+	if p, ok := pinners[provider]; ok {
+		return p, nil
+	}
+	p, err := NewNamedPinner(provider)
+	if err != nil {
+		return nil, err
+	}
+	pinners[provider] = p
+	return p, nil
+}
+
+// CIDFromURL extracts the CID from a gateway URL (https://host/ipfs/<cid>)
+// or an ipfs://<cid> URI. It returns "" for anything else.
+func CIDFromURL(raw string) string {
+	var rest string
+	switch {
+	case strings.HasPrefix(raw, "ipfs://"):
+		rest = strings.TrimPrefix(raw, "ipfs://")
+	case strings.Contains(raw, "/ipfs/"):
+		rest = raw[strings.Index(raw, "/ipfs/")+len("/ipfs/"):]
+	default:
+		return ""
+	}
+
+	if i := strings.IndexAny(rest, "/?#"); i >= 0 {
+		rest = rest[:i]
+	}
+	if !strings.HasPrefix(rest, "Qm") && !strings.HasPrefix(rest, "b") {
+		return ""
+	}
+	return rest
+}
+
+// GetPin returns the pin record with the given hex ID, or nil if there is
+// none or the ID is malformed.
+func GetPin(ctx context.Context, id string) (*models.Pin, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, nil
+	}
+
+	var pin models.Pin
+	err = collection().FindOne(ctx, bson.M{"_id": objectID}).Decode(&pin)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pin, nil
+}
+
+// SetParent records that pin is referenced by the content pinned as
+// parentCID.
+func SetParent(ctx context.Context, pin *models.Pin, parentCID string) error {
+	_, err := collection().UpdateOne(ctx, bson.M{"_id": pin.ID}, bson.M{"$set": bson.M{"parent_cid": parentCID}})
+	return err
+}
+
+// LinkPins links the pins behind ipfsURL, and those they reference, to the
+// registered user or model: field is "user_id" or "model_id".
+func LinkPins(ctx context.Context, ipfsURL, field string, id primitive.ObjectID) error {
+	cid := CIDFromURL(ipfsURL)
+	if cid == "" {
+		return nil
+	}
+
+	_, err := collection().UpdateMany(ctx,
+		bson.M{"$or": []bson.M{{"cid": cid}, {"parent_cid": cid}}},
+		bson.M{"$set": bson.M{field: id}},
+	)
+	return err
+}
+
+// referencedCIDs returns the CIDs named by the ipfs_url of users and
+// models.
+func referencedCIDs(ctx context.Context) (map[string]bool, error) {
+	users, err := repos.Users.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	registered, err := repos.Models.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	referenced := map[string]bool{}
+	for _, u := range users {
+		if cid := CIDFromURL(u.IpfsUrl); cid != "" {
+			referenced[cid] = true
+		}
+	}
+	for _, m := range registered {
+		if cid := CIDFromURL(m.IpfsUrl); cid != "" {
+			referenced[cid] = true
+		}
+	}
+	return referenced, nil
+}
+
+func isReferenced(pin models.Pin, referenced map[string]bool) bool {
+	return referenced[pin.CID] || (pin.ParentCID != "" && referenced[pin.ParentCID])
+}
+
+// Unpin releases pin and the pins it references, unless a registered user
+// or model still uses it (ErrPinInUse).
+func Unpin(ctx context.Context, pin *models.Pin) error {
+	if pin.Status == models.PinUnpinned {
+		return nil
+	}
+
+	referenced, err := referencedCIDs(ctx)
+	if err != nil {
+		return err
+	}
+	if isReferenced(*pin, referenced) {
+		return ErrPinInUse
+	}
+
+	if err := release(ctx, *pin); err != nil {
+		return err
+	}
+
+	cursor, err := collection().Find(ctx, bson.M{"parent_cid": pin.CID, "status": stillPinned})
+	if err != nil {
+		return err
+	}
+	var children []models.Pin
+	if err := cursor.All(ctx, &children); err != nil {
+		return err
+	}
+	for _, child := range children {
+		if err := release(ctx, child); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// release unpins pin at its provider, unless another active record holds
+// the same content there, and marks the record unpinned.
+func release(ctx context.Context, pin models.Pin) error {
+	others, err := collection().CountDocuments(ctx, bson.M{
+		"_id":      bson.M{"$ne": pin.ID},
+		"cid":      pin.CID,
+		"provider": pin.Provider,
+		"status":   stillPinned,
+	})
+	if err != nil {
+		return err
+	}
+
+	if others == 0 {
+		p, err := pinnerFor(pin.Provider)
+		if err != nil {
+			return err
+		}
+		if err := p.Unpin(ctx, pin.CID); err != nil {
+			return err
+		}
+	}
+
+	_, err = collection().UpdateOne(ctx, bson.M{"_id": pin.ID}, bson.M{"$set": bson.M{
+		"status":      models.PinUnpinned,
+		"unpinned_at": time.Now(),
+	}})
+	return err
+}
+
+// CollectGarbage unpins content pinned before cutoff that no user or model
+// references, e.g. because registration failed after pinning. It returns
+// how many pins were released.
+func CollectGarbage(ctx context.Context, cutoff time.Time) (int, error) {
+	referenced, err := referencedCIDs(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	cursor, err := collection().Find(ctx, bson.M{
+		"status":     stillPinned,
+		"created_at": bson.M{"$lt": cutoff},
+	})
+	if err != nil {
+		return 0, err
+	}
+	defer cursor.Close(ctx)
+
+	released := 0
+	for cursor.Next(ctx) {
+		var pin models.Pin
+		if err := cursor.Decode(&pin); err != nil {
+			return released, err
+		}
+		if isReferenced(pin, referenced) {
+			continue
+		}
+		if err := release(ctx, pin); err != nil {
+			log.Printf("IPFS: failed to unpin %s (%s): %v", pin.CID, pin.Provider, err)
+			continue
+		}
+		released++
+	}
+	return released, cursor.Err()
+}
+
+// Fetch downloads cid through the configured gateway and checks that the
+// content matches it.
+func Fetch(ctx context.Context, cid string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, GatewayURL(cid), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("gateway returned status %d for %s", resp.StatusCode, cid)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if err := VerifyCID(data, cid); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+type RepinReport struct {
+	To       string         `json:"to"`
+	DryRun   bool           `json:"dry_run"`
+	Migrated int            `json:"migrated"`
+	Failed   map[string]int `json:"failed"`
+	// Unpinned counts source pins released after migrating, with -unpin.
+	Unpinned int `json:"unpinned"`
+}
+
+// Repin moves every active pin held by another provider (or only by from,
+// when set) to the provider named to. Content is fetched through the
+// gateway, pinned again and verified before the record is switched over;
+// with unpinSource the old provider's pin is then released.
+func Repin(ctx context.Context, from, to string, unpinSource, dryRun bool) (*RepinReport, error) {
+	target, err := pinnerFor(to)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := bson.M{"status": stillPinned, "provider": bson.M{"$ne": to}}
+	if from != "" {
+		filter["provider"] = from
+	}
+
+	cursor, err := collection().Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	var pins []models.Pin
+	if err := cursor.All(ctx, &pins); err != nil {
+		return nil, err
+	}
+
+	report := &RepinReport{To: to, DryRun: dryRun, Failed: map[string]int{}}
+	for _, pin := range pins {
+		if dryRun {
+			report.Migrated++
+			continue
+		}
+
+		if err := repin(ctx, target, pin, unpinSource); err != nil {
+			log.Printf("IPFS: failed to re-pin %s from %s: %v", pin.CID, pin.Provider, err)
+			report.Failed[pin.Provider]++
+			continue
+		}
+		report.Migrated++
+		if unpinSource {
+			report.Unpinned++
+		}
+	}
+	return report, nil
+}
+
+func repin(ctx context.Context, target Pinner, pin models.Pin, unpinSource bool) error {
+	data, err := Fetch(ctx, pin.CID)
+	if err != nil {
+		return err
+	}
+
+	result, err := target.Pin(ctx, data, pin.CID, pin.Name)
+	if err != nil {
+		return err
+	}
+	if result.CID != pin.CID {
+		return fmt.Errorf("%w: %s returned %s for %s", ErrCIDMismatch, target.Name(), result.CID, pin.CID)
+	}
+
+	if unpinSource {
+		source, err := pinnerFor(pin.Provider)
+		if err != nil {
+			return err
+		}
+		if err := source.Unpin(ctx, pin.CID); err != nil {
+			return err
+		}
+	}
+
+	_, err = collection().UpdateOne(ctx, bson.M{"_id": pin.ID}, bson.M{"$set": bson.M{"provider": target.Name()}})
+	return err
+}
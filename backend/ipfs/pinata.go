@@ -0,0 +1,104 @@
+package ipfs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+const (
+	pinataEndpoint      = "https://api.pinata.cloud/pinning/pinFileToIPFS"
+	pinataUnpinEndpoint = "https://api.pinata.cloud/pinning/unpin/"
+)
+
+// Pinata pins through the Pinata pinning service.
+type Pinata struct {
+	jwt string
+}
+
+func NewPinata(jwt string) (*Pinata, error) {
+	if jwt == "" {
+		return nil, errors.New("JWT environment variable not set")
+	}
+	return &Pinata{jwt: jwt}, nil
+}
+
+func (p *Pinata) Name() string {
+	return "pinata"
+}
+
+type pinataResponse struct {
+	IpfsHash string `json:"IpfsHash"`
+	PinSize  int64  `json:"PinSize"`
+}
+
+func (p *Pinata) Pin(ctx context.Context, data []byte, filename, name string) (*PinResult, error) {
+	metadataJSON, err := json.Marshal(map[string]string{"name": name})
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling metadata: %v", err)
+	}
+	optionsJSON, err := json.Marshal(map[string]interface{}{"cidVersion": 0})
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling options: %v", err)
+	}
+
+	body, contentType, err := multipartFile(data, filename, map[string]string{
+		"pinataMetadata": string(metadataJSON),
+		"pinataOptions":  string(optionsJSON),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, pinataEndpoint, body)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %v", err)
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("Authorization", "Bearer "+p.jwt)
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("pinata API error: %s", string(respBody))
+	}
+
+	var pinataResp pinataResponse
+	if err := json.NewDecoder(resp.Body).Decode(&pinataResp); err != nil {
+		return nil, fmt.Errorf("error decoding response: %v", err)
+	}
+	if pinataResp.IpfsHash == "" {
+		return nil, errors.New("pinata API returned no IpfsHash")
+	}
+
+	return &PinResult{CID: pinataResp.IpfsHash, Size: pinataResp.PinSize}, nil
+}
+
+func (p *Pinata) Unpin(ctx context.Context, cid string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, pinataUnpinEndpoint+url.PathEscape(cid), nil)
+	if err != nil {
+		return fmt.Errorf("error creating request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+p.jwt)
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error making request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound {
+		respBody, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("pinata API error: %s", string(respBody))
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+// Package ipfs pins content through a configurable provider and keeps a
+// record of every pin the platform makes.
+package ipfs
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+const defaultGatewayURL = "https://ipfs.io/ipfs/"
+
+// Pinner adds content to IPFS and keeps it pinned.
+type Pinner interface {
+	// Name identifies the provider in pin records.
+	Name() string
+	Pin(ctx context.Context, data []byte, filename, name string) (*PinResult, error)
+	// Unpin releases cid. Unpinning content that is not pinned succeeds.
+	Unpin(ctx context.Context, cid string) error
+}
+
+type PinResult struct {
+	CID  string
+	Size int64
+}
+
+var httpClient = &http.Client{Timeout: 2 * time.Minute}
+
+// NewPinner builds the pinner named by IPFS_PINNER: "pinata" or "kubo".
+// When unset, Pinata is used if JWT is configured and the local Kubo node
+// otherwise.
+func NewPinner() (Pinner, error) {
+	provider := os.Getenv("IPFS_PINNER")
+	if provider == "" {
+		provider = "kubo"
+		if os.Getenv("JWT") != "" {
+			provider = "pinata"
+		}
+	}
+	return NewNamedPinner(provider)
+}
+
+// NewNamedPinner builds the pinner called provider, configured from the
+// environment like NewPinner.
+func NewNamedPinner(provider string) (Pinner, error) {
+	switch provider {
+	case "pinata":
+		return NewPinata(os.Getenv("JWT"))
+	case "kubo":
+		return NewKubo(os.Getenv("KUBO_API_URL")), nil
+	default:
+		return nil, fmt.Errorf("unknown IPFS_PINNER %q", provider)
+	}
+}
+
+// gatewayURL is the base that CIDs are appended to in public links.
+var gatewayURL = defaultGatewayURL
+
+// GatewayURL returns the public link to cid on the configured gateway.
+func GatewayURL(cid string) string {
+	return gatewayURL + cid
+}
+
+func loadGatewayURL() {
+	if v := os.Getenv("IPFS_GATEWAY_URL"); v != "" {
+		gatewayURL = strings.TrimRight(v, "/") + "/"
+	}
+}
+
+// multipartFile builds a multipart body with data as its "file" part plus
+// any extra fields.
+func multipartFile(data []byte, filename string, fields map[string]string) (*bytes.Buffer, string, error) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	part, err := writer.CreateFormFile("file", filename)
+	if err != nil {
+		return nil, "", fmt.Errorf("error creating form file: %v", err)
+	}
+	if _, err := part.Write(data); err != nil {
+		return nil, "", fmt.Errorf("error writing file: %v", err)
+	}
+
+	for key, value := range fields {
+		if err := writer.WriteField(key, value); err != nil {
+			return nil, "", fmt.Errorf("error writing %s field: %v", key, err)
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, "", fmt.Errorf("error closing writer: %v", err)
+	}
+	return body, writer.FormDataContentType(), nil
+}
@@ -0,0 +1,117 @@
+package ipfs
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"arjunmal1311/fans_flow_on_chain/backend/db"
+	"arjunmal1311/fans_flow_on_chain/backend/models"
+	"arjunmal1311/fans_flow_on_chain/backend/storage"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const maxPinPageSize = 200
+
+var (
+	pinner Pinner
+	// repos holds the users and models whose ipfs_url keep pins alive.
+	repos storage.Repos
+)
+
+// Init selects the pinner and gateway from the environment, and checks
+// pins against the users and models in r before releasing them.
+func Init(r storage.Repos) error {
+	p, err := NewPinner()
+	if err != nil {
+		return err
+	}
+	pinner = p
+	repos = r
+	loadGatewayURL()
+	log.Printf("Pinning to IPFS with %s, gateway %s", pinner.Name(), gatewayURL)
+	return nil
+}
+
+func collection() *mongo.Collection {
+	return db.GetCollection("pins")
+}
+
+// Pin pins data with the configured provider and records the pin for
+// ownerWallet. It fails with ErrCIDMismatch if the provider reports a CID
+// other than the one computed locally, after unpinning what it reported.
+func Pin(ctx context.Context, data []byte, filename, name, ownerWallet string) (*models.Pin, error) {
+	result, err := pinner.Pin(ctx, data, filename, name)
+	if err != nil {
+		return nil, err
+	}
+	if err := VerifyCID(data, result.CID); err != nil {
+		log.Printf("IPFS: %s pin of %q rejected: %v", pinner.Name(), name, err)
+		if unpinErr := unpinRejected(ctx, result.CID); unpinErr != nil {
+			log.Printf("IPFS: failed to unpin rejected %s from %s: %v", result.CID, pinner.Name(), unpinErr)
+		}
+		return nil, err
+	}
+
+	size := result.Size
+	if size == 0 {
+		size = int64(len(data))
+	}
+
+	pin := &models.Pin{
+		ID:          primitive.NewObjectID(),
+		CID:         result.CID,
+		Name:        name,
+		Size:        size,
+		OwnerWallet: ownerWallet,
+		Provider:    pinner.Name(),
+		Status:      models.PinPinned,
+		CreatedAt:   time.Now(),
+	}
+	if _, err := collection().InsertOne(ctx, pin); err != nil {
+		return nil, err
+	}
+	return pin, nil
+}
+
+// unpinRejected unpins cid, which the provider reported for content that
+// hashes to something else, unless a recorded pin holds it there.
+func unpinRejected(ctx context.Context, cid string) error {
+	held, err := collection().CountDocuments(ctx, bson.M{
+		"cid":      cid,
+		"provider": pinner.Name(),
+		"status":   stillPinned,
+	})
+	if err != nil {
+		return err
+	}
+	if held > 0 {
+		return nil
+	}
+	return pinner.Unpin(ctx, cid)
+}
+
+// ListPins returns pin records matching filter, newest first, at most
+// limit of them.
+func ListPins(ctx context.Context, filter bson.M, limit int64) ([]models.Pin, error) {
+	if limit <= 0 || limit > maxPinPageSize {
+		limit = maxPinPageSize
+	}
+
+	cursor, err := collection().Find(ctx, filter,
+		options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}}).SetLimit(limit))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	pins := []models.Pin{}
+	if err := cursor.All(ctx, &pins); err != nil {
+		return nil, err
+	}
+	return pins, nil
+}
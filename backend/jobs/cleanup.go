@@ -0,0 +1,33 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"os"
+	"time"
+
+	"arjunmal1311/fans_flow_on_chain/backend/assets"
+	"arjunmal1311/fans_flow_on_chain/backend/storage"
+)
+
+const defaultTempImageMaxAge = time.Hour
+
+// CleanTempImages deletes generated images that were never published once
+// they are older than TEMP_IMAGE_MAX_AGE (default 1h), for clients that
+// never call /server-storage-clean.
+func CleanTempImages(ctx context.Context, _ storage.Repos) error {
+	maxAge := defaultTempImageMaxAge
+	if v := os.Getenv("TEMP_IMAGE_MAX_AGE"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return err
+		}
+		maxAge = d
+	}
+
+	removed, err := assets.DeleteTemporary(ctx, time.Now().Add(-maxAge))
+	if removed > 0 {
+		log.Printf("Removed %d temp images", removed)
+	}
+	return err
+}
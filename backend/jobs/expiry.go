@@ -0,0 +1,24 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"arjunmal1311/fans_flow_on_chain/backend/storage"
+)
+
+// ExpireSubscriptions marks subscriptions whose expires_at has passed as
+// expired and takes them off the resale market, since the NFT's uri()
+// reverts once it expires.
+func ExpireSubscriptions(ctx context.Context, repos storage.Repos) error {
+	expired, err := repos.Subscriptions.ExpireDue(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+
+	if expired > 0 {
+		log.Printf("Expired %d subscriptions", expired)
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+// Package jobs holds the recurring maintenance tasks run by the scheduler.
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"arjunmal1311/fans_flow_on_chain/backend/scheduler"
+	"arjunmal1311/fans_flow_on_chain/backend/storage"
+)
+
+type definition struct {
+	name        string
+	scheduleEnv string
+	defaultSpec string
+	jitter      time.Duration
+	timeout     time.Duration
+	run         func(ctx context.Context, repos storage.Repos) error
+}
+
+var definitions = []definition{
+	{
+		name:        "expire-subscriptions",
+		scheduleEnv: "JOB_EXPIRE_SUBSCRIPTIONS_SCHEDULE",
+		defaultSpec: "@every 5m",
+		jitter:      30 * time.Second,
+		timeout:     time.Minute,
+		run:         ExpireSubscriptions,
+	},
+	{
+		name:        "reconcile-subscriptions",
+		scheduleEnv: "JOB_RECONCILE_SUBSCRIPTIONS_SCHEDULE",
+		defaultSpec: "@hourly",
+		jitter:      5 * time.Minute,
+		timeout:     30 * time.Minute,
+		run:         ReconcileSubscriptions,
+	},
+	{
+		name:        "clean-temp-images",
+		scheduleEnv: "JOB_CLEAN_TEMP_IMAGES_SCHEDULE",
+		defaultSpec: "*/30 * * * *",
+		timeout:     time.Minute,
+		run:         CleanTempImages,
+	},
+	{
+		name:        "gc-pins",
+		scheduleEnv: "JOB_GC_PINS_SCHEDULE",
+		defaultSpec: "@daily",
+		jitter:      10 * time.Minute,
+		timeout:     30 * time.Minute,
+		run:         CollectPinGarbage,
+	},
+}
+
+// Register adds every job to s, using the schedule from its environment
+// variable when set. A schedule of "off" disables the job. Jobs read and
+// write users, models and subscriptions through repos.
+func Register(s *scheduler.Scheduler, repos storage.Repos) error {
+	for _, d := range definitions {
+		run := d.run
+		spec := os.Getenv(d.scheduleEnv)
+		if spec == "" {
+			spec = d.defaultSpec
+		}
+		if spec == "off" {
+			log.Printf("Scheduler: job %s is disabled", d.name)
+			continue
+		}
+
+		schedule, err := scheduler.Parse(spec)
+		if err != nil {
+			return fmt.Errorf("invalid %s: %v", d.scheduleEnv, err)
+		}
+
+		err = s.Add(scheduler.Job{
+			Name:     d.name,
+			Schedule: schedule,
+			Jitter:   d.jitter,
+			Timeout:  d.timeout,
+			Run:      func(ctx context.Context) error { return run(ctx, repos) },
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"os"
+	"time"
+
+	"arjunmal1311/fans_flow_on_chain/backend/ipfs"
+	"arjunmal1311/fans_flow_on_chain/backend/storage"
+)
+
+const defaultPinGCGrace = 72 * time.Hour
+
+// CollectPinGarbage unpins content that no user or model references once
+// it is older than PIN_GC_GRACE (default 72h), so pins left behind by
+// abandoned registrations stop costing money.
+func CollectPinGarbage(ctx context.Context, _ storage.Repos) error {
+	grace := defaultPinGCGrace
+	if v := os.Getenv("PIN_GC_GRACE"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return err
+		}
+		grace = d
+	}
+
+	released, err := ipfs.CollectGarbage(ctx, time.Now().Add(-grace))
+	if released > 0 {
+		log.Printf("Unpinned %d unreferenced pins", released)
+	}
+	return err
+}
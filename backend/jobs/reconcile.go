@@ -0,0 +1,104 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"math/big"
+	"time"
+
+	"arjunmal1311/fans_flow_on_chain/backend/chain"
+	"arjunmal1311/fans_flow_on_chain/backend/models"
+	"arjunmal1311/fans_flow_on_chain/backend/storage"
+	"arjunmal1311/fans_flow_on_chain/backend/tokenid"
+)
+
+// ReconcileSubscriptions checks every unexpired subscription on a network
+// with an NFT contract against the holder's on-chain balance, recording the
+// result in held_on_chain, and fills in expires_at where it is missing.
+func ReconcileSubscriptions(ctx context.Context, repos storage.Repos) error {
+	for _, network := range chain.Networks() {
+		if !network.HasRPC() || network.NFT == "" {
+			continue
+		}
+		if err := reconcileNetwork(ctx, repos, network); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func reconcileNetwork(ctx context.Context, repos storage.Repos, network chain.Network) error {
+	unexpired := false
+	subs, err := repos.Subscriptions.Find(ctx, storage.SubscriptionFilter{Chain: network.Name, Expired: &unexpired})
+	if err != nil {
+		return err
+	}
+
+	var checked, missing int
+	for _, sub := range subs {
+		tokenID, err := tokenid.Parse(sub.TokenID)
+		if err != nil {
+			log.Printf("Reconcile[%s]: subscription %s has invalid token id %q", network.Name, sub.ID.Hex(), sub.TokenID)
+			continue
+		}
+
+		holder, err := repos.Users.Get(ctx, sub.UserID)
+		if err != nil {
+			log.Printf("Reconcile[%s]: subscription %s has no holder: %v", network.Name, sub.ID.Hex(), err)
+			continue
+		}
+
+		balance, err := chain.BalanceOf(ctx, network, holder.WalletAddress, tokenID)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		held := balance.Sign() > 0
+		update := storage.SubscriptionUpdate{HeldOnChain: &held, ReconciledAt: &now}
+		if sub.ExpiresAt == nil {
+			expiresAt, err := purchaseExpiry(ctx, repos, network, sub, tokenID)
+			if err != nil {
+				return err
+			}
+			if !expiresAt.IsZero() {
+				update.ExpiresAt = &expiresAt
+			}
+		}
+
+		if _, err := repos.Subscriptions.Update(ctx, sub.ID, update); err != nil {
+			return err
+		}
+
+		checked++
+		if !held {
+			missing++
+			log.Printf("Reconcile[%s]: %s no longer holds token %s", network.Name, holder.WalletAddress, sub.TokenID)
+		}
+	}
+
+	log.Printf("Reconcile[%s]: checked %d subscriptions, %d not held on chain", network.Name, checked, missing)
+	return nil
+}
+
+// purchaseExpiry works out when sub expires from its own purchase, since
+// the contract's expirationTimes now holds the token's latest buyer's: it
+// is read at the block of the purchase transaction or, without one, taken
+// from the option's duration (in days). It is zero when neither is known.
+func purchaseExpiry(ctx context.Context, repos storage.Repos, network chain.Network, sub models.Subscription, tokenID *big.Int) (time.Time, error) {
+	if sub.TxHash != "" {
+		return chain.PurchaseExpiry(ctx, network, sub.TxHash, tokenID)
+	}
+	if sub.StartsAt == nil || sub.OptionID == nil {
+		return time.Time{}, nil
+	}
+
+	option, err := repos.Options.Get(ctx, *sub.OptionID)
+	if err == storage.ErrNotFound || (err == nil && option.Duration <= 0) {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+	return sub.StartsAt.Add(time.Duration(option.Duration) * 24 * time.Hour), nil
+}
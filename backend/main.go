@@ -1,6 +1,8 @@
 package main
 
 import (
+	"context"
+	"fmt"
 	"log"
 	"net/http"
 	"os"
@@ -9,8 +11,19 @@ import (
 	"github.com/joho/godotenv"
 	"github.com/rs/cors"
 
+	"arjunmal1311/fans_flow_on_chain/backend/assets"
+	"arjunmal1311/fans_flow_on_chain/backend/auth"
+	"arjunmal1311/fans_flow_on_chain/backend/avatar"
+	"arjunmal1311/fans_flow_on_chain/backend/chain"
 	"arjunmal1311/fans_flow_on_chain/backend/db"
+	"arjunmal1311/fans_flow_on_chain/backend/imagegen"
+	"arjunmal1311/fans_flow_on_chain/backend/imageproc"
+	"arjunmal1311/fans_flow_on_chain/backend/indexer"
+	"arjunmal1311/fans_flow_on_chain/backend/ipfs"
+	"arjunmal1311/fans_flow_on_chain/backend/jobs"
 	"arjunmal1311/fans_flow_on_chain/backend/routes"
+	"arjunmal1311/fans_flow_on_chain/backend/scheduler"
+	"arjunmal1311/fans_flow_on_chain/backend/storage"
 )
 
 func main() {
@@ -18,13 +31,96 @@ func main() {
 		log.Printf("Warning: .env file not found")
 	}
 
-	db.InitDB()
+	var err error
+	if len(os.Args) > 1 {
+		err = runCommand(os.Args[1], os.Args[2:])
+	} else {
+		err = run()
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// run starts the HTTP server and its background work, returning once the
+// server stops so that deferred cleanup runs before the process exits.
+func run() error {
+	if err := chain.LoadRegistry(); err != nil {
+		return err
+	}
+
+	if err := auth.Init(); err != nil {
+		return err
+	}
+
+	// MongoDB is needed whatever STORAGE_BACKEND is: assets, pins, posts,
+	// nonces, avatar jobs and indexer cursors only live there.
+	if err := db.InitDB(); err != nil {
+		return err
+	}
 	defer db.CloseDB()
 
+	repos, closeStorage, err := storage.FromEnv(context.Background())
+	if err != nil {
+		return err
+	}
+	defer closeStorage()
+
+	if os.Getenv("INDEXER_ENABLED") == "true" {
+		for _, network := range chain.Networks() {
+			if network.HasRPC() {
+				go indexer.New(network, repos).Run(context.Background())
+			}
+		}
+	}
+
+	if err := assets.Init(); err != nil {
+		return err
+	}
+
+	if err := ipfs.Init(repos); err != nil {
+		return err
+	}
+
+	generator, err := imagegen.FromEnv()
+	if err != nil {
+		return err
+	}
+	log.Printf("Using %s image generator", generator.Name())
+
+	pipeline, err := imageproc.FromEnv()
+	if err != nil {
+		return err
+	}
+
+	avatarQueue, err := avatar.FromEnv(generator, pipeline)
+	if err != nil {
+		return err
+	}
+	if err := avatarQueue.Start(context.Background()); err != nil {
+		return fmt.Errorf("failed to start avatar queue: %v", err)
+	}
+
+	jobScheduler := scheduler.New()
+	if err := jobs.Register(jobScheduler, repos); err != nil {
+		return err
+	}
+	jobScheduler.Start(context.Background())
+
+	server := routes.NewServer(repos, pipeline)
+
 	router := mux.NewRouter()
 
-	routes.SetupUserRoutes(router)
-	routes.SetupImageRoutes(router)
+	routes.SetupAuthRoutes(router)
+	server.SetupUserRoutes(router)
+	server.SetupSubscriptionRoutes(router)
+	routes.SetupChainRoutes(router)
+	server.SetupMetadataRoutes(router)
+	routes.SetupImageRoutes(router, avatarQueue)
+	routes.SetupPinRoutes(router)
+	server.SetupMediaRoutes(router)
+	server.SetupPostRoutes(router)
+	routes.SetupAdminRoutes(router, jobScheduler)
 
 	c := cors.New(cors.Options{
 		AllowedOrigins: []string{"http://localhost:3000"},
@@ -41,7 +137,5 @@ func main() {
 	}
 
 	log.Printf("Server starting on port %s...", port)
-	if err := http.ListenAndServe(":"+port, handler); err != nil {
-		log.Fatal(err)
-	}
+	return http.ListenAndServe(":"+port, handler)
 }
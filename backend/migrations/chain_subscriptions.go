@@ -0,0 +1,202 @@
+package migrations
+
+import (
+	"context"
+	"log"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// legacySubscriptionCollections are the per-network collections that were
+// merged into "subscriptions" with a chain field.
+var legacySubscriptionCollections = []struct {
+	collection string
+	chain      string
+}{
+	{"subscriptions_zkevm", "zkevm"},
+	{"subscriptions_moonbeam", "moonbeam"},
+	{"subscriptions_metis", "metis"},
+}
+
+type chainSubscriptionsReport struct {
+	DryRun bool `json:"dry_run"`
+	// Tagged counts documents already in "subscriptions" that were given
+	// chain "default".
+	Tagged int64 `json:"tagged"`
+	// Copied, Skipped and Reassigned are keyed by chain. Skipped documents
+	// were copied by a previous run; Reassigned ones were copied under a new
+	// _id because theirs was taken, with the original kept in legacy_id.
+	Copied     map[string]int `json:"copied"`
+	Skipped    map[string]int `json:"skipped"`
+	Reassigned map[string]int `json:"reassigned"`
+}
+
+// mergeChainSubscriptions moves documents from the legacy per-network
+// collections into "subscriptions". It can be re-run safely and leaves the
+// legacy collections untouched so they can be dropped once verified.
+func mergeChainSubscriptions(ctx context.Context, database *mongo.Database, dryRun bool) (interface{}, error) {
+	report := &chainSubscriptionsReport{
+		DryRun:     dryRun,
+		Copied:     map[string]int{},
+		Skipped:    map[string]int{},
+		Reassigned: map[string]int{},
+	}
+
+	target := database.Collection("subscriptions")
+
+	untagged := bson.M{"chain": bson.M{"$exists": false}}
+	if dryRun {
+		count, err := target.CountDocuments(ctx, untagged)
+		if err != nil {
+			return nil, err
+		}
+		report.Tagged = count
+	} else {
+		result, err := target.UpdateMany(ctx, untagged, bson.M{"$set": bson.M{"chain": "default"}})
+		if err != nil {
+			return nil, err
+		}
+		report.Tagged = result.ModifiedCount
+	}
+
+	for _, legacy := range legacySubscriptionCollections {
+		cursor, err := database.Collection(legacy.collection).Find(ctx, bson.M{})
+		if err != nil {
+			return nil, err
+		}
+
+		for cursor.Next(ctx) {
+			var doc bson.M
+			if err := cursor.Decode(&doc); err != nil {
+				cursor.Close(ctx)
+				return nil, err
+			}
+
+			copied, reassigned, err := copyLegacySubscription(ctx, target, doc, legacy.chain, dryRun)
+			if err != nil {
+				cursor.Close(ctx)
+				return nil, err
+			}
+			switch {
+			case !copied:
+				report.Skipped[legacy.chain]++
+			case reassigned:
+				report.Reassigned[legacy.chain]++
+			default:
+				report.Copied[legacy.chain]++
+			}
+		}
+
+		if err := cursor.Err(); err != nil {
+			cursor.Close(ctx)
+			return nil, err
+		}
+		cursor.Close(ctx)
+
+		log.Printf("Migrated %s: %d copied, %d reassigned, %d already present",
+			legacy.collection, report.Copied[legacy.chain], report.Reassigned[legacy.chain], report.Skipped[legacy.chain])
+	}
+
+	return report, nil
+}
+
+func copyLegacySubscription(ctx context.Context, target *mongo.Collection, doc bson.M, chain string, dryRun bool) (copied bool, reassigned bool, err error) {
+	id := doc["_id"]
+
+	var existing bson.M
+	err = target.FindOne(ctx, bson.M{"_id": id}).Decode(&existing)
+	switch {
+	case err == mongo.ErrNoDocuments:
+	case err != nil:
+		return false, false, err
+	case existing["chain"] == chain:
+		return false, false, nil
+	default:
+		count, err := target.CountDocuments(ctx, bson.M{"chain": chain, "legacy_id": id})
+		if err != nil {
+			return false, false, err
+		}
+		if count > 0 {
+			return false, false, nil
+		}
+		delete(doc, "_id")
+		doc["legacy_id"] = id
+		reassigned = true
+	}
+
+	doc["chain"] = chain
+	if dryRun {
+		return true, reassigned, nil
+	}
+
+	if _, err := target.InsertOne(ctx, doc); err != nil {
+		return false, false, err
+	}
+	return true, reassigned, nil
+}
+
+type splitReport struct {
+	DryRun bool `json:"dry_run"`
+	// Untagged counts documents whose chain "default" was removed.
+	Untagged int64 `json:"untagged"`
+	// Removed is keyed by chain and counts copies of legacy documents
+	// deleted from "subscriptions".
+	Removed map[string]int64 `json:"removed"`
+}
+
+// splitChainSubscriptions reverses mergeChainSubscriptions: it deletes the
+// copies of legacy documents, which are still in their own collections,
+// and untags chain "default". Subscriptions recorded on the other chains
+// since the merge are kept.
+func splitChainSubscriptions(ctx context.Context, database *mongo.Database, dryRun bool) (interface{}, error) {
+	report := &splitReport{DryRun: dryRun, Removed: map[string]int64{}}
+	target := database.Collection("subscriptions")
+
+	for _, legacy := range legacySubscriptionCollections {
+		ids, err := database.Collection(legacy.collection).Distinct(ctx, "_id", bson.M{})
+		if err != nil {
+			return nil, err
+		}
+		if len(ids) == 0 {
+			continue
+		}
+
+		copies := bson.M{
+			"chain": legacy.chain,
+			"$or": []bson.M{
+				{"_id": bson.M{"$in": ids}},
+				{"legacy_id": bson.M{"$in": ids}},
+			},
+		}
+		if dryRun {
+			count, err := target.CountDocuments(ctx, copies)
+			if err != nil {
+				return nil, err
+			}
+			report.Removed[legacy.chain] = count
+			continue
+		}
+		result, err := target.DeleteMany(ctx, copies)
+		if err != nil {
+			return nil, err
+		}
+		report.Removed[legacy.chain] = result.DeletedCount
+	}
+
+	tagged := bson.M{"chain": "default"}
+	if dryRun {
+		count, err := target.CountDocuments(ctx, tagged)
+		if err != nil {
+			return nil, err
+		}
+		report.Untagged = count
+		return report, nil
+	}
+	result, err := target.UpdateMany(ctx, tagged, bson.M{"$unset": bson.M{"chain": ""}})
+	if err != nil {
+		return nil, err
+	}
+	report.Untagged = result.ModifiedCount
+	return report, nil
+}
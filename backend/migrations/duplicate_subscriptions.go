@@ -0,0 +1,94 @@
+package migrations
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type duplicateSubscriptionsReport struct {
+	DryRun bool `json:"dry_run"`
+	// Groups counts the chain, token and holder keys with more than one
+	// subscription.
+	Groups int `json:"groups"`
+	// Removed maps the _id of each subscription kept to those removed in
+	// its favour.
+	Removed map[string][]string `json:"removed"`
+}
+
+// duplicateSubscription is the part of a subscription that decides which
+// of its duplicates is kept.
+type duplicateSubscription struct {
+	ID        primitive.ObjectID `bson:"_id"`
+	ExpiresAt *time.Time         `bson:"expires_at"`
+}
+
+// outlasts reports whether s should be kept over other: a subscription
+// that never expires beats one that does, then the later expiry wins, then
+// the later-created one.
+func (s duplicateSubscription) outlasts(other duplicateSubscription) bool {
+	switch {
+	case (s.ExpiresAt == nil) != (other.ExpiresAt == nil):
+		return s.ExpiresAt == nil
+	case s.ExpiresAt != nil && !s.ExpiresAt.Equal(*other.ExpiresAt):
+		return s.ExpiresAt.After(*other.ExpiresAt)
+	}
+	// ObjectIDs start with their creation time, so later ones sort after.
+	return s.ID.Hex() > other.ID.Hex()
+}
+
+// removeDuplicateSubscriptions keeps one subscription per chain, token and
+// holder, so the {token_id, chain, user_id} index can be unique. Before the
+// purchase handler upserted on that key, recording the same purchase twice
+// inserted a second row. The one with the longest term is kept and the rest
+// are deleted, which cannot be rolled back.
+func removeDuplicateSubscriptions(ctx context.Context, database *mongo.Database, dryRun bool) (interface{}, error) {
+	report := &duplicateSubscriptionsReport{DryRun: dryRun, Removed: map[string][]string{}}
+	subscriptions := database.Collection("subscriptions")
+
+	cursor, err := subscriptions.Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$group", Value: bson.M{
+			"_id":  bson.M{"token_id": "$token_id", "chain": "$chain", "user_id": "$user_id"},
+			"subs": bson.M{"$push": bson.M{"_id": "$_id", "expires_at": "$expires_at"}},
+			"n":    bson.M{"$sum": 1},
+		}}},
+		{{Key: "$match", Value: bson.M{"n": bson.M{"$gt": 1}}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var groups []struct {
+		Subs []duplicateSubscription `bson:"subs"`
+	}
+	if err := cursor.All(ctx, &groups); err != nil {
+		return nil, err
+	}
+
+	for _, group := range groups {
+		report.Groups++
+		kept := group.Subs[0]
+		for _, sub := range group.Subs[1:] {
+			if sub.outlasts(kept) {
+				kept = sub
+			}
+		}
+
+		var removed []primitive.ObjectID
+		for _, sub := range group.Subs {
+			if sub.ID != kept.ID {
+				removed = append(removed, sub.ID)
+				report.Removed[kept.ID.Hex()] = append(report.Removed[kept.ID.Hex()], sub.ID.Hex())
+			}
+		}
+		if dryRun {
+			continue
+		}
+		if _, err := subscriptions.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": removed}}); err != nil {
+			return nil, err
+		}
+	}
+	return report, nil
+}
@@ -0,0 +1,224 @@
+// Package migrations holds the ordered, versioned changes to the MongoDB
+// data and records which have been applied in "schema_migrations".
+package migrations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const collectionName = "schema_migrations"
+
+// ErrIrreversible is returned when rolling back a migration without Down.
+var ErrIrreversible = errors.New("migration cannot be rolled back")
+
+// Migration is one versioned change. Up and Down return a report of what
+// they changed, or with dryRun set, what they would change without writing.
+type Migration struct {
+	Version int
+	Name    string
+	Up      func(ctx context.Context, database *mongo.Database, dryRun bool) (interface{}, error)
+	// Down reverses Up. Migrations without one cannot be rolled back.
+	Down func(ctx context.Context, database *mongo.Database, dryRun bool) (interface{}, error)
+}
+
+// all lists every migration in version order. Versions are never reused
+// or renumbered once released.
+var all = []Migration{
+	{
+		Version: 1,
+		Name:    "merge-chain-subscriptions",
+		Up:      mergeChainSubscriptions,
+		Down:    splitChainSubscriptions,
+	},
+	{
+		Version: 2,
+		Name:    "lowercase-subscription-tx-hashes",
+		Up:      lowercaseTxHashes,
+	},
+	{
+		Version: 3,
+		Name:    "lowercase-wallet-addresses",
+		Up:      lowercaseWalletAddresses,
+	},
+	{
+		Version: 4,
+		Name:    "remove-duplicate-subscriptions",
+		Up:      removeDuplicateSubscriptions,
+	},
+}
+
+// record is a document in "schema_migrations".
+type record struct {
+	Version   int       `bson:"_id"`
+	Name      string    `bson:"name"`
+	AppliedAt time.Time `bson:"applied_at"`
+}
+
+// State is a migration's status in one database.
+type State struct {
+	Version    int        `json:"version"`
+	Name       string     `json:"name"`
+	Applied    bool       `json:"applied"`
+	AppliedAt  *time.Time `json:"applied_at,omitempty"`
+	Reversible bool       `json:"reversible"`
+	// Unknown is set for versions recorded as applied that this build has
+	// no migration for, e.g. after rolling the code back.
+	Unknown bool `json:"unknown,omitempty"`
+}
+
+// Result reports one migration run.
+type Result struct {
+	Version   int         `json:"version"`
+	Name      string      `json:"name"`
+	Direction string      `json:"direction"`
+	DryRun    bool        `json:"dry_run"`
+	Report    interface{} `json:"report,omitempty"`
+	Duration  string      `json:"duration"`
+}
+
+func applied(ctx context.Context, database *mongo.Database) (map[int]record, error) {
+	cursor, err := database.Collection(collectionName).Find(ctx, bson.M{})
+	if err != nil {
+		return nil, err
+	}
+	var records []record
+	if err := cursor.All(ctx, &records); err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int]record, len(records))
+	for _, r := range records {
+		byVersion[r.Version] = r
+	}
+	return byVersion, nil
+}
+
+// Status lists every known migration, and any unknown applied version, in
+// version order.
+func Status(ctx context.Context, database *mongo.Database) ([]State, error) {
+	done, err := applied(ctx, database)
+	if err != nil {
+		return nil, err
+	}
+
+	states := make([]State, 0, len(all))
+	for _, m := range all {
+		state := State{Version: m.Version, Name: m.Name, Reversible: m.Down != nil}
+		if r, ok := done[m.Version]; ok {
+			state.Applied = true
+			state.AppliedAt = &r.AppliedAt
+			delete(done, m.Version)
+		}
+		states = append(states, state)
+	}
+	for _, r := range done {
+		appliedAt := r.AppliedAt
+		states = append(states, State{Version: r.Version, Name: r.Name, Applied: true, AppliedAt: &appliedAt, Unknown: true})
+	}
+
+	sort.Slice(states, func(i, j int) bool { return states[i].Version < states[j].Version })
+	return states, nil
+}
+
+// Up applies the pending migrations up to and including version target (0
+// for all), oldest first, stopping at the first failure. With dryRun the
+// migrations only report and nothing is recorded.
+func Up(ctx context.Context, database *mongo.Database, target int, dryRun bool) ([]Result, error) {
+	done, err := applied(ctx, database)
+	if err != nil {
+		return nil, err
+	}
+
+	results := []Result{}
+	for _, m := range all {
+		if target > 0 && m.Version > target {
+			break
+		}
+		if _, ok := done[m.Version]; ok {
+			continue
+		}
+
+		result, err := run(ctx, database, m, "up", m.Up, dryRun)
+		if err != nil {
+			return results, err
+		}
+		results = append(results, result)
+
+		if !dryRun {
+			_, err := database.Collection(collectionName).InsertOne(ctx, record{
+				Version:   m.Version,
+				Name:      m.Name,
+				AppliedAt: time.Now(),
+			})
+			if err != nil {
+				return results, fmt.Errorf("migration %d applied but not recorded: %v", m.Version, err)
+			}
+		}
+	}
+	return results, nil
+}
+
+// Down rolls back the applied migrations above version target, newest
+// first. A negative target rolls back only the latest applied migration.
+func Down(ctx context.Context, database *mongo.Database, target int, dryRun bool) ([]Result, error) {
+	done, err := applied(ctx, database)
+	if err != nil {
+		return nil, err
+	}
+
+	results := []Result{}
+	for i := len(all) - 1; i >= 0; i-- {
+		m := all[i]
+		if _, ok := done[m.Version]; !ok {
+			continue
+		}
+		if target >= 0 && m.Version <= target {
+			break
+		}
+		if m.Down == nil {
+			return results, fmt.Errorf("%w: %d %s", ErrIrreversible, m.Version, m.Name)
+		}
+
+		result, err := run(ctx, database, m, "down", m.Down, dryRun)
+		if err != nil {
+			return results, err
+		}
+		results = append(results, result)
+
+		if !dryRun {
+			if _, err := database.Collection(collectionName).DeleteOne(ctx, bson.M{"_id": m.Version}); err != nil {
+				return results, fmt.Errorf("migration %d rolled back but still recorded: %v", m.Version, err)
+			}
+		}
+		if target < 0 {
+			break
+		}
+	}
+	return results, nil
+}
+
+func run(ctx context.Context, database *mongo.Database, m Migration, direction string, step func(context.Context, *mongo.Database, bool) (interface{}, error), dryRun bool) (Result, error) {
+	log.Printf("Migration %d %s: %s (dry run: %t)", m.Version, m.Name, direction, dryRun)
+
+	start := time.Now()
+	report, err := step(ctx, database, dryRun)
+	if err != nil {
+		return Result{}, fmt.Errorf("migration %d %s %s failed: %v", m.Version, m.Name, direction, err)
+	}
+	return Result{
+		Version:   m.Version,
+		Name:      m.Name,
+		Direction: direction,
+		DryRun:    dryRun,
+		Report:    report,
+		Duration:  time.Since(start).Round(time.Millisecond).String(),
+	}, nil
+}
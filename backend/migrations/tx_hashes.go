@@ -0,0 +1,49 @@
+package migrations
+
+import (
+	"context"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type txHashReport struct {
+	DryRun     bool  `json:"dry_run"`
+	Lowercased int64 `json:"lowercased"`
+}
+
+// lowercaseTxHashes lowercases subscriptions.tx_hash. Purchases are matched
+// against it lowercased, so a hash recorded with checksum casing would let
+// the same transaction be claimed twice. The original casing carries no
+// information, so there is nothing to roll back.
+func lowercaseTxHashes(ctx context.Context, database *mongo.Database, dryRun bool) (interface{}, error) {
+	report := &txHashReport{DryRun: dryRun}
+	subscriptions := database.Collection("subscriptions")
+
+	cursor, err := subscriptions.Find(ctx, bson.M{"tx_hash": bson.M{"$regex": "[A-F]"}})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var doc struct {
+			ID     interface{} `bson:"_id"`
+			TxHash string      `bson:"tx_hash"`
+		}
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, err
+		}
+
+		report.Lowercased++
+		if dryRun {
+			continue
+		}
+		_, err := subscriptions.UpdateOne(ctx, bson.M{"_id": doc.ID}, bson.M{"$set": bson.M{"tx_hash": strings.ToLower(doc.TxHash)}})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return report, cursor.Err()
+}
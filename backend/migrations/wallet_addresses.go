@@ -0,0 +1,84 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type walletAddressReport struct {
+	DryRun     bool             `json:"dry_run"`
+	Lowercased map[string]int64 `json:"lowercased"`
+	// Conflicts lists addresses held by more than one document once
+	// lower-cased, which have to be resolved by hand.
+	Conflicts map[string][]string `json:"conflicts,omitempty"`
+}
+
+// lowercaseWalletAddresses lowercases users.wallet_address and
+// models.wallet_address, which are looked up by equality against the
+// unique index. Documents whose addresses only differ in case are the same
+// wallet registered twice; they are reported and nothing is changed until
+// they are merged by hand. The original casing carries no information, so
+// there is nothing to roll back.
+func lowercaseWalletAddresses(ctx context.Context, database *mongo.Database, dryRun bool) (interface{}, error) {
+	report := &walletAddressReport{
+		DryRun:     dryRun,
+		Lowercased: map[string]int64{},
+		Conflicts:  map[string][]string{},
+	}
+
+	type change struct {
+		collection *mongo.Collection
+		id         interface{}
+		address    string
+	}
+	var changes []change
+
+	for _, name := range []string{"users", "models"} {
+		collection := database.Collection(name)
+		cursor, err := collection.Find(ctx, bson.M{})
+		if err != nil {
+			return nil, err
+		}
+
+		byAddress := map[string][]interface{}{}
+		var docs []struct {
+			ID            interface{} `bson:"_id"`
+			WalletAddress string      `bson:"wallet_address"`
+		}
+		if err := cursor.All(ctx, &docs); err != nil {
+			return nil, err
+		}
+		for _, doc := range docs {
+			address := strings.ToLower(doc.WalletAddress)
+			byAddress[address] = append(byAddress[address], doc.ID)
+			if address != doc.WalletAddress {
+				changes = append(changes, change{collection, doc.ID, address})
+				report.Lowercased[name]++
+			}
+		}
+		for address, ids := range byAddress {
+			if len(ids) > 1 {
+				report.Conflicts[name] = append(report.Conflicts[name], fmt.Sprintf("%s (%d documents)", address, len(ids)))
+			}
+		}
+	}
+
+	if len(report.Conflicts) > 0 && !dryRun {
+		return report, fmt.Errorf("wallet addresses registered more than once: %v", report.Conflicts)
+	}
+	if dryRun {
+		return report, nil
+	}
+
+	for _, c := range changes {
+		_, err := c.collection.UpdateOne(ctx, bson.M{"_id": c.id}, bson.M{"$set": bson.M{"wallet_address": c.address}})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return report, nil
+}
@@ -0,0 +1,57 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Asset access tiers. Private assets, the default, are only visible to
+// their owner; subscriber assets show everyone else a blurred preview.
+const (
+	AssetPrivate     = "private"
+	AssetPublic      = "public"
+	AssetSubscribers = "subscribers"
+)
+
+// Asset is a stored file, referenced by its opaque ID. The content lives in
+// the asset store under Hash, which several assets may share.
+type Asset struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	Hash        string             `bson:"hash" json:"hash"`
+	ContentType string             `bson:"content_type" json:"contentType"`
+	Size        int64              `bson:"size" json:"size"`
+	Name        string             `bson:"name,omitempty" json:"name,omitempty"`
+	OwnerWallet string             `bson:"owner_wallet" json:"ownerWallet"`
+	// Temporary assets, such as freshly generated avatars, are removed by
+	// the cleanup job unless they are published first.
+	Temporary bool `bson:"temporary" json:"temporary"`
+	// Generator and Prompt record how a generated image was made.
+	Generator string    `bson:"generator,omitempty" json:"generator,omitempty"`
+	Prompt    string    `bson:"prompt,omitempty" json:"prompt,omitempty"`
+	Width     int       `bson:"width,omitempty" json:"width,omitempty"`
+	Height    int       `bson:"height,omitempty" json:"height,omitempty"`
+	CreatedAt time.Time `bson:"created_at" json:"createdAt"`
+	// Renditions is set on the asset handed to clients and lists every
+	// rendition of the image by name, including the asset itself. The
+	// other renditions are separate assets whose SourceID points back.
+	Renditions map[string]Rendition `bson:"renditions,omitempty" json:"renditions,omitempty"`
+	SourceID   *primitive.ObjectID  `bson:"source_id,omitempty" json:"sourceId,omitempty"`
+	// Access is the tier served by /media; empty means AssetPrivate. For
+	// AssetSubscribers, ModelID is the model whose subscribers see the
+	// original and OptionIDs, when set, the subscription options they must
+	// hold one of.
+	Access    string               `bson:"access,omitempty" json:"access,omitempty"`
+	ModelID   *primitive.ObjectID  `bson:"model_id,omitempty" json:"modelId,omitempty"`
+	OptionIDs []primitive.ObjectID `bson:"option_ids,omitempty" json:"optionIds,omitempty"`
+}
+
+type Rendition struct {
+	AssetID     string `bson:"asset_id" json:"assetId"`
+	ContentType string `bson:"content_type" json:"contentType"`
+	Width       int    `bson:"width" json:"width"`
+	Height      int    `bson:"height" json:"height"`
+	Size        int64  `bson:"size" json:"size"`
+	// URL is the public copy, set once the asset is published.
+	URL string `bson:"url,omitempty" json:"url,omitempty"`
+}
@@ -0,0 +1,31 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	AvatarJobQueued    = "queued"
+	AvatarJobRunning   = "running"
+	AvatarJobSucceeded = "succeeded"
+	AvatarJobFailed    = "failed"
+)
+
+// AvatarJob is a queued avatar generation, stored in "avatar_jobs".
+type AvatarJob struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	Name        string             `bson:"name" json:"name"`
+	Prompt      string             `bson:"prompt" json:"prompt"`
+	OwnerWallet string             `bson:"owner_wallet" json:"ownerWallet"`
+	Status      string             `bson:"status" json:"status"`
+	Generator   string             `bson:"generator,omitempty" json:"generator,omitempty"`
+	// AssetID is the stored image, set on success.
+	AssetID    string     `bson:"asset_id,omitempty" json:"assetId,omitempty"`
+	Error      string     `bson:"error,omitempty" json:"error,omitempty"`
+	Attempts   int        `bson:"attempts" json:"attempts"`
+	CreatedAt  time.Time  `bson:"created_at" json:"createdAt"`
+	StartedAt  *time.Time `bson:"started_at,omitempty" json:"startedAt,omitempty"`
+	FinishedAt *time.Time `bson:"finished_at,omitempty" json:"finishedAt,omitempty"`
+}
@@ -0,0 +1,33 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	PinPinned   = "pinned"
+	PinUnpinned = "unpinned"
+)
+
+// Pin records content the platform pinned to IPFS, stored in "pins".
+type Pin struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	CID         string             `bson:"cid" json:"cid"`
+	Name        string             `bson:"name" json:"name"`
+	Size        int64              `bson:"size" json:"size"`
+	OwnerWallet string             `bson:"owner_wallet" json:"ownerWallet"`
+	Provider    string             `bson:"provider" json:"provider"`
+	// Status is PinPinned or PinUnpinned; records written before it existed
+	// have none and are pinned.
+	Status string `bson:"status,omitempty" json:"status"`
+	// ParentCID is the pin that references this one, e.g. the metadata
+	// JSON an image was pinned for. A pin is kept as long as its parent is.
+	ParentCID string `bson:"parent_cid,omitempty" json:"parentCid,omitempty"`
+	// UserID and ModelID link the pin to the account registered with it.
+	UserID     *primitive.ObjectID `bson:"user_id,omitempty" json:"userId,omitempty"`
+	ModelID    *primitive.ObjectID `bson:"model_id,omitempty" json:"modelId,omitempty"`
+	CreatedAt  time.Time           `bson:"created_at" json:"createdAt"`
+	UnpinnedAt *time.Time          `bson:"unpinned_at,omitempty" json:"unpinnedAt,omitempty"`
+}
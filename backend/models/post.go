@@ -0,0 +1,30 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Post visibilities. Subscriber posts may be narrowed to holders of
+// particular subscription options.
+const (
+	PostPublic      = "public"
+	PostSubscribers = "subscribers"
+)
+
+// Post is something a model published for its viewers, stored in "posts".
+type Post struct {
+	ID primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	// ModelID is the model's _id, as on subscriptions.
+	ModelID       primitive.ObjectID `bson:"model_id" json:"modelId"`
+	WalletAddress string             `bson:"wallet_address" json:"walletAddress"`
+	Text          string             `bson:"text" json:"text"`
+	// AssetIDs are the hex ids of the attached media, served by /media.
+	AssetIDs   []string `bson:"asset_ids,omitempty" json:"assetIds,omitempty"`
+	Visibility string   `bson:"visibility" json:"visibility"`
+	// OptionIDs are the subscription options whose holders can see a
+	// subscriber post. Empty means any active subscriber.
+	OptionIDs []primitive.ObjectID `bson:"option_ids,omitempty" json:"optionIds,omitempty"`
+	CreatedAt time.Time            `bson:"created_at" json:"createdAt"`
+}
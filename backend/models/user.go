@@ -1,6 +1,8 @@
 package models
 
 import (
+	"time"
+
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
@@ -38,36 +40,50 @@ type Model struct {
 
 type Subscription struct {
 	ID        primitive.ObjectID `bson:"_id,omitempty" json:"id,omitempty"`
+	Chain     string             `bson:"chain" json:"chain"`
 	UserID    primitive.ObjectID `bson:"user_id" json:"user_id"`
 	ModelID   primitive.ObjectID `bson:"model_id" json:"model_id"`
 	TokenID   string             `bson:"token_id" json:"token_id"`
+	TxHash    string             `bson:"tx_hash,omitempty" json:"tx_hash,omitempty"`
 	ListingID string             `bson:"listing_id,omitempty" json:"listing_id,omitempty"`
 	Price     string             `bson:"price,omitempty" json:"price,omitempty"`
 	IsListed  bool               `bson:"is_listed" json:"is_listed"`
+	StartsAt  *time.Time         `bson:"starts_at,omitempty" json:"starts_at,omitempty"`
+	ExpiresAt *time.Time         `bson:"expires_at,omitempty" json:"expires_at,omitempty"`
+	// Expired is set, and the subscription delisted, by the expiry sweep
+	// once ExpiresAt has passed.
+	Expired bool `bson:"expired,omitempty" json:"expired,omitempty"`
+	// HeldOnChain records whether the holder's wallet still owned the token
+	// when the reconciliation job last checked, at ReconciledAt.
+	HeldOnChain  *bool      `bson:"held_on_chain,omitempty" json:"held_on_chain,omitempty"`
+	ReconciledAt *time.Time `bson:"reconciled_at,omitempty" json:"reconciled_at,omitempty"`
+	// OptionID is the subscription option bought, when the purchase named
+	// one. Subscriptions recorded by the indexer have none.
+	OptionID *primitive.ObjectID `bson:"subscription_option_id,omitempty" json:"subscription_option_id,omitempty"`
 }
 
-type SubscriptionMetis struct {
-	ID       primitive.ObjectID `bson:"_id,omitempty" json:"id,omitempty"`
-	UserID   primitive.ObjectID `bson:"user_id" json:"user_id"`
-	ModelID  primitive.ObjectID `bson:"model_id" json:"model_id"`
-	TokenID  string             `bson:"token_id" json:"token_id"`
-	Price    string             `bson:"price,omitempty" json:"price,omitempty"`
-	IsListed bool               `bson:"is_listed" json:"is_listed"`
+// SubscriptionOption is a plan a model offers, stored in
+// "subscription_options". ModelID is the model's model_id and Duration is
+// in days.
+type SubscriptionOption struct {
+	ID          primitive.ObjectID `bson:"_id" json:"id"`
+	ModelID     string             `bson:"model_id" json:"modelId"`
+	Price       string             `bson:"price" json:"price"`
+	Duration    int                `bson:"duration" json:"duration"`
+	Description string             `bson:"description" json:"description"`
+	CreatedAt   time.Time          `bson:"created_at" json:"createdAt"`
 }
 
-type SubscriptionMoonbeam struct {
-	ID       primitive.ObjectID `bson:"_id,omitempty" json:"id,omitempty"`
-	UserID   primitive.ObjectID `bson:"user_id" json:"user_id"`
-	ModelID  primitive.ObjectID `bson:"model_id" json:"model_id"`
-	TokenID  string             `bson:"token_id" json:"token_id"`
-	Price    string             `bson:"price,omitempty" json:"price,omitempty"`
-	IsListed bool               `bson:"is_listed" json:"is_listed"`
-}
+const (
+	SubscriptionActive  = "active"
+	SubscriptionExpired = "expired"
+)
 
-type SubscriptionZkEVM struct {
-	ID       primitive.ObjectID `bson:"_id,omitempty" json:"id,omitempty"`
-	UserID   primitive.ObjectID `bson:"user_id" json:"user_id"`
-	ModelID  primitive.ObjectID `bson:"model_id" json:"model_id"`
-	TokenID  string             `bson:"token_id" json:"token_id"`
-	IsListed bool               `bson:"is_listed" json:"is_listed"`
+// Status reports whether the subscription has expired at now. Subscriptions
+// recorded before expiry was tracked have no ExpiresAt and stay active.
+func (s Subscription) Status(now time.Time) string {
+	if s.ExpiresAt != nil && !now.Before(*s.ExpiresAt) {
+		return SubscriptionExpired
+	}
+	return SubscriptionActive
 }
@@ -0,0 +1,234 @@
+// Package nftmeta builds token metadata JSON in the shape OpenSea and the
+// ERC-1155 metadata URI JSON schema expect, rejecting what they would not
+// accept.
+package nftmeta
+
+import (
+	"fmt"
+	"math"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"arjunmal1311/fans_flow_on_chain/backend/types"
+)
+
+const (
+	maxAttributes     = 100
+	maxTraitLength    = 100
+	maxValueLength    = 1000
+	maxNameLength     = 200
+	maxDescriptionLen = 10000
+)
+
+// Display types OpenSea renders; each needs a numeric value.
+var displayTypes = map[string]bool{
+	"number":           true,
+	"boost_number":     true,
+	"boost_percentage": true,
+	"date":             true,
+}
+
+var colorPattern = regexp.MustCompile(`^[0-9a-fA-F]{6}$`)
+
+// Input is the caller-supplied part of a token's metadata.
+type Input struct {
+	Name            string
+	Description     string
+	ExternalURL     string
+	AnimationURL    string
+	BackgroundColor string
+	Attributes      []types.TokenAttribute
+	Properties      map[string]interface{}
+	// Generator and Prompt record how the image was made; they are stored
+	// in properties.
+	Generator string
+	Prompt    string
+}
+
+// ValidationError lists every problem found, so a client can fix them all
+// at once.
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return "invalid metadata: " + strings.Join(e.Problems, "; ")
+}
+
+// Validate checks the caller-supplied fields.
+func (in Input) Validate() error {
+	v := &ValidationError{}
+
+	switch {
+	case strings.TrimSpace(in.Name) == "":
+		v.add("name is required")
+	case len(in.Name) > maxNameLength:
+		v.add("name is longer than %d characters", maxNameLength)
+	}
+	if len(in.Description) > maxDescriptionLen {
+		v.add("description is longer than %d characters", maxDescriptionLen)
+	}
+
+	v.checkURL("external_url", in.ExternalURL)
+	v.checkURL("animation_url", in.AnimationURL)
+	if in.BackgroundColor != "" && !colorPattern.MatchString(in.BackgroundColor) {
+		v.add("background_color must be six hexadecimal digits without a leading #")
+	}
+
+	if len(in.Attributes) > maxAttributes {
+		v.add("at most %d attributes are allowed", maxAttributes)
+	}
+	for i, attr := range in.Attributes {
+		v.checkAttribute(i, attr)
+	}
+
+	if len(v.Problems) > 0 {
+		return v
+	}
+	return nil
+}
+
+// Build validates in and returns the metadata for image. Without
+// caller-supplied attributes the platform's defaults are used.
+func Build(in Input, image string) (*types.TokenMetadata, error) {
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+
+	v := &ValidationError{}
+	if image == "" {
+		v.add("image is required")
+	}
+	v.checkURL("image", image)
+	if len(v.Problems) > 0 {
+		return nil, v
+	}
+
+	attributes := in.Attributes
+	if len(attributes) == 0 {
+		attributes = []types.TokenAttribute{
+			{TraitType: "Category", Value: "Art"},
+			{TraitType: "Style", Value: "Generated"},
+		}
+		if in.Generator != "" {
+			attributes = append(attributes, types.TokenAttribute{TraitType: "Model", Value: in.Generator})
+		}
+	}
+
+	properties := map[string]interface{}{}
+	for key, value := range in.Properties {
+		properties[key] = value
+	}
+	if in.Generator != "" {
+		properties["generator"] = in.Generator
+	}
+	if in.Prompt != "" {
+		properties["prompt"] = in.Prompt
+	}
+
+	return &types.TokenMetadata{
+		Name:            strings.TrimSpace(in.Name),
+		Description:     in.Description,
+		Image:           image,
+		ExternalURL:     in.ExternalURL,
+		AnimationURL:    in.AnimationURL,
+		BackgroundColor: strings.ToLower(in.BackgroundColor),
+		Decimals:        0,
+		Properties:      properties,
+		Attributes:      attributes,
+	}, nil
+}
+
+func (v *ValidationError) add(format string, args ...interface{}) {
+	v.Problems = append(v.Problems, fmt.Sprintf(format, args...))
+}
+
+// checkURL accepts the schemes marketplaces resolve: http(s), ipfs and ar.
+func (v *ValidationError) checkURL(field, raw string) {
+	if raw == "" {
+		return
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		v.add("%s is not a valid URL", field)
+		return
+	}
+	switch u.Scheme {
+	case "http", "https":
+		if u.Host == "" {
+			v.add("%s has no host", field)
+		}
+	case "ipfs", "ar":
+	default:
+		v.add("%s must be an http, https, ipfs or ar URL", field)
+	}
+}
+
+func (v *ValidationError) checkAttribute(i int, attr types.TokenAttribute) {
+	name := fmt.Sprintf("attributes[%d]", i)
+
+	switch {
+	case strings.TrimSpace(attr.TraitType) == "":
+		v.add("%s.trait_type is required", name)
+	case len(attr.TraitType) > maxTraitLength:
+		v.add("%s.trait_type is longer than %d characters", name, maxTraitLength)
+	}
+
+	number, isNumber := numeric(attr.Value)
+	switch value := attr.Value.(type) {
+	case nil:
+		v.add("%s.value is required", name)
+		return
+	case string:
+		if len(value) > maxValueLength {
+			v.add("%s.value is longer than %d characters", name, maxValueLength)
+		}
+	case bool:
+	default:
+		if !isNumber {
+			v.add("%s.value must be a string, number or boolean", name)
+			return
+		}
+	}
+
+	if attr.DisplayType != "" {
+		if !displayTypes[attr.DisplayType] {
+			v.add("%s.display_type must be number, boost_number, boost_percentage or date", name)
+		} else if !isNumber {
+			v.add("%s.value must be a number for display_type %s", name, attr.DisplayType)
+		} else if attr.DisplayType == "date" && (number < 0 || number != math.Trunc(number)) {
+			v.add("%s.value must be a Unix timestamp in seconds for display_type date", name)
+		}
+	}
+
+	if attr.MaxValue != nil {
+		max, ok := numeric(attr.MaxValue)
+		switch {
+		case !ok:
+			v.add("%s.max_value must be a number", name)
+		case !isNumber:
+			v.add("%s.max_value needs a numeric value", name)
+		case number > max:
+			v.add("%s.value is greater than max_value", name)
+		}
+	}
+}
+
+// numeric reports the value of JSON numbers, which decode as float64, and
+// of the integer types handlers build attributes from.
+func numeric(value interface{}) (float64, bool) {
+	switch n := value.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case uint64:
+		return float64(n), true
+	}
+	return 0, false
+}
@@ -0,0 +1,207 @@
+package nftmeta
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"arjunmal1311/fans_flow_on_chain/backend/types"
+)
+
+const testImage = "ipfs://bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi"
+
+// problems returns the problems Validate reports for in, nil when there
+// are none.
+func problems(t *testing.T, in Input) []string {
+	t.Helper()
+	err := in.Validate()
+	if err == nil {
+		return nil
+	}
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("Validate error %T, want *ValidationError", err)
+	}
+	return verr.Problems
+}
+
+func TestValidateAttributes(t *testing.T) {
+	tests := []struct {
+		name string
+		attr types.TokenAttribute
+		// problem is a substring of the only problem expected, empty when
+		// the attribute is valid.
+		problem string
+	}{
+		{"string", types.TokenAttribute{TraitType: "Style", Value: "Generated"}, ""},
+		{"boolean", types.TokenAttribute{TraitType: "Animated", Value: false}, ""},
+		{"JSON number", types.TokenAttribute{TraitType: "Level", Value: 3.5}, ""},
+		{"no trait type", types.TokenAttribute{TraitType: " ", Value: "x"}, "attributes[0].trait_type is required"},
+		{"long trait type", types.TokenAttribute{TraitType: strings.Repeat("t", maxTraitLength+1), Value: "x"}, "trait_type is longer than 100"},
+		{"no value", types.TokenAttribute{TraitType: "Style"}, "attributes[0].value is required"},
+		{"long value", types.TokenAttribute{TraitType: "Style", Value: strings.Repeat("v", maxValueLength+1)}, "value is longer than 1000"},
+		{"object value", types.TokenAttribute{TraitType: "Style", Value: map[string]interface{}{}}, "value must be a string, number or boolean"},
+
+		{"number", types.TokenAttribute{TraitType: "Level", Value: 5, DisplayType: "number"}, ""},
+		{"boost_number", types.TokenAttribute{TraitType: "Power", Value: int64(-10), DisplayType: "boost_number"}, ""},
+		{"boost_percentage", types.TokenAttribute{TraitType: "Growth", Value: float32(12.5), DisplayType: "boost_percentage"}, ""},
+		{"unknown display type", types.TokenAttribute{TraitType: "Level", Value: 5, DisplayType: "ranking"}, "display_type must be number, boost_number, boost_percentage or date"},
+		{"display type with a string", types.TokenAttribute{TraitType: "Level", Value: "5", DisplayType: "number"}, "value must be a number for display_type number"},
+		{"display type with a boolean", types.TokenAttribute{TraitType: "Level", Value: true, DisplayType: "boost_number"}, "value must be a number for display_type boost_number"},
+
+		{"date", types.TokenAttribute{TraitType: "Birthday", Value: 1546360800, DisplayType: "date"}, ""},
+		{"date as a JSON number", types.TokenAttribute{TraitType: "Birthday", Value: float64(1546360800), DisplayType: "date"}, ""},
+		{"date as a uint64", types.TokenAttribute{TraitType: "Birthday", Value: uint64(0), DisplayType: "date"}, ""},
+		{"date before 1970", types.TokenAttribute{TraitType: "Birthday", Value: -1, DisplayType: "date"}, "must be a Unix timestamp in seconds"},
+		{"date with fractions", types.TokenAttribute{TraitType: "Birthday", Value: 1546360800.5, DisplayType: "date"}, "must be a Unix timestamp in seconds"},
+		{"date as a string", types.TokenAttribute{TraitType: "Birthday", Value: "2019-01-01", DisplayType: "date"}, "value must be a number for display_type date"},
+
+		{"max_value", types.TokenAttribute{TraitType: "Level", Value: 5, MaxValue: 10}, ""},
+		{"value equal to max_value", types.TokenAttribute{TraitType: "Level", Value: 10.0, MaxValue: int64(10)}, ""},
+		{"value above max_value", types.TokenAttribute{TraitType: "Level", Value: 11, MaxValue: 10}, "value is greater than max_value"},
+		{"string max_value", types.TokenAttribute{TraitType: "Level", Value: 5, MaxValue: "10"}, "max_value must be a number"},
+		{"max_value for a string", types.TokenAttribute{TraitType: "Level", Value: "five", MaxValue: 10}, "max_value needs a numeric value"},
+	}
+
+	for _, tt := range tests {
+		got := problems(t, Input{Name: "Token", Attributes: []types.TokenAttribute{tt.attr}})
+		switch {
+		case tt.problem == "" && got != nil:
+			t.Errorf("%s: %q", tt.name, got)
+		case tt.problem != "" && (len(got) != 1 || !strings.Contains(got[0], tt.problem)):
+			t.Errorf("%s: %q, want one problem containing %q", tt.name, got, tt.problem)
+		}
+	}
+}
+
+func TestValidateFields(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      Input
+		problem string
+	}{
+		{"name only", Input{Name: "Token"}, ""},
+		{"no name", Input{Name: "  "}, "name is required"},
+		{"long name", Input{Name: strings.Repeat("n", maxNameLength+1)}, "name is longer than 200"},
+		{"long description", Input{Name: "Token", Description: strings.Repeat("d", maxDescriptionLen+1)}, "description is longer than 10000"},
+
+		{"https URL", Input{Name: "Token", ExternalURL: "https://fansflow.example/models/7"}, ""},
+		{"http URL", Input{Name: "Token", ExternalURL: "http://localhost:3000/models/7"}, ""},
+		{"ipfs URL", Input{Name: "Token", AnimationURL: "ipfs://bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi/clip.mp4"}, ""},
+		{"arweave URL", Input{Name: "Token", AnimationURL: "ar://bNbA3TEQVL60xlgCcqdz4ZPHFZ711cZ3hmkpGttDt_U"}, ""},
+		{"javascript URL", Input{Name: "Token", ExternalURL: "javascript:alert(1)"}, "external_url must be an http, https, ipfs or ar URL"},
+		{"data URL", Input{Name: "Token", AnimationURL: "data:text/html,<script></script>"}, "animation_url must be an http, https, ipfs or ar URL"},
+		{"relative URL", Input{Name: "Token", ExternalURL: "/models/7"}, "external_url must be an http, https, ipfs or ar URL"},
+		{"URL without a host", Input{Name: "Token", ExternalURL: "https:///models/7"}, "external_url has no host"},
+		{"unparsable URL", Input{Name: "Token", ExternalURL: "https://exa mple.com/%zz"}, "external_url is not a valid URL"},
+
+		{"background_color", Input{Name: "Token", BackgroundColor: "1A2b3C"}, ""},
+		{"background_color with #", Input{Name: "Token", BackgroundColor: "#1a2b3c"}, "background_color must be six hexadecimal digits"},
+		{"short background_color", Input{Name: "Token", BackgroundColor: "fff"}, "background_color must be six hexadecimal digits"},
+		{"background_color not hex", Input{Name: "Token", BackgroundColor: "gggggg"}, "background_color must be six hexadecimal digits"},
+
+		{"too many attributes", Input{Name: "Token", Attributes: make([]types.TokenAttribute, maxAttributes+1)}, "at most 100 attributes"},
+	}
+
+	for _, tt := range tests {
+		got := problems(t, tt.in)
+		switch {
+		case tt.problem == "" && got != nil:
+			t.Errorf("%s: %q", tt.name, got)
+		case tt.problem != "" && (len(got) == 0 || !strings.Contains(got[0], tt.problem)):
+			t.Errorf("%s: %q, want a first problem containing %q", tt.name, got, tt.problem)
+		}
+	}
+
+	// Every problem is reported at once.
+	got := problems(t, Input{ExternalURL: "ftp://example.com", BackgroundColor: "red", Attributes: []types.TokenAttribute{{Value: "x"}, {TraitType: "Level", Value: 11, MaxValue: 10}}})
+	if len(got) != 5 {
+		t.Errorf("problems %q, want 5", got)
+	}
+}
+
+func TestBuild(t *testing.T) {
+	in := Input{
+		Name:            "  Sunset #1 ",
+		Description:     "A sunset.",
+		ExternalURL:     "https://fansflow.example/models/7",
+		BackgroundColor: "FFAA00",
+		Properties:      map[string]interface{}{"edition": 1.0, "generator": "overwritten"},
+		Generator:       "imagepig",
+		Prompt:          "a sunset over the sea",
+	}
+
+	meta, err := Build(in, testImage)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if meta.Name != "Sunset #1" || meta.Image != testImage || meta.BackgroundColor != "ffaa00" || meta.Decimals != 0 {
+		t.Errorf("Build = %+v", meta)
+	}
+	defaults := []types.TokenAttribute{
+		{TraitType: "Category", Value: "Art"},
+		{TraitType: "Style", Value: "Generated"},
+		{TraitType: "Model", Value: "imagepig"},
+	}
+	if !reflect.DeepEqual(meta.Attributes, defaults) {
+		t.Errorf("default attributes %+v, want %+v", meta.Attributes, defaults)
+	}
+	wantProperties := map[string]interface{}{"edition": 1.0, "generator": "imagepig", "prompt": "a sunset over the sea"}
+	if !reflect.DeepEqual(meta.Properties, wantProperties) {
+		t.Errorf("properties %v, want %v", meta.Properties, wantProperties)
+	}
+	if in.Properties["generator"] != "overwritten" {
+		t.Errorf("Build changed the caller's properties: %v", in.Properties)
+	}
+
+	// Without a generator the defaults name none; supplied attributes
+	// replace the defaults entirely.
+	meta, err = Build(Input{Name: "Token"}, testImage)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(meta.Attributes) != 2 || meta.Attributes[1].Value != "Generated" {
+		t.Errorf("default attributes without a generator %+v", meta.Attributes)
+	}
+	own := []types.TokenAttribute{{TraitType: "Tier", Value: "Gold"}}
+	meta, err = Build(Input{Name: "Token", Generator: "imagepig", Attributes: own}, testImage)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(meta.Attributes, own) {
+		t.Errorf("attributes %+v, want %+v", meta.Attributes, own)
+	}
+
+	// The metadata serializes with OpenSea's field names.
+	data, err := json.Marshal(meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, field := range []string{`"name":"Token"`, `"image":"` + testImage + `"`, `"trait_type":"Tier"`} {
+		if !strings.Contains(string(data), field) {
+			t.Errorf("%s does not contain %s", data, field)
+		}
+	}
+}
+
+func TestBuildImage(t *testing.T) {
+	for name, image := range map[string]string{
+		"no image":           "",
+		"data URL":           "data:image/png;base64,iVBORw0KGgo=",
+		"file URL":           "file:///etc/passwd",
+		"image without host": "https://",
+	} {
+		_, err := Build(Input{Name: "Token"}, image)
+		var verr *ValidationError
+		if !errors.As(err, &verr) || !strings.Contains(verr.Error(), "image") {
+			t.Errorf("%s: error %v, want an image problem", name, err)
+		}
+	}
+
+	// Input problems are reported before the image is looked at.
+	if _, err := Build(Input{}, ""); err == nil || !strings.Contains(err.Error(), "name is required") || strings.Contains(err.Error(), "image") {
+		t.Errorf("Build of an invalid input: %v", err)
+	}
+}
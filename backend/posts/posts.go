@@ -0,0 +1,121 @@
+// Package posts stores model posts in MongoDB and keeps Model.Posts, in
+// whichever storage backend holds models, in step with them.
+package posts
+
+import (
+	"context"
+	"time"
+
+	"arjunmal1311/fans_flow_on_chain/backend/db"
+	"arjunmal1311/fans_flow_on_chain/backend/models"
+	"arjunmal1311/fans_flow_on_chain/backend/storage"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	DefaultLimit = 20
+	MaxLimit     = 100
+)
+
+func collection() *mongo.Collection {
+	return db.GetCollection("posts")
+}
+
+// Create stores post and updates its model's post count.
+func Create(ctx context.Context, repo storage.ModelRepo, post models.Post) (*models.Post, error) {
+	post.ID = primitive.NewObjectID()
+	post.CreatedAt = time.Now()
+	if _, err := collection().InsertOne(ctx, post); err != nil {
+		return nil, err
+	}
+	if _, err := Recount(ctx, repo, post.ModelID); err != nil {
+		return nil, err
+	}
+	return &post, nil
+}
+
+// Get returns the post with the given hex ID, or nil if there is none or
+// the ID is malformed.
+func Get(ctx context.Context, id string) (*models.Post, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, nil
+	}
+
+	var post models.Post
+	err = collection().FindOne(ctx, bson.M{"_id": objectID}).Decode(&post)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &post, nil
+}
+
+// List returns a model's posts, newest first. With before set, only posts
+// older than that post are returned, for paging.
+func List(ctx context.Context, modelID primitive.ObjectID, before *primitive.ObjectID, limit int64) ([]models.Post, error) {
+	if limit <= 0 || limit > MaxLimit {
+		limit = DefaultLimit
+	}
+
+	filter := bson.M{"model_id": modelID}
+	if before != nil {
+		filter["_id"] = bson.M{"$lt": *before}
+	}
+
+	cursor, err := collection().Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "_id", Value: -1}}).SetLimit(limit))
+	if err != nil {
+		return nil, err
+	}
+	posts := []models.Post{}
+	if err := cursor.All(ctx, &posts); err != nil {
+		return nil, err
+	}
+	return posts, nil
+}
+
+// Delete removes post and updates its model's post count. Attached assets
+// are left alone since they may be used elsewhere.
+func Delete(ctx context.Context, repo storage.ModelRepo, post *models.Post) error {
+	if _, err := collection().DeleteOne(ctx, bson.M{"_id": post.ID}); err != nil {
+		return err
+	}
+	_, err := Recount(ctx, repo, post.ModelID)
+	return err
+}
+
+// Recount sets Model.Posts from the posts collection and returns it.
+func Recount(ctx context.Context, repo storage.ModelRepo, modelID primitive.ObjectID) (int64, error) {
+	count, err := collection().CountDocuments(ctx, bson.M{"model_id": modelID})
+	if err != nil {
+		return 0, err
+	}
+	return count, repo.SetPosts(ctx, modelID, count)
+}
+
+// RecountAll recounts every model's posts, correcting counts recorded
+// before posts were stored. It returns how many models changed.
+func RecountAll(ctx context.Context, repo storage.ModelRepo) (int, error) {
+	all, err := repo.List(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	changed := 0
+	for _, model := range all {
+		count, err := Recount(ctx, repo, model.ID)
+		if err != nil {
+			return changed, err
+		}
+		if count != model.Posts {
+			changed++
+		}
+	}
+	return changed, nil
+}
@@ -0,0 +1,63 @@
+package routes
+
+import (
+	"net/http"
+
+	"arjunmal1311/fans_flow_on_chain/backend/auth"
+	"arjunmal1311/fans_flow_on_chain/backend/scheduler"
+	"arjunmal1311/fans_flow_on_chain/backend/types"
+
+	"github.com/gorilla/mux"
+)
+
+const codeJobRunning = "JOB_RUNNING"
+
+func SetupAdminRoutes(router *mux.Router, jobs *scheduler.Scheduler) {
+	router.HandleFunc("/admin/jobs", requireAdmin(func(w http.ResponseWriter, r *http.Request) {
+		GetJobsHandler(w, r, jobs)
+	})).Methods("GET")
+	router.HandleFunc("/admin/jobs/{name}/run", requireAdmin(func(w http.ResponseWriter, r *http.Request) {
+		RunJobHandler(w, r, jobs)
+	})).Methods("POST")
+}
+
+// requireAdmin is requireSession plus the admin policy.
+func requireAdmin(handler http.HandlerFunc) http.HandlerFunc {
+	return requireSession(func(w http.ResponseWriter, r *http.Request) {
+		if !authorize(w, auth.CanAdminister(session(r))) {
+			return
+		}
+		handler(w, r)
+	})
+}
+
+func GetJobsHandler(w http.ResponseWriter, r *http.Request, jobs *scheduler.Scheduler) {
+	response := types.UserResponse{
+		Success: true,
+		Message: "Jobs retrieved successfully",
+		Data:    jobs.Status(),
+	}
+
+	sendJSON(w, response, http.StatusOK)
+}
+
+func RunJobHandler(w http.ResponseWriter, r *http.Request, jobs *scheduler.Scheduler) {
+	name := mux.Vars(r)["name"]
+
+	found, started := jobs.Trigger(name)
+	if !found {
+		sendErrorCode(w, codeJobNotFound, "Unknown job: "+name, http.StatusNotFound)
+		return
+	}
+	if !started {
+		sendErrorCode(w, codeJobRunning, "Job "+name+" is already running", http.StatusConflict)
+		return
+	}
+
+	response := types.UserResponse{
+		Success: true,
+		Message: "Job " + name + " started",
+	}
+
+	sendJSON(w, response, http.StatusAccepted)
+}
@@ -0,0 +1,202 @@
+package routes
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"arjunmal1311/fans_flow_on_chain/backend/auth"
+	"arjunmal1311/fans_flow_on_chain/backend/chain"
+	"arjunmal1311/fans_flow_on_chain/backend/types"
+
+	"github.com/gorilla/mux"
+)
+
+const (
+	codeUnauthenticated = "UNAUTHENTICATED"
+	codeInvalidSession  = "INVALID_SESSION"
+	codeSessionExpired  = "SESSION_EXPIRED"
+
+	defaultSIWEDomain = "localhost:3000"
+)
+
+func SetupAuthRoutes(router *mux.Router) {
+	router.HandleFunc("/auth/nonce", GetNonceHandler).Methods("GET")
+	router.HandleFunc("/auth/verify", VerifySIWEHandler).Methods("POST")
+	router.HandleFunc("/auth/session", requireSession(GetSessionHandler)).Methods("GET")
+}
+
+// requireSession rejects requests without a valid "Authorization: Bearer"
+// session token and attaches the session to the request context.
+func requireSession(handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || token == "" {
+			sendErrorCode(w, codeUnauthenticated, "Sign in with your wallet to continue", http.StatusUnauthorized)
+			return
+		}
+		withSession(w, r, token, handler)
+	}
+}
+
+// optionalSession attaches the session when the request carries a token and
+// lets anonymous requests through. An invalid or expired token is still
+// rejected so clients know to sign in again.
+func optionalSession(handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || token == "" {
+			handler(w, r)
+			return
+		}
+		withSession(w, r, token, handler)
+	}
+}
+
+func withSession(w http.ResponseWriter, r *http.Request, token string, handler http.HandlerFunc) {
+	claims, err := auth.ParseToken(token)
+	if err != nil {
+		code := codeInvalidSession
+		if errors.Is(err, auth.ErrTokenExpired) {
+			code = codeSessionExpired
+		}
+		sendErrorCode(w, code, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	handler(w, r.WithContext(auth.WithSession(r.Context(), claims)))
+}
+
+func siweDomain() string {
+	if domain := os.Getenv("SIWE_DOMAIN"); domain != "" {
+		return domain
+	}
+	return defaultSIWEDomain
+}
+
+func GetNonceHandler(w http.ResponseWriter, r *http.Request) {
+	nonce, err := auth.IssueNonce(r.Context())
+	if err != nil {
+		sendError(w, "Failed to issue nonce: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	response := types.UserResponse{
+		Success: true,
+		Message: "Nonce issued successfully",
+		Data:    types.NonceResponse{Nonce: nonce},
+	}
+
+	sendJSON(w, response, http.StatusOK)
+}
+
+func VerifySIWEHandler(w http.ResponseWriter, r *http.Request) {
+	var req types.VerifySIWERequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		sendError(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if req.Message == "" || req.Signature == "" {
+		sendError(w, "Message and signature are required", http.StatusBadRequest)
+		return
+	}
+
+	message, err := auth.ParseMessage(req.Message)
+	if err != nil {
+		sendAuthError(w, err)
+		return
+	}
+
+	if err := message.Validate(siweDomain(), time.Now()); err != nil {
+		sendAuthError(w, err)
+		return
+	}
+
+	if !chainIDKnown(message.ChainID) {
+		sendErrorCode(w, codeUnknownChain, "Unsupported chain ID", http.StatusBadRequest)
+		return
+	}
+
+	signer, err := auth.RecoverAddress(req.Message, req.Signature)
+	if err != nil {
+		sendAuthError(w, err)
+		return
+	}
+	if !strings.EqualFold(signer, message.Address) {
+		sendErrorCode(w, auth.CodeInvalidSignature, "Signature was not made by "+message.Address, http.StatusUnauthorized)
+		return
+	}
+
+	// The nonce is only spent once everything else checks out.
+	ok, err := auth.ConsumeNonce(r.Context(), message.Nonce)
+	if err != nil {
+		sendError(w, "Failed to check nonce: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if !ok {
+		sendErrorCode(w, auth.CodeInvalidNonce, "Nonce is unknown, expired or already used", http.StatusUnauthorized)
+		return
+	}
+
+	token, claims, err := auth.IssueToken(signer, message.ChainID)
+	if err != nil {
+		sendError(w, "Failed to issue session: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	response := types.UserResponse{
+		Success: true,
+		Message: "Signed in successfully",
+		Data: types.SessionResponse{
+			Token:         token,
+			WalletAddress: claims.WalletAddress,
+			ChainID:       claims.ChainID,
+			ExpiresAt:     time.Unix(claims.ExpiresAt, 0).UTC(),
+		},
+	}
+
+	sendJSON(w, response, http.StatusOK)
+}
+
+func GetSessionHandler(w http.ResponseWriter, r *http.Request) {
+	claims, _ := auth.SessionFromContext(r.Context())
+
+	response := types.UserResponse{
+		Success: true,
+		Message: "Session retrieved successfully",
+		Data: types.SessionResponse{
+			WalletAddress: claims.WalletAddress,
+			ChainID:       claims.ChainID,
+			ExpiresAt:     time.Unix(claims.ExpiresAt, 0).UTC(),
+		},
+	}
+
+	sendJSON(w, response, http.StatusOK)
+}
+
+func sendAuthError(w http.ResponseWriter, err error) {
+	var aerr *auth.Error
+	if !errors.As(err, &aerr) {
+		sendError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	status := http.StatusUnauthorized
+	if aerr.Code == auth.CodeInvalidMessage {
+		status = http.StatusBadRequest
+	}
+	sendErrorCode(w, aerr.Code, aerr.Message, status)
+}
+
+func chainIDKnown(chainID uint64) bool {
+	for _, network := range chain.Networks() {
+		if network.ChainID == chainID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,111 @@
+package routes
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"github.com/gorilla/mux"
+
+	"arjunmal1311/fans_flow_on_chain/backend/auth"
+	"arjunmal1311/fans_flow_on_chain/backend/chain"
+	"arjunmal1311/fans_flow_on_chain/backend/types"
+)
+
+// signerWallet is the address of signerKey.
+const (
+	signerKey    = "4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318"
+	signerWallet = "0x2c7536E3605D9C16a7a3D7b1898e529396a65c23"
+)
+
+// personalSign signs message the way a wallet's personal_sign does.
+func personalSign(t *testing.T, key, message string) string {
+	t.Helper()
+	b, err := hex.DecodeString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash := chain.Keccak256([]byte(fmt.Sprintf("\x19Ethereum Signed Message:\n%d%s", len(message), message)))
+	compact := ecdsa.SignCompact(secp256k1.PrivKeyFromBytes(b), hash, false)
+	// SignCompact puts the recovery byte first; Ethereum puts it last.
+	return "0x" + hex.EncodeToString(append(compact[1:], compact[0]))
+}
+
+// siweMessage builds the message the frontend asks the wallet to sign.
+func siweMessage(domain string, chainID uint64, nonce string, issuedAt time.Time) string {
+	return domain + " wants you to sign in with your Ethereum account:\n" +
+		signerWallet + "\n" +
+		"\n" +
+		"Sign in to Fans Flow.\n" +
+		"\n" +
+		"URI: http://" + domain + "\n" +
+		"Version: 1\n" +
+		fmt.Sprintf("Chain ID: %d\n", chainID) +
+		"Nonce: " + nonce + "\n" +
+		"Issued At: " + issuedAt.UTC().Format(time.RFC3339) + "\n" +
+		"Expiration Time: " + issuedAt.Add(10*time.Minute).UTC().Format(time.RFC3339)
+}
+
+func TestVerifySIWE(t *testing.T) {
+	auth.UseNonceStore(auth.NewMemoryNonces())
+	router := mux.NewRouter()
+	SetupAuthRoutes(router)
+
+	status, resp := call(t, router, "GET", "/auth/nonce", "", nil)
+	if status != http.StatusOK {
+		t.Fatalf("nonce: status %d (%s)", status, resp.Error)
+	}
+	var nonce types.NonceResponse
+	if err := json.Unmarshal(resp.Data, &nonce); err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now()
+	signed := func(message string) map[string]string {
+		return map[string]string{"message": message, "signature": personalSign(t, signerKey, message)}
+	}
+	valid := siweMessage(defaultSIWEDomain, 31337, nonce.Nonce, now)
+
+	rejected := []struct {
+		name   string
+		body   map[string]string
+		status int
+		code   string
+	}{
+		{"not a SIWE message", signed("hello"), http.StatusBadRequest, auth.CodeInvalidMessage},
+		{"wrong domain", signed(siweMessage("evil.example", 31337, nonce.Nonce, now)), http.StatusUnauthorized, auth.CodeDomainMismatch},
+		{"unknown chain ID", signed(siweMessage(defaultSIWEDomain, 1, nonce.Nonce, now)), http.StatusBadRequest, codeUnknownChain},
+		{"expired", signed(siweMessage(defaultSIWEDomain, 31337, nonce.Nonce, now.Add(-time.Hour))), http.StatusUnauthorized, auth.CodeMessageExpired},
+		{"signed by another key", map[string]string{"message": valid, "signature": personalSign(t, strings.Repeat("11", 32), valid)}, http.StatusUnauthorized, auth.CodeInvalidSignature},
+		{"unknown nonce", signed(siweMessage(defaultSIWEDomain, 31337, "0123456789abcdef", now)), http.StatusUnauthorized, auth.CodeInvalidNonce},
+	}
+	for _, tt := range rejected {
+		if status, resp := call(t, router, "POST", "/auth/verify", "", tt.body); status != tt.status || resp.Code != tt.code {
+			t.Errorf("%s: status %d code %q (%s), want %d %s", tt.name, status, resp.Code, resp.Error, tt.status, tt.code)
+		}
+	}
+
+	// None of the rejections spent the nonce.
+	status, resp = call(t, router, "POST", "/auth/verify", "", signed(valid))
+	if status != http.StatusOK {
+		t.Fatalf("verify: status %d code %q (%s), want 200", status, resp.Code, resp.Error)
+	}
+	var session types.SessionResponse
+	if err := json.Unmarshal(resp.Data, &session); err != nil {
+		t.Fatal(err)
+	}
+	claims, err := auth.ParseToken(session.Token)
+	if err != nil || claims.WalletAddress != strings.ToLower(signerWallet) || claims.ChainID != 31337 {
+		t.Fatalf("session %+v: claims %+v, %v", session, claims, err)
+	}
+
+	if status, resp := call(t, router, "POST", "/auth/verify", "", signed(valid)); status != http.StatusUnauthorized || resp.Code != auth.CodeInvalidNonce {
+		t.Fatalf("reusing the nonce: status %d code %q, want 401 %s", status, resp.Code, auth.CodeInvalidNonce)
+	}
+}
@@ -0,0 +1,75 @@
+package routes
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"arjunmal1311/fans_flow_on_chain/backend/auth"
+	"arjunmal1311/fans_flow_on_chain/backend/models"
+	"arjunmal1311/fans_flow_on_chain/backend/storage"
+)
+
+const codeWalletNotRegistered = "WALLET_NOT_REGISTERED"
+
+// session returns the session attached by requireSession.
+func session(r *http.Request) *auth.Claims {
+	claims, _ := auth.SessionFromContext(r.Context())
+	return claims
+}
+
+// authorize writes a 403 for a failed policy check and returns false.
+func authorize(w http.ResponseWriter, err error) bool {
+	if err == nil {
+		return true
+	}
+
+	var aerr *auth.Error
+	if errors.As(err, &aerr) {
+		sendErrorCode(w, aerr.Code, aerr.Message, http.StatusForbidden)
+		return false
+	}
+	sendError(w, err.Error(), http.StatusInternalServerError)
+	return false
+}
+
+// sessionUser loads the user registered with the signed-in wallet.
+func (s *Server) sessionUser(w http.ResponseWriter, r *http.Request) (models.User, bool) {
+	user, err := s.Users.FindByWallet(r.Context(), session(r).WalletAddress)
+	if err != nil {
+		if err == storage.ErrNotFound {
+			sendErrorCode(w, codeWalletNotRegistered, "No user is registered with the signed-in wallet", http.StatusForbidden)
+			return models.User{}, false
+		}
+		sendError(w, "Failed to retrieve user: "+err.Error(), http.StatusInternalServerError)
+		return models.User{}, false
+	}
+	return *user, true
+}
+
+// findSubscription returns the caller's subscription to tokenId if they hold
+// one, otherwise another holder's, along with its holder. Policies then
+// decide whether the caller may act on it.
+func (s *Server) findSubscription(ctx context.Context, chainName, tokenId string, caller models.User) (*models.Subscription, *models.User, error) {
+	sub, err := s.Subscriptions.FindOne(ctx, storage.SubscriptionFilter{Chain: chainName, TokenID: tokenId, UserID: &caller.ID})
+	if err == nil {
+		return sub, &caller, nil
+	}
+	if err != storage.ErrNotFound {
+		return nil, nil, err
+	}
+
+	sub, err = s.Subscriptions.FindOne(ctx, storage.SubscriptionFilter{Chain: chainName, TokenID: tokenId})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	holder, err := s.Users.Get(ctx, sub.UserID)
+	if err == storage.ErrNotFound {
+		return sub, &models.User{}, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return sub, holder, nil
+}
@@ -0,0 +1,40 @@
+package routes
+
+import (
+	"net/http"
+
+	"arjunmal1311/fans_flow_on_chain/backend/chain"
+	"arjunmal1311/fans_flow_on_chain/backend/types"
+
+	"github.com/gorilla/mux"
+)
+
+func SetupChainRoutes(router *mux.Router) {
+	router.HandleFunc("/chains", GetChainsHandler).Methods("GET")
+}
+
+func GetChainsHandler(w http.ResponseWriter, r *http.Request) {
+	var chains []types.ChainInfo
+	for _, network := range chain.Networks() {
+		chains = append(chains, types.ChainInfo{
+			Name:                network.Name,
+			ChainID:             network.ChainID,
+			DisplayName:         network.DisplayName,
+			MarketplaceAddress:  network.Marketplace,
+			NFTAddress:          network.NFT,
+			PaymentTokenAddress: network.PaymentToken,
+			PaymentDecimals:     network.PaymentDecimals,
+			Confirmations:       network.Confirmations,
+			ExplorerURL:         network.ExplorerURL,
+			Enabled:             network.HasRPC(),
+		})
+	}
+
+	response := types.UserResponse{
+		Success: true,
+		Message: "Chains retrieved successfully",
+		Data:    chains,
+	}
+
+	sendJSON(w, response, http.StatusOK)
+}
@@ -3,30 +3,34 @@ package routes
 import (
 	"bytes"
 	"context"
-	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
 	"log"
-	"mime/multipart"
 	"net/http"
 	"os"
-	"path/filepath"
 
+	"arjunmal1311/fans_flow_on_chain/backend/assets"
+	"arjunmal1311/fans_flow_on_chain/backend/auth"
+	"arjunmal1311/fans_flow_on_chain/backend/avatar"
+	"arjunmal1311/fans_flow_on_chain/backend/imageproc"
+	"arjunmal1311/fans_flow_on_chain/backend/ipfs"
+	"arjunmal1311/fans_flow_on_chain/backend/models"
+	"arjunmal1311/fans_flow_on_chain/backend/nftmeta"
 	"arjunmal1311/fans_flow_on_chain/backend/types"
 
 	"github.com/cloudinary/cloudinary-go/v2"
 	"github.com/cloudinary/cloudinary-go/v2/api/uploader"
 	"github.com/gorilla/mux"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
-const (
-	imagePigEndpoint = "https://api.imagepig.com/xl"
-	pinataEndpoint   = "https://api.pinata.cloud/pinning/pinFileToIPFS"
-	defaultPrompt    = "happy sunbathing pig, resting"
-)
+const defaultPrompt = "happy sunbathing pig, resting"
 
-var cld *cloudinary.Cloudinary
+var (
+	cld         *cloudinary.Cloudinary
+	avatarQueue *avatar.Queue
+)
 
 func init() {
 	cloudinaryURL := os.Getenv("CLOUDINARY_URL")
@@ -44,18 +48,24 @@ func init() {
 	log.Printf("Cloudinary initialized successfully")
 }
 
-func SetupImageRoutes(router *mux.Router) {
-	router.HandleFunc("/generate-avatar-imagepig", GenerateAvatarHandler).Methods("POST")
-	router.HandleFunc("/create-nft-pin-metadata", CreateNFTPinMetadataHandler).Methods("POST")
-	router.HandleFunc("/server-storage-clean", ServerStorageCleanHandler).Methods("POST")
-}
+const (
+	codeJobNotFound   = "JOB_NOT_FOUND"
+	codeAssetNotFound = "ASSET_NOT_FOUND"
+	// codeAssetNotPublished answers a request that needs the asset's public
+	// rendition URLs before it has been published.
+	codeAssetNotPublished = "ASSET_NOT_PUBLISHED"
+	// codeInvalidMetadata answers metadata the marketplaces would reject.
+	codeInvalidMetadata = "INVALID_METADATA"
+)
 
-type imagePigRequest struct {
-	Prompt string `json:"prompt"`
-}
+func SetupImageRoutes(router *mux.Router, queue *avatar.Queue) {
+	avatarQueue = queue
 
-type imagePigResponse struct {
-	ImageData string `json:"image_data"`
+	router.HandleFunc("/generate-avatar-imagepig", requireSession(GenerateAvatarHandler)).Methods("POST")
+	router.HandleFunc("/create-nft-pin-metadata", requireSession(CreateNFTPinMetadataHandler)).Methods("POST")
+	router.HandleFunc("/server-storage-clean", requireSession(ServerStorageCleanHandler)).Methods("POST")
+	router.HandleFunc("/jobs/{id}", requireSession(GetAvatarJobHandler)).Methods("GET")
+	router.HandleFunc("/assets/{id}", requireSession(GetAssetHandler)).Methods("GET")
 }
 
 func GenerateAvatarHandler(w http.ResponseWriter, r *http.Request) {
@@ -75,324 +85,274 @@ func GenerateAvatarHandler(w http.ResponseWriter, r *http.Request) {
 		prompt = req.Prompt
 	}
 
-	imageData, err := generateImage(prompt)
-	if err != nil {
-		sendError(w, fmt.Sprintf("Error generating image: %v", err), http.StatusInternalServerError)
-		return
-	}
-
-	filePath, err := saveImage(imageData, req.Name)
+	job, err := avatarQueue.Enqueue(r.Context(), req.Name, prompt, session(r).WalletAddress)
 	if err != nil {
-		sendError(w, fmt.Sprintf("Error saving image: %v", err), http.StatusInternalServerError)
+		sendError(w, fmt.Sprintf("Error queueing image generation: %v", err), http.StatusInternalServerError)
 		return
 	}
 
-	response := types.GenerateAvatarResponse{
-		Success:  true,
-		Message:  "Image generated and saved successfully",
-		FilePath: filePath,
+	response := types.UserResponse{
+		Success: true,
+		Message: "Image generation queued",
+		Data:    job,
 	}
 
-	sendJSON(w, response, http.StatusOK)
+	sendJSON(w, response, http.StatusAccepted)
 }
 
-func CreateNFTPinMetadataHandler(w http.ResponseWriter, r *http.Request) {
-	if cld == nil {
-		sendError(w, "Cloudinary is not properly initialized. Please check your CLOUDINARY_URL environment variable.", http.StatusInternalServerError)
+// GetAvatarJobHandler reports the state of a generation job to the wallet
+// that queued it.
+func GetAvatarJobHandler(w http.ResponseWriter, r *http.Request) {
+	id, err := primitive.ObjectIDFromHex(mux.Vars(r)["id"])
+	if err != nil {
+		sendErrorCode(w, codeJobNotFound, "Job not found", http.StatusNotFound)
 		return
 	}
 
-	var req types.CreateNFTMetadataRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		sendError(w, "Invalid request body", http.StatusBadRequest)
+	job, err := avatar.Get(r.Context(), id)
+	if err != nil {
+		sendError(w, "Failed to retrieve job: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
-
-	if req.Name == "" || req.Description == "" {
-		sendError(w, "Missing required fields: name or description", http.StatusBadRequest)
+	if job == nil {
+		sendErrorCode(w, codeJobNotFound, "Job not found", http.StatusNotFound)
 		return
 	}
 
-	filePath := filepath.Join(".", fmt.Sprintf("%s.jpeg", req.Name))
-	if _, err := os.Stat(filePath); os.IsNotExist(err) {
-		sendError(w, "Image file not found. Ensure the file path is correct", http.StatusNotFound)
+	if !authorize(w, auth.CanActAs(session(r), job.OwnerWallet)) {
 		return
 	}
 
-	ctx := context.Background()
-	uploadResult, err := cld.Upload.Upload(ctx, filePath, uploader.UploadParams{
-		PublicID: req.Name,
-		Folder:   "nft_images",
-	})
-	if err != nil {
-		sendError(w, fmt.Sprintf("Error uploading to Cloudinary: %v", err), http.StatusInternalServerError)
-		return
+	response := types.UserResponse{
+		Success: true,
+		Message: "Job retrieved successfully",
+		Data:    job,
 	}
 
-	_, err = pinFileToIPFS(filePath, req.Name)
+	sendJSON(w, response, http.StatusOK)
+}
+
+// ownedAsset loads the asset with id and checks that the signed-in wallet
+// owns it, answering the request itself when it does not.
+func ownedAsset(w http.ResponseWriter, r *http.Request, id string) (*models.Asset, bool) {
+	asset, err := assets.Get(r.Context(), id)
 	if err != nil {
-		sendError(w, fmt.Sprintf("Error pinning to IPFS: %v", err), http.StatusInternalServerError)
-		return
+		sendError(w, "Failed to retrieve asset: "+err.Error(), http.StatusInternalServerError)
+		return nil, false
 	}
-
-	attributes := []types.NFTAttribute{
-		{TraitType: "Category", Value: "Art"},
-		{TraitType: "Style", Value: "Generated"},
-		{TraitType: "Model", Value: "ImagePig"},
+	if asset == nil {
+		sendErrorCode(w, codeAssetNotFound, "Asset not found", http.StatusNotFound)
+		return nil, false
 	}
-
-	metadata := types.NFTMetadata{
-		Name:        req.Name,
-		Description: req.Description,
-		Image:       uploadResult.SecureURL,
-		Attributes:  attributes,
+	if !authorize(w, auth.CanManageAsset(session(r), *asset)) {
+		return nil, false
 	}
+	return asset, true
+}
 
-	metadataJSON, err := json.MarshalIndent(metadata, "", "  ")
-	if err != nil {
-		sendError(w, fmt.Sprintf("Error creating metadata JSON: %v", err), http.StatusInternalServerError)
+// GetAssetHandler serves the content of an asset to its owner.
+func GetAssetHandler(w http.ResponseWriter, r *http.Request) {
+	asset, ok := ownedAsset(w, r, mux.Vars(r)["id"])
+	if !ok {
 		return
 	}
 
-	metadataResp, err := pinJSONToIPFS(metadata, req.Name)
+	content, err := assets.Open(r.Context(), asset)
 	if err != nil {
-		sendError(w, fmt.Sprintf("Error pinning metadata to IPFS: %v", err), http.StatusInternalServerError)
+		sendError(w, "Failed to read asset: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
+	defer content.Close()
 
-	metadataURL := fmt.Sprintf("https://ipfs.io/ipfs/%s", metadataResp.IpfsHash)
+	w.Header().Set("Content-Type", asset.ContentType)
+	w.Header().Set("Content-Length", fmt.Sprint(asset.Size))
+	w.Header().Set("Cache-Control", "private, max-age=3600")
+	io.Copy(w, content)
+}
 
-	response := types.CreateNFTMetadataResponse{
-		Success:      true,
-		Message:      "Image and metadata successfully pinned to IPFS",
-		ImageURL:     uploadResult.SecureURL,
-		IpfsURL:      metadataURL,
-		MetadataJSON: string(metadataJSON),
+func CreateNFTPinMetadataHandler(w http.ResponseWriter, r *http.Request) {
+	if cld == nil {
+		sendError(w, "Cloudinary is not properly initialized. Please check your CLOUDINARY_URL environment variable.", http.StatusInternalServerError)
+		return
 	}
 
-	sendJSON(w, response, http.StatusOK)
-}
-
-func ServerStorageCleanHandler(w http.ResponseWriter, r *http.Request) {
-	var req types.ServerStorageCleanRequest
+	var req types.CreateNFTMetadataRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		sendError(w, "Invalid request body", http.StatusBadRequest)
 		return
 	}
 
-	if req.Name == "" {
-		sendError(w, "Missing required fields: name", http.StatusBadRequest)
+	if req.AssetID == "" || req.Name == "" || req.Description == "" {
+		sendError(w, "Missing required fields: assetId, name or description", http.StatusBadRequest)
 		return
 	}
 
-	filePath := filepath.Join(".", fmt.Sprintf("%s.jpeg", req.Name))
-	if _, err := os.Stat(filePath); os.IsNotExist(err) {
-		sendError(w, "Image file not found. Ensure the file path is correct", http.StatusNotFound)
-		return
+	input := nftmeta.Input{
+		Name:            req.Name,
+		Description:     req.Description,
+		ExternalURL:     req.ExternalURL,
+		AnimationURL:    req.AnimationURL,
+		BackgroundColor: req.BackgroundColor,
+		Attributes:      req.Attributes,
+		Properties:      req.Properties,
 	}
-
-	if err := os.Remove(filePath); err != nil {
-		sendError(w, fmt.Sprintf("Failed to delete file: %v", err), http.StatusInternalServerError)
+	if err := input.Validate(); err != nil {
+		sendErrorCode(w, codeInvalidMetadata, err.Error(), http.StatusBadRequest)
 		return
 	}
 
-	response := types.ServerStorageCleanResponse{
-		Success: true,
-		Message: "Image Successfully removed",
-	}
-
-	sendJSON(w, response, http.StatusOK)
-}
-
-func generateImage(prompt string) (string, error) {
-	apiKey := os.Getenv("IMAGE_PIG")
-	if apiKey == "" {
-		return "", fmt.Errorf("IMAGE_PIG environment variable not set")
-	}
-
-	reqBody := imagePigRequest{
-		Prompt: prompt,
-	}
-
-	jsonData, err := json.Marshal(reqBody)
-	if err != nil {
-		return "", fmt.Errorf("error marshaling request: %v", err)
-	}
-
-	req, err := http.NewRequest("POST", imagePigEndpoint, bytes.NewBuffer(jsonData))
-	if err != nil {
-		return "", fmt.Errorf("error creating request: %v", err)
-	}
-
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Api-Key", apiKey)
-
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return "", fmt.Errorf("error making request: %v", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return "", fmt.Errorf("ImagePig API error: %s", string(body))
+	asset, ok := ownedAsset(w, r, req.AssetID)
+	if !ok {
+		return
 	}
+	input.Generator = asset.Generator
+	input.Prompt = asset.Prompt
 
-	var imagePigResp imagePigResponse
-	if err := json.NewDecoder(resp.Body).Decode(&imagePigResp); err != nil {
-		return "", fmt.Errorf("error decoding response: %v", err)
+	if err := publishRenditions(r.Context(), asset); err != nil {
+		sendError(w, fmt.Sprintf("Error uploading to Cloudinary: %v", err), http.StatusInternalServerError)
+		return
 	}
+	imageURL := asset.Renditions[imageproc.Full].URL
 
-	return imagePigResp.ImageData, nil
-}
-
-func saveImage(imageData string, fileName string) (string, error) {
-	decoded, err := base64.StdEncoding.DecodeString(imageData)
+	// The full rendition is what gets pinned; it carries no EXIF data.
+	data, err := assets.Read(r.Context(), asset)
 	if err != nil {
-		return "", fmt.Errorf("error decoding base64 image: %v", err)
-	}
-
-	filePath := filepath.Join(".", fmt.Sprintf("%s.jpeg", fileName))
-	if err := os.WriteFile(filePath, decoded, 0644); err != nil {
-		return "", fmt.Errorf("error saving image: %v", err)
-	}
-
-	return filePath, nil
-}
-
-func pinFileToIPFS(filePath, fileName string) (*types.PinataResponse, error) {
-	jwt := os.Getenv("JWT")
-	if jwt == "" {
-		return nil, fmt.Errorf("JWT environment variable not set")
+		sendError(w, fmt.Sprintf("Error reading image: %v", err), http.StatusInternalServerError)
+		return
 	}
 
-	file, err := os.Open(filePath)
+	wallet := session(r).WalletAddress
+	imagePin, err := ipfs.Pin(r.Context(), data, asset.ID.Hex()+extension(asset.ContentType), req.Name, wallet)
 	if err != nil {
-		return nil, fmt.Errorf("error opening file: %v", err)
+		sendPinError(w, "Error pinning to IPFS", err)
+		return
 	}
-	defer file.Close()
-
-	body := &bytes.Buffer{}
-	writer := multipart.NewWriter(body)
 
-	part, err := writer.CreateFormFile("file", filepath.Base(filePath))
+	metadata, err := nftmeta.Build(input, imageURL)
 	if err != nil {
-		return nil, fmt.Errorf("error creating form file: %v", err)
-	}
-	if _, err := io.Copy(part, file); err != nil {
-		return nil, fmt.Errorf("error copying file: %v", err)
+		sendErrorCode(w, codeInvalidMetadata, err.Error(), http.StatusBadRequest)
+		return
 	}
 
-	metadata := map[string]string{"name": fileName}
-	metadataJSON, err := json.Marshal(metadata)
+	metadataJSON, err := json.MarshalIndent(metadata, "", "  ")
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling metadata: %v", err)
-	}
-	if err := writer.WriteField("pinataMetadata", string(metadataJSON)); err != nil {
-		return nil, fmt.Errorf("error writing metadata field: %v", err)
+		sendError(w, fmt.Sprintf("Error creating metadata JSON: %v", err), http.StatusInternalServerError)
+		return
 	}
 
-	options := map[string]interface{}{"cidVersion": 0}
-	optionsJSON, err := json.Marshal(options)
+	metadataPin, err := ipfs.Pin(r.Context(), metadataJSON, req.Name+".json", req.Name, wallet)
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling options: %v", err)
-	}
-	if err := writer.WriteField("pinataOptions", string(optionsJSON)); err != nil {
-		return nil, fmt.Errorf("error writing options field: %v", err)
+		sendPinError(w, "Error pinning metadata to IPFS", err)
+		return
 	}
-
-	if err := writer.Close(); err != nil {
-		return nil, fmt.Errorf("error closing writer: %v", err)
+	if err := ipfs.SetParent(r.Context(), imagePin, metadataPin.CID); err != nil {
+		log.Printf("Failed to link image pin %s to metadata %s: %v", imagePin.CID, metadataPin.CID, err)
 	}
 
-	req, err := http.NewRequest("POST", pinataEndpoint, body)
-	if err != nil {
-		return nil, fmt.Errorf("error creating request: %v", err)
+	if err := assets.Keep(r.Context(), asset); err != nil {
+		log.Printf("Failed to mark asset %s as permanent: %v", asset.ID.Hex(), err)
 	}
 
-	req.Header.Set("Content-Type", writer.FormDataContentType())
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", jwt))
+	metadataURL := ipfs.GatewayURL(metadataPin.CID)
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("error making request: %v", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("pinata API error: %s", string(body))
-	}
-
-	var pinataResp types.PinataResponse
-	if err := json.NewDecoder(resp.Body).Decode(&pinataResp); err != nil {
-		return nil, fmt.Errorf("error decoding response: %v", err)
+	response := types.CreateNFTMetadataResponse{
+		Success:      true,
+		Message:      "Image and metadata successfully pinned to IPFS",
+		ImageURL:     imageURL,
+		Renditions:   renditionURLs(asset),
+		IpfsURL:      metadataURL,
+		MetadataJSON: string(metadataJSON),
 	}
 
-	return &pinataResp, nil
+	sendJSON(w, response, http.StatusOK)
 }
 
-func pinJSONToIPFS(metadata interface{}, name string) (*types.PinataResponse, error) {
-	jwt := os.Getenv("JWT")
-	if jwt == "" {
-		return nil, fmt.Errorf("JWT environment variable not set")
-	}
-
-	jsonData, err := json.Marshal(metadata)
-	if err != nil {
-		return nil, fmt.Errorf("error marshaling metadata: %v", err)
-	}
-
-	body := &bytes.Buffer{}
-	writer := multipart.NewWriter(body)
+// publishRenditions uploads every rendition of asset to Cloudinary and
+// records the public URLs. Assets stored before renditions existed are
+// uploaded as their own full rendition.
+func publishRenditions(ctx context.Context, asset *models.Asset) error {
+	if len(asset.Renditions) == 0 {
+		asset.Renditions = map[string]models.Rendition{
+			imageproc.Full: {AssetID: asset.ID.Hex(), ContentType: asset.ContentType, Size: asset.Size},
+		}
+	}
+
+	for name, rendition := range asset.Renditions {
+		if rendition.URL != "" {
+			continue
+		}
+
+		source, err := assets.GetRendition(ctx, asset, name)
+		if err != nil {
+			return err
+		}
+		if source == nil {
+			return fmt.Errorf("%s rendition is missing", name)
+		}
+		data, err := assets.Read(ctx, source)
+		if err != nil {
+			return err
+		}
+
+		uploadResult, err := cld.Upload.Upload(context.Background(), bytes.NewReader(data), uploader.UploadParams{
+			PublicID: asset.ID.Hex() + "_" + name,
+			Folder:   "nft_images",
+		})
+		if err != nil {
+			return err
+		}
+		if err := assets.SetRenditionURL(ctx, asset, name, uploadResult.SecureURL); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-	part, err := writer.CreateFormFile("file", name)
-	if err != nil {
-		return nil, fmt.Errorf("error creating form file: %v", err)
-	}
-	if _, err := part.Write(jsonData); err != nil {
-		return nil, fmt.Errorf("error writing JSON data: %v", err)
+func renditionURLs(asset *models.Asset) map[string]string {
+	urls := map[string]string{}
+	for name, rendition := range asset.Renditions {
+		urls[name] = rendition.URL
 	}
+	return urls
+}
 
-	pinataMetadata := map[string]string{"name": name}
-	metadataJSON, err := json.Marshal(pinataMetadata)
-	if err != nil {
-		return nil, fmt.Errorf("error marshaling metadata: %v", err)
-	}
-	if err := writer.WriteField("pinataMetadata", string(metadataJSON)); err != nil {
-		return nil, fmt.Errorf("error writing metadata field: %v", err)
+func extension(contentType string) string {
+	switch contentType {
+	case "image/png":
+		return ".png"
+	case "image/webp":
+		return ".webp"
+	default:
+		return ".jpeg"
 	}
+}
 
-	if err := writer.Close(); err != nil {
-		return nil, fmt.Errorf("error closing writer: %v", err)
+func ServerStorageCleanHandler(w http.ResponseWriter, r *http.Request) {
+	var req types.ServerStorageCleanRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		sendError(w, "Invalid request body", http.StatusBadRequest)
+		return
 	}
 
-	req, err := http.NewRequest("POST", pinataEndpoint, body)
-	if err != nil {
-		return nil, fmt.Errorf("error creating request: %v", err)
+	if req.AssetID == "" {
+		sendError(w, "Missing required fields: assetId", http.StatusBadRequest)
+		return
 	}
 
-	req.Header.Set("Content-Type", writer.FormDataContentType())
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", jwt))
-
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("error making request: %v", err)
+	asset, ok := ownedAsset(w, r, req.AssetID)
+	if !ok {
+		return
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		respBody, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("pinata API error: %s", string(respBody))
+	if err := assets.Delete(r.Context(), asset); err != nil {
+		sendError(w, fmt.Sprintf("Failed to delete image: %v", err), http.StatusInternalServerError)
+		return
 	}
 
-	var pinataResp types.PinataResponse
-	if err := json.NewDecoder(resp.Body).Decode(&pinataResp); err != nil {
-		return nil, fmt.Errorf("error decoding response: %v", err)
+	response := types.ServerStorageCleanResponse{
+		Success: true,
+		Message: "Image Successfully removed",
 	}
 
-	return &pinataResp, nil
+	sendJSON(w, response, http.StatusOK)
 }
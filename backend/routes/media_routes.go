@@ -0,0 +1,229 @@
+package routes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"arjunmal1311/fans_flow_on_chain/backend/assets"
+	"arjunmal1311/fans_flow_on_chain/backend/auth"
+	"arjunmal1311/fans_flow_on_chain/backend/imageproc"
+	"arjunmal1311/fans_flow_on_chain/backend/models"
+	"arjunmal1311/fans_flow_on_chain/backend/storage"
+	"arjunmal1311/fans_flow_on_chain/backend/types"
+
+	"github.com/gorilla/mux"
+)
+
+const codeInvalidAccess = "INVALID_ACCESS"
+
+// Values of the X-Media-Access header on /media responses.
+const (
+	mediaOriginal = "original"
+	mediaPreview  = "preview"
+)
+
+func (s *Server) SetupMediaRoutes(router *mux.Router) {
+	router.HandleFunc("/media/{assetId}", optionalSession(s.GetMediaHandler)).Methods("GET")
+	router.HandleFunc("/assets/{id}/access", requireSession(s.SetAssetAccessHandler)).Methods("PATCH")
+}
+
+// GetMediaHandler serves an asset according to its access tier: the
+// original to viewers allowed it, a blurred preview to everyone else when
+// the asset is for subscribers. Renditions share their source's tier.
+func (s *Server) GetMediaHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	asset, err := assets.Get(ctx, mux.Vars(r)["assetId"])
+	if err != nil {
+		sendError(w, "Failed to retrieve asset: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	source := asset
+	if asset != nil && asset.SourceID != nil {
+		source, err = assets.Get(ctx, asset.SourceID.Hex())
+		if err != nil {
+			sendError(w, "Failed to retrieve asset: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+	if source == nil {
+		sendErrorCode(w, codeAssetNotFound, "Asset not found", http.StatusNotFound)
+		return
+	}
+
+	err = s.canViewMedia(ctx, session(r), *source)
+	var aerr *auth.Error
+	switch {
+	case err == nil:
+		cache := "private, max-age=300"
+		if source.Access == models.AssetPublic {
+			cache = "public, max-age=86400"
+		}
+		serveMedia(w, r, asset, mediaOriginal, cache)
+	case errors.As(err, &aerr) && aerr.Code == auth.CodeSubscriptionRequired:
+		preview, err := s.previewOf(ctx, source)
+		if err != nil {
+			sendError(w, "Failed to render preview: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		serveMedia(w, r, preview, mediaPreview, "public, max-age=3600")
+	case errors.As(err, &aerr):
+		// Private assets are not acknowledged to anyone but their owner.
+		sendErrorCode(w, codeAssetNotFound, "Asset not found", http.StatusNotFound)
+	default:
+		sendError(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// canViewMedia loads what auth.CanViewMedia needs to decide on asset.
+func (s *Server) canViewMedia(ctx context.Context, claims *auth.Claims, asset models.Asset) error {
+	if asset.Access != models.AssetSubscribers || asset.ModelID == nil || claims == nil {
+		return auth.CanViewMedia(claims, asset, nil, nil)
+	}
+
+	model, err := s.Models.Get(ctx, *asset.ModelID)
+	if err == storage.ErrNotFound {
+		return auth.CanViewMedia(claims, asset, nil, nil)
+	}
+	if err != nil {
+		return err
+	}
+
+	held, err := s.heldOptions(ctx, claims, *model)
+	if err != nil {
+		return err
+	}
+	return auth.CanViewMedia(claims, asset, model, held)
+}
+
+// activeSubscriptions returns wallet's unexpired subscriptions to model on
+// every chain.
+func (s *Server) activeSubscriptions(ctx context.Context, wallet string, model models.Model) ([]models.Subscription, error) {
+	if wallet == "" || model.ID.IsZero() {
+		return nil, nil
+	}
+
+	user, err := s.Users.FindByWallet(ctx, wallet)
+	if err == storage.ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Subscriptions.Find(ctx, storage.SubscriptionFilter{
+		UserID:  &user.ID,
+		ModelID: &model.ID,
+		Status:  models.SubscriptionActive,
+	})
+}
+
+// previewOf returns the blurred preview of asset, rendering and storing it
+// the first time it is asked for.
+func (s *Server) previewOf(ctx context.Context, asset *models.Asset) (*models.Asset, error) {
+	preview, err := assets.GetRendition(ctx, asset, imageproc.Preview)
+	if err != nil || preview != nil {
+		return preview, err
+	}
+
+	original, err := assets.GetRendition(ctx, asset, imageproc.Full)
+	if err != nil {
+		return nil, err
+	}
+	if original == nil {
+		original = asset
+	}
+	data, err := assets.Read(ctx, original)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := s.Pipeline.Preview(data)
+	if err != nil {
+		return nil, err
+	}
+	return assets.AddRendition(ctx, asset, assets.Content{
+		Name:        output.Name,
+		Data:        output.Data,
+		ContentType: output.ContentType,
+		Width:       output.Width,
+		Height:      output.Height,
+	})
+}
+
+func serveMedia(w http.ResponseWriter, r *http.Request, asset *models.Asset, access, cache string) {
+	content, err := assets.Open(r.Context(), asset)
+	if err != nil {
+		sendError(w, "Failed to read asset: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer content.Close()
+
+	w.Header().Set("Content-Type", asset.ContentType)
+	w.Header().Set("Content-Length", fmt.Sprint(asset.Size))
+	w.Header().Set("Cache-Control", cache)
+	w.Header().Set("Vary", "Authorization")
+	w.Header().Set("X-Media-Access", access)
+	io.Copy(w, content)
+}
+
+// SetAssetAccessHandler sets the access tier of one of the caller's assets.
+// Subscriber assets are tied to a model the caller manages.
+func (s *Server) SetAssetAccessHandler(w http.ResponseWriter, r *http.Request) {
+	var req types.SetAssetAccessRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		sendError(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+
+	asset, ok := ownedAsset(w, r, mux.Vars(r)["id"])
+	if !ok {
+		return
+	}
+	if asset.SourceID != nil {
+		sendErrorCode(w, codeInvalidAccess, "Set access on the asset the rendition was made from", http.StatusBadRequest)
+		return
+	}
+
+	switch req.Access {
+	case models.AssetPrivate, models.AssetPublic:
+		if err := assets.SetAccess(r.Context(), asset, req.Access, nil, nil); err != nil {
+			sendError(w, "Failed to update asset: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+	case models.AssetSubscribers:
+		if req.ModelID == "" {
+			sendErrorCode(w, codeInvalidAccess, "modelId is required for subscriber access", http.StatusBadRequest)
+			return
+		}
+		model, err := s.Models.FindByModelID(r.Context(), req.ModelID)
+		if err != nil {
+			if err == storage.ErrNotFound {
+				sendError(w, "Model not found", http.StatusNotFound)
+				return
+			}
+			sendError(w, "Failed to retrieve model: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if !authorize(w, auth.CanManageModel(session(r), *model)) {
+			return
+		}
+		if err := assets.SetAccess(r.Context(), asset, req.Access, &model.ID, nil); err != nil {
+			sendError(w, "Failed to update asset: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+	default:
+		sendErrorCode(w, codeInvalidAccess, "access must be private, public or subscribers", http.StatusBadRequest)
+		return
+	}
+
+	sendJSON(w, types.UserResponse{
+		Success: true,
+		Message: "Asset access updated",
+		Data:    asset,
+	}, http.StatusOK)
+}
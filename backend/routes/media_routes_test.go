@@ -0,0 +1,134 @@
+package routes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"arjunmal1311/fans_flow_on_chain/backend/auth"
+	"arjunmal1311/fans_flow_on_chain/backend/models"
+	"arjunmal1311/fans_flow_on_chain/backend/storage"
+)
+
+const carolWallet = "0x0000000000000000000000000000000000000ca7"
+
+func TestCanViewMediaOptions(t *testing.T) {
+	ctx := context.Background()
+	repos := storage.NewMemory()
+	server := NewServer(repos, nil)
+
+	model := models.Model{Name: "Model", ModelID: "7", Email: "model@example.com", WalletAddress: "0x0000000000000000000000000000000000000007", Slug: "model"}
+	if err := repos.Models.Create(ctx, &model); err != nil {
+		t.Fatal(err)
+	}
+	gold := models.SubscriptionOption{ModelID: "7", Price: "50", Duration: 30}
+	silver := models.SubscriptionOption{ModelID: "7", Price: "10", Duration: 30}
+	for _, option := range []*models.SubscriptionOption{&gold, &silver} {
+		if err := repos.Options.Create(ctx, option); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Alice holds gold, Bob silver and Carol a subscription recorded
+	// without an option.
+	holders := []struct {
+		wallet string
+		option *primitive.ObjectID
+	}{
+		{aliceWallet, &gold.ID},
+		{bobWallet, &silver.ID},
+		{carolWallet, nil},
+	}
+	for i, h := range holders {
+		user := models.User{Username: h.wallet, Email: h.wallet + "@example.com", WalletAddress: h.wallet}
+		if err := repos.Users.Create(ctx, &user); err != nil {
+			t.Fatal(err)
+		}
+		sub := models.Subscription{Chain: testChain, TokenID: fmt.Sprintf("700000000000000000%d", i+1), UserID: user.ID, ModelID: model.ID, OptionID: h.option}
+		if err := repos.Subscriptions.RecordPurchase(ctx, sub); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	goldOnly := models.Asset{OwnerWallet: model.WalletAddress, Access: models.AssetSubscribers, ModelID: &model.ID, OptionIDs: []primitive.ObjectID{gold.ID}}
+	anyOption := models.Asset{OwnerWallet: model.WalletAddress, Access: models.AssetSubscribers, ModelID: &model.ID}
+
+	tests := []struct {
+		name   string
+		asset  models.Asset
+		wallet string
+		want   bool
+	}{
+		{"gold asset, gold holder", goldOnly, aliceWallet, true},
+		{"gold asset, silver holder", goldOnly, bobWallet, false},
+		{"gold asset, holder without an option", goldOnly, carolWallet, false},
+		{"gold asset, model wallet", goldOnly, model.WalletAddress, true},
+		{"gold asset, anonymous", goldOnly, "", false},
+		{"any option, silver holder", anyOption, bobWallet, true},
+		{"any option, holder without an option", anyOption, carolWallet, true},
+		{"any option, unsubscribed", anyOption, "0x0000000000000000000000000000000000000bad", false},
+	}
+
+	for _, tt := range tests {
+		var claims *auth.Claims
+		if tt.wallet != "" {
+			claims = &auth.Claims{WalletAddress: tt.wallet}
+		}
+		err := server.canViewMedia(ctx, claims, tt.asset)
+		var aerr *auth.Error
+		switch {
+		case tt.want && err != nil:
+			t.Errorf("%s: %v, want the original", tt.name, err)
+		case !tt.want && (!errors.As(err, &aerr) || aerr.Code != auth.CodeSubscriptionRequired):
+			t.Errorf("%s: %v, want %s", tt.name, err, auth.CodeSubscriptionRequired)
+		}
+	}
+}
+
+func TestAudienceAccess(t *testing.T) {
+	modelID, otherModel := primitive.NewObjectID(), primitive.NewObjectID()
+	gold, silver := primitive.NewObjectID(), primitive.NewObjectID()
+
+	subscribers := func(options ...primitive.ObjectID) models.Asset {
+		return models.Asset{Access: models.AssetSubscribers, ModelID: &modelID, OptionIDs: options}
+	}
+	post := func(visibility string, options ...primitive.ObjectID) models.Post {
+		return models.Post{ModelID: modelID, Visibility: visibility, OptionIDs: options}
+	}
+
+	tests := []struct {
+		name    string
+		asset   models.Asset
+		post    models.Post
+		access  string
+		options []primitive.ObjectID
+		changed bool
+	}{
+		{"private to public post", models.Asset{}, post(models.PostPublic), models.AssetPublic, nil, true},
+		{"private to subscriber post", models.Asset{Access: models.AssetPrivate}, post(models.PostSubscribers), models.AssetSubscribers, nil, true},
+		{"private to gold post", models.Asset{}, post(models.PostSubscribers, gold), models.AssetSubscribers, []primitive.ObjectID{gold}, true},
+		{"gold to silver post", subscribers(gold), post(models.PostSubscribers, silver), models.AssetSubscribers, []primitive.ObjectID{gold, silver}, true},
+		{"gold to gold post", subscribers(gold), post(models.PostSubscribers, gold), "", nil, false},
+		{"gold to any subscriber post", subscribers(gold), post(models.PostSubscribers), models.AssetSubscribers, nil, true},
+		{"gold to public post", subscribers(gold), post(models.PostPublic), "", nil, false},
+		{"whole model to gold post", subscribers(), post(models.PostSubscribers, gold), "", nil, false},
+		{"public to gold post", models.Asset{Access: models.AssetPublic}, post(models.PostSubscribers, gold), "", nil, false},
+		{"other model's gold to silver post", models.Asset{Access: models.AssetSubscribers, ModelID: &otherModel, OptionIDs: []primitive.ObjectID{gold}}, post(models.PostSubscribers, silver), "", nil, false},
+	}
+
+	for _, tt := range tests {
+		access, options, changed := audienceAccess(tt.asset, tt.post)
+		if changed != tt.changed || access != tt.access || len(options) != len(tt.options) {
+			t.Errorf("%s: %q %v %t, want %q %v %t", tt.name, access, options, changed, tt.access, tt.options, tt.changed)
+			continue
+		}
+		for i := range options {
+			if options[i] != tt.options[i] {
+				t.Errorf("%s: options %v, want %v", tt.name, options, tt.options)
+			}
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package routes
+
+import (
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"arjunmal1311/fans_flow_on_chain/backend/models"
+	"arjunmal1311/fans_flow_on_chain/backend/storage"
+	"arjunmal1311/fans_flow_on_chain/backend/tokenid"
+	"arjunmal1311/fans_flow_on_chain/backend/types"
+
+	"github.com/gorilla/mux"
+)
+
+var (
+	// hexTokenIDPattern is the {id} substitution of the ERC-1155 metadata
+	// URI: the token id as 64 lower-case hex characters without 0x.
+	hexTokenIDPattern = regexp.MustCompile(`^[0-9a-fA-F]{64}$`)
+	decimalIDPattern  = regexp.MustCompile(`^[0-9]+$`)
+)
+
+func (s *Server) SetupMetadataRoutes(router *mux.Router) {
+	router.HandleFunc("/metadata/{id}", s.GetTokenMetadataHandler).Methods("GET")
+}
+
+// GetTokenMetadataHandler serves ERC-1155 metadata built from the current
+// model and subscription records. {id} is either the hex token id of the
+// ERC-1155 URI template, or a decimal model id as produced by
+// BlockTeaseNFTs.uri(), optionally followed by ".json".
+func (s *Server) GetTokenMetadataHandler(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimSuffix(mux.Vars(r)["id"], ".json")
+
+	var tokenID *big.Int
+	var modelID string
+	switch {
+	case hexTokenIDPattern.MatchString(id):
+		tokenID, _ = new(big.Int).SetString(id, 16)
+		decodedModel, _, err := tokenid.DecodeTokenID(tokenID)
+		if err != nil {
+			sendErrorCode(w, codeInvalidTokenID, "Invalid token id: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		modelID = decodedModel.String()
+	case decimalIDPattern.MatchString(id):
+		modelID = strings.TrimLeft(id, "0")
+		if modelID == "" {
+			modelID = "0"
+		}
+	default:
+		sendErrorCode(w, codeInvalidTokenID, "id must be a 64 character hex token id or a decimal model id", http.StatusBadRequest)
+		return
+	}
+
+	model, err := s.Models.FindByModelID(r.Context(), modelID)
+	if err != nil {
+		if err == storage.ErrNotFound {
+			sendError(w, "Model not found", http.StatusNotFound)
+			return
+		}
+		sendError(w, "Failed to retrieve model: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	metadata := modelMetadata(*model)
+
+	if tokenID != nil {
+		_, subscriptionID, _ := tokenid.DecodeTokenID(tokenID)
+		metadata.Name = model.Name + " Subscription #" + subscriptionID.String()
+		metadata.Properties["token_id"] = tokenID.String()
+		metadata.Properties["subscription_id"] = subscriptionID.String()
+		metadata.Attributes = append(metadata.Attributes, types.TokenAttribute{
+			TraitType: "Subscription",
+			Value:     subscriptionID.String(),
+		})
+
+		sub, err := s.latestSubscription(r, tokenID.String())
+		if err != nil {
+			sendError(w, "Failed to retrieve subscription: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if sub != nil && sub.ExpiresAt != nil {
+			status := sub.Status(time.Now())
+			metadata.Properties["expires_at"] = sub.ExpiresAt.UTC().Format(time.RFC3339)
+			metadata.Properties["status"] = status
+			metadata.Attributes = append(metadata.Attributes,
+				types.TokenAttribute{TraitType: "Expires", Value: sub.ExpiresAt.Unix(), DisplayType: "date"},
+				types.TokenAttribute{TraitType: "Status", Value: status},
+			)
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "public, max-age=60")
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	json.NewEncoder(w).Encode(metadata)
+}
+
+func modelMetadata(model models.Model) types.TokenMetadata {
+	image := model.Image.Src
+	if image == "" {
+		image = model.IpfsUrl
+	}
+
+	return types.TokenMetadata{
+		Name:        model.Name,
+		Description: model.AboutMe,
+		Image:       image,
+		Decimals:    0,
+		Properties: map[string]interface{}{
+			"model_id": model.ModelID,
+			"slug":     model.Slug,
+		},
+		Attributes: []types.TokenAttribute{
+			{TraitType: "Model", Value: model.Name},
+			{TraitType: "Location", Value: model.Location},
+			{TraitType: "Views", Value: model.Views, DisplayType: "number"},
+			{TraitType: "Posts", Value: model.Posts, DisplayType: "number"},
+			{TraitType: "Teases", Value: model.Tease, DisplayType: "number"},
+		},
+	}
+}
+
+// latestSubscription returns the most recently expiring subscription to
+// tokenID, optionally limited to the ?chain= network. Like the contract's
+// expirationTimes it reflects the token's latest purchase.
+func (s *Server) latestSubscription(r *http.Request, tokenID string) (*models.Subscription, error) {
+	sub, err := s.Subscriptions.Latest(r.Context(), r.URL.Query().Get("chain"), tokenID)
+	if err == storage.ErrNotFound {
+		return nil, nil
+	}
+	return sub, err
+}
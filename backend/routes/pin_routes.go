@@ -0,0 +1,168 @@
+package routes
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"arjunmal1311/fans_flow_on_chain/backend/auth"
+	"arjunmal1311/fans_flow_on_chain/backend/ipfs"
+	"arjunmal1311/fans_flow_on_chain/backend/types"
+
+	"github.com/gorilla/mux"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const (
+	codeCIDMismatch = "CID_MISMATCH"
+	codePinNotFound = "PIN_NOT_FOUND"
+	codePinInUse    = "PIN_IN_USE"
+	// maxCIDUpload bounds POST /ipfs/cid uploads.
+	maxCIDUpload = 32 << 20
+)
+
+func SetupPinRoutes(router *mux.Router) {
+	router.HandleFunc("/ipfs/cid", ComputeCIDHandler).Methods("POST")
+	router.HandleFunc("/pins", requireSession(GetPinsHandler)).Methods("GET")
+	router.HandleFunc("/pins/{id}", requireSession(UnpinHandler)).Methods("DELETE")
+	router.HandleFunc("/admin/pins", requireAdmin(GetAllPinsHandler)).Methods("GET")
+}
+
+// GetPinsHandler lists what the signed-in wallet has pinned.
+func GetPinsHandler(w http.ResponseWriter, r *http.Request) {
+	listPins(w, r, bson.M{"owner_wallet": strings.ToLower(session(r).WalletAddress)})
+}
+
+// GetAllPinsHandler lists every pin for auditing, optionally for one
+// ?owner= wallet.
+func GetAllPinsHandler(w http.ResponseWriter, r *http.Request) {
+	filter := bson.M{}
+	if owner := r.URL.Query().Get("owner"); owner != "" {
+		filter["owner_wallet"] = strings.ToLower(owner)
+	}
+	listPins(w, r, filter)
+}
+
+func listPins(w http.ResponseWriter, r *http.Request, filter bson.M) {
+	if cid := r.URL.Query().Get("cid"); cid != "" {
+		filter["cid"] = cid
+	}
+
+	var limit int64
+	if v := r.URL.Query().Get("limit"); v != "" {
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || n <= 0 {
+			sendError(w, "limit must be a positive integer", http.StatusBadRequest)
+			return
+		}
+		limit = n
+	}
+
+	pins, err := ipfs.ListPins(r.Context(), filter, limit)
+	if err != nil {
+		sendError(w, "Failed to retrieve pins: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	response := types.UserResponse{
+		Success: true,
+		Message: "Pins retrieved successfully",
+		Data:    pins,
+	}
+
+	sendJSON(w, response, http.StatusOK)
+}
+
+// UnpinHandler releases a pin, and the pins it references, for the wallet
+// that made it. Content still used by a registered user or model is kept.
+func UnpinHandler(w http.ResponseWriter, r *http.Request) {
+	pin, err := ipfs.GetPin(r.Context(), mux.Vars(r)["id"])
+	if err != nil {
+		sendError(w, "Failed to retrieve pin: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if pin == nil {
+		sendErrorCode(w, codePinNotFound, "Pin not found", http.StatusNotFound)
+		return
+	}
+
+	if !authorize(w, auth.CanManagePin(session(r), *pin)) {
+		return
+	}
+
+	if err := ipfs.Unpin(r.Context(), pin); err != nil {
+		if errors.Is(err, ipfs.ErrPinInUse) {
+			sendErrorCode(w, codePinInUse, "Pin is still used by a registered user or model", http.StatusConflict)
+			return
+		}
+		sendError(w, "Failed to unpin: "+err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	response := types.UserResponse{
+		Success: true,
+		Message: "Unpinned " + pin.CID,
+	}
+
+	sendJSON(w, response, http.StatusOK)
+}
+
+// sendPinError reports a failed pin, flagging CID mismatches so clients can
+// tell a misbehaving provider from an unreachable one.
+func sendPinError(w http.ResponseWriter, message string, err error) {
+	if errors.Is(err, ipfs.ErrCIDMismatch) {
+		sendErrorCode(w, codeCIDMismatch, fmt.Sprintf("%s: %v", message, err), http.StatusBadGateway)
+		return
+	}
+	sendError(w, fmt.Sprintf("%s: %v", message, err), http.StatusInternalServerError)
+}
+
+// ComputeCIDHandler returns the CIDs a file would get when pinned, without
+// pinning it. The file is sent as the "file" field of a multipart form or as
+// the raw request body.
+func ComputeCIDHandler(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxCIDUpload)
+
+	var body io.Reader = r.Body
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+		file, _, err := r.FormFile("file")
+		if err != nil {
+			sendCIDUploadError(w, err)
+			return
+		}
+		defer file.Close()
+		body = file
+	}
+
+	data, err := io.ReadAll(body)
+	if err != nil {
+		sendCIDUploadError(w, err)
+		return
+	}
+
+	cidV0 := ipfs.CIDv0(data)
+	response := types.UserResponse{
+		Success: true,
+		Message: "CID computed successfully",
+		Data: types.CIDResponse{
+			CIDv0:      cidV0,
+			CIDv1:      ipfs.CIDv1(data),
+			Size:       int64(len(data)),
+			GatewayURL: ipfs.GatewayURL(cidV0),
+		},
+	}
+
+	sendJSON(w, response, http.StatusOK)
+}
+
+func sendCIDUploadError(w http.ResponseWriter, err error) {
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		sendError(w, fmt.Sprintf("File is larger than %d MiB", maxCIDUpload>>20), http.StatusRequestEntityTooLarge)
+		return
+	}
+	sendError(w, "Missing or unreadable 'file': "+err.Error(), http.StatusBadRequest)
+}
@@ -0,0 +1,347 @@
+package routes
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"arjunmal1311/fans_flow_on_chain/backend/assets"
+	"arjunmal1311/fans_flow_on_chain/backend/auth"
+	"arjunmal1311/fans_flow_on_chain/backend/models"
+	"arjunmal1311/fans_flow_on_chain/backend/posts"
+	"arjunmal1311/fans_flow_on_chain/backend/storage"
+	"arjunmal1311/fans_flow_on_chain/backend/types"
+
+	"github.com/gorilla/mux"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	codePostNotFound  = "POST_NOT_FOUND"
+	codeInvalidPost   = "INVALID_POST"
+	codeModelNotFound = "MODEL_NOT_FOUND"
+	maxPostText       = 5000
+	maxPostMedia      = 10
+)
+
+func (s *Server) SetupPostRoutes(router *mux.Router) {
+	router.HandleFunc("/models/{modelId}/posts", requireSession(s.CreatePostHandler)).Methods("POST")
+	router.HandleFunc("/models/{modelId}/posts", optionalSession(s.ListPostsHandler)).Methods("GET")
+	router.HandleFunc("/posts/{id}", optionalSession(s.GetPostHandler)).Methods("GET")
+	router.HandleFunc("/posts/{id}", requireSession(s.DeletePostHandler)).Methods("DELETE")
+}
+
+// CreatePostHandler publishes a post for a model registered with the
+// signed-in wallet. Attached assets must belong to that wallet; private
+// ones are opened up to the post's audience so /media can serve them.
+func (s *Server) CreatePostHandler(w http.ResponseWriter, r *http.Request) {
+	var req types.CreatePostRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		sendError(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	model, ok := s.findModel(w, r, mux.Vars(r)["modelId"])
+	if !ok {
+		return
+	}
+	if !authorize(w, auth.CanManageModel(session(r), *model)) {
+		return
+	}
+
+	post := models.Post{
+		ModelID:       model.ID,
+		WalletAddress: strings.ToLower(model.WalletAddress),
+		Text:          strings.TrimSpace(req.Text),
+		Visibility:    req.Visibility,
+	}
+
+	switch {
+	case post.Text == "" && len(req.AssetIDs) == 0:
+		sendErrorCode(w, codeInvalidPost, "A post needs text or media", http.StatusBadRequest)
+		return
+	case len(post.Text) > maxPostText:
+		sendErrorCode(w, codeInvalidPost, "Text must be at most "+strconv.Itoa(maxPostText)+" characters", http.StatusBadRequest)
+		return
+	case len(req.AssetIDs) > maxPostMedia:
+		sendErrorCode(w, codeInvalidPost, "A post can have at most "+strconv.Itoa(maxPostMedia)+" media", http.StatusBadRequest)
+		return
+	case post.Visibility != models.PostPublic && post.Visibility != models.PostSubscribers:
+		sendErrorCode(w, codeInvalidPost, "visibility must be public or subscribers", http.StatusBadRequest)
+		return
+	case post.Visibility == models.PostPublic && len(req.OptionIDs) > 0:
+		sendErrorCode(w, codeInvalidPost, "optionIds only apply to subscriber posts", http.StatusBadRequest)
+		return
+	}
+
+	for _, optionID := range req.OptionIDs {
+		if optionID == "" {
+			sendError(w, "Invalid subscriptionOptionId", http.StatusBadRequest)
+			return
+		}
+		option, ok := s.loadSubscriptionOption(w, r, optionID, model.ModelID)
+		if !ok {
+			return
+		}
+		post.OptionIDs = append(post.OptionIDs, option.ID)
+	}
+
+	var attached []*models.Asset
+	for _, assetID := range req.AssetIDs {
+		asset, ok := ownedAsset(w, r, assetID)
+		if !ok {
+			return
+		}
+		if asset.SourceID != nil {
+			sendErrorCode(w, codeInvalidPost, "Attach the asset the rendition "+assetID+" was made from", http.StatusBadRequest)
+			return
+		}
+		attached = append(attached, asset)
+		post.AssetIDs = append(post.AssetIDs, asset.ID.Hex())
+	}
+
+	for _, asset := range attached {
+		if err := shareWithAudience(r.Context(), asset, post); err != nil {
+			sendError(w, "Failed to update asset: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	created, err := posts.Create(r.Context(), s.Models, post)
+	if err != nil {
+		sendError(w, "Failed to create post: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSON(w, types.UserResponse{
+		Success: true,
+		Message: "Post created successfully",
+		Data:    postResponse(*created, true),
+	}, http.StatusCreated)
+}
+
+// shareWithAudience keeps an attached asset and opens it up to the post's
+// audience.
+func shareWithAudience(ctx context.Context, asset *models.Asset, post models.Post) error {
+	if err := assets.Keep(ctx, asset); err != nil {
+		return err
+	}
+	access, optionIDs, ok := audienceAccess(*asset, post)
+	if !ok {
+		return nil
+	}
+	if access == models.AssetPublic {
+		return assets.SetAccess(ctx, asset, access, nil, nil)
+	}
+	return assets.SetAccess(ctx, asset, access, &post.ModelID, optionIDs)
+}
+
+// audienceAccess returns the tier and options an asset attached to post
+// should have, or false to leave it as it is. A private asset gets the
+// post's tier, and for subscriber posts its options. A tier the owner chose
+// is kept, except that an asset limited to the options of earlier posts is
+// widened to this post's audience too.
+func audienceAccess(asset models.Asset, post models.Post) (string, []primitive.ObjectID, bool) {
+	switch {
+	case asset.Access == "" || asset.Access == models.AssetPrivate:
+		if post.Visibility == models.PostPublic {
+			return models.AssetPublic, nil, true
+		}
+		return models.AssetSubscribers, post.OptionIDs, true
+	case asset.Access != models.AssetSubscribers || len(asset.OptionIDs) == 0 || post.Visibility != models.PostSubscribers:
+		return "", nil, false
+	case asset.ModelID == nil || *asset.ModelID != post.ModelID:
+		return "", nil, false
+	case len(post.OptionIDs) == 0:
+		return models.AssetSubscribers, nil, true
+	}
+
+	optionIDs := append([]primitive.ObjectID(nil), asset.OptionIDs...)
+	for _, id := range post.OptionIDs {
+		if !containsID(optionIDs, id) {
+			optionIDs = append(optionIDs, id)
+		}
+	}
+	if len(optionIDs) == len(asset.OptionIDs) {
+		return "", nil, false
+	}
+	return models.AssetSubscribers, optionIDs, true
+}
+
+func containsID(ids []primitive.ObjectID, id primitive.ObjectID) bool {
+	for _, other := range ids {
+		if other == id {
+			return true
+		}
+	}
+	return false
+}
+
+// ListPostsHandler lists a model's posts, newest first. Posts the caller
+// cannot see are returned locked. ?before= takes the id of the last post of
+// the previous page and ?limit= defaults to 20, at most 100.
+func (s *Server) ListPostsHandler(w http.ResponseWriter, r *http.Request) {
+	model, ok := s.findModel(w, r, mux.Vars(r)["modelId"])
+	if !ok {
+		return
+	}
+
+	var before *primitive.ObjectID
+	if v := r.URL.Query().Get("before"); v != "" {
+		id, err := primitive.ObjectIDFromHex(v)
+		if err != nil {
+			sendErrorCode(w, codeInvalidPost, "before must be a post id", http.StatusBadRequest)
+			return
+		}
+		before = &id
+	}
+	limit := int64(posts.DefaultLimit)
+	if v := r.URL.Query().Get("limit"); v != "" {
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || n <= 0 || n > posts.MaxLimit {
+			sendErrorCode(w, codeInvalidPost, "limit must be between 1 and "+strconv.Itoa(posts.MaxLimit), http.StatusBadRequest)
+			return
+		}
+		limit = n
+	}
+
+	list, err := posts.List(r.Context(), model.ID, before, limit)
+	if err != nil {
+		sendError(w, "Failed to retrieve posts: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	held, err := s.heldOptions(r.Context(), session(r), *model)
+	if err != nil {
+		sendError(w, "Failed to check subscriptions: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	response := make([]types.PostResponse, 0, len(list))
+	for _, post := range list {
+		visible := auth.CanViewPost(session(r), *model, post, held) == nil
+		response = append(response, postResponse(post, visible))
+	}
+
+	sendJSON(w, types.UserResponse{
+		Success: true,
+		Message: "Posts retrieved successfully",
+		Data:    response,
+	}, http.StatusOK)
+}
+
+// GetPostHandler returns one post, locked unless the caller can see it.
+func (s *Server) GetPostHandler(w http.ResponseWriter, r *http.Request) {
+	post, model, ok := s.findPost(w, r)
+	if !ok {
+		return
+	}
+
+	held, err := s.heldOptions(r.Context(), session(r), *model)
+	if err != nil {
+		sendError(w, "Failed to check subscriptions: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	visible := auth.CanViewPost(session(r), *model, *post, held) == nil
+
+	sendJSON(w, types.UserResponse{
+		Success: true,
+		Message: "Post retrieved successfully",
+		Data:    postResponse(*post, visible),
+	}, http.StatusOK)
+}
+
+// DeletePostHandler removes a post of a model registered with the signed-in
+// wallet. Its media stay stored.
+func (s *Server) DeletePostHandler(w http.ResponseWriter, r *http.Request) {
+	post, model, ok := s.findPost(w, r)
+	if !ok {
+		return
+	}
+	if !authorize(w, auth.CanManageModel(session(r), *model)) {
+		return
+	}
+
+	if err := posts.Delete(r.Context(), s.Models, post); err != nil {
+		sendError(w, "Failed to delete post: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSON(w, types.UserResponse{
+		Success: true,
+		Message: "Post deleted successfully",
+	}, http.StatusOK)
+}
+
+func (s *Server) findPost(w http.ResponseWriter, r *http.Request) (*models.Post, *models.Model, bool) {
+	post, err := posts.Get(r.Context(), mux.Vars(r)["id"])
+	if err != nil {
+		sendError(w, "Failed to retrieve post: "+err.Error(), http.StatusInternalServerError)
+		return nil, nil, false
+	}
+	if post == nil {
+		sendErrorCode(w, codePostNotFound, "Post not found", http.StatusNotFound)
+		return nil, nil, false
+	}
+
+	model, err := s.Models.Get(r.Context(), post.ModelID)
+	if !modelFound(w, err) {
+		return nil, nil, false
+	}
+	return post, model, true
+}
+
+// findModel loads the model with the given model_id.
+func (s *Server) findModel(w http.ResponseWriter, r *http.Request, modelID string) (*models.Model, bool) {
+	model, err := s.Models.FindByModelID(r.Context(), modelID)
+	return model, modelFound(w, err)
+}
+
+func modelFound(w http.ResponseWriter, err error) bool {
+	switch {
+	case err == storage.ErrNotFound:
+		sendErrorCode(w, codeModelNotFound, "Model not found", http.StatusNotFound)
+		return false
+	case err != nil:
+		sendError(w, "Failed to retrieve model: "+err.Error(), http.StatusInternalServerError)
+		return false
+	}
+	return true
+}
+
+// heldOptions returns the option of each of the caller's active
+// subscriptions to model, as auth.CanViewPost expects.
+func (s *Server) heldOptions(ctx context.Context, claims *auth.Claims, model models.Model) ([]*primitive.ObjectID, error) {
+	if claims == nil {
+		return nil, nil
+	}
+	subscriptions, err := s.activeSubscriptions(ctx, claims.WalletAddress, model)
+	if err != nil {
+		return nil, err
+	}
+
+	held := make([]*primitive.ObjectID, 0, len(subscriptions))
+	for _, sub := range subscriptions {
+		held = append(held, sub.OptionID)
+	}
+	return held, nil
+}
+
+func postResponse(post models.Post, visible bool) types.PostResponse {
+	response := types.PostResponse{
+		ID:         post.ID,
+		ModelID:    post.ModelID,
+		Visibility: post.Visibility,
+		OptionIDs:  post.OptionIDs,
+		Locked:     !visible,
+		MediaCount: len(post.AssetIDs),
+		CreatedAt:  post.CreatedAt,
+	}
+	if visible {
+		response.Text = post.Text
+		response.AssetIDs = post.AssetIDs
+	}
+	return response
+}
@@ -0,0 +1,92 @@
+package routes
+
+import (
+	"errors"
+	"net/http"
+
+	"arjunmal1311/fans_flow_on_chain/backend/chain"
+	"arjunmal1311/fans_flow_on_chain/backend/models"
+	"arjunmal1311/fans_flow_on_chain/backend/storage"
+)
+
+const (
+	codeChainNotConfigured = "CHAIN_NOT_CONFIGURED"
+	codeTxAlreadyUsed      = "TX_ALREADY_USED"
+)
+
+// verifyPurchaseTx checks that txHash is a confirmed marketplace purchase of
+// tokenId/modelId by the user's wallet on the given network, and that it has
+// not already been used to record a subscription. It writes the error
+// response itself and returns false when the purchase must be rejected.
+func (s *Server) verifyPurchaseTx(w http.ResponseWriter, r *http.Request, networkName string, user models.User, modelId, tokenId, txHash string) (*chain.SubscriptionPurchased, bool) {
+	network, ok := chain.NetworkByName(networkName)
+	if !ok || !network.HasRPC() {
+		sendErrorCode(w, codeChainNotConfigured, "Purchase verification is not configured for this network", http.StatusServiceUnavailable)
+		return nil, false
+	}
+
+	if !s.txUnused(w, r, txHash) {
+		return nil, false
+	}
+
+	purchase, err := chain.VerifyPurchase(r.Context(), network, txHash, chain.PurchaseClaim{
+		Buyer:   user.WalletAddress,
+		ModelID: modelId,
+		TokenID: tokenId,
+	})
+	if err != nil {
+		sendVerificationError(w, "purchase", err)
+		return nil, false
+	}
+
+	return purchase, true
+}
+
+// verifySaleTx checks that txHash is a confirmed marketplace sale of tokenId
+// to buyer, in the same way as verifyPurchaseTx, and returns the sale so the
+// seller can be taken from it.
+func (s *Server) verifySaleTx(w http.ResponseWriter, r *http.Request, networkName string, buyer models.User, tokenId, txHash string) (*chain.NFTSold, bool) {
+	network, ok := chain.NetworkByName(networkName)
+	if !ok || !network.HasRPC() {
+		sendErrorCode(w, codeChainNotConfigured, "Sale verification is not configured for this network", http.StatusServiceUnavailable)
+		return nil, false
+	}
+
+	if !s.txUnused(w, r, txHash) {
+		return nil, false
+	}
+
+	sale, err := chain.VerifySale(r.Context(), network, txHash, chain.SaleClaim{
+		Buyer:   buyer.WalletAddress,
+		TokenID: tokenId,
+	})
+	if err != nil {
+		sendVerificationError(w, "sale", err)
+		return nil, false
+	}
+
+	return sale, true
+}
+
+// txUnused rejects a transaction that already backs a recorded subscription.
+func (s *Server) txUnused(w http.ResponseWriter, r *http.Request, txHash string) bool {
+	_, err := s.Subscriptions.FindOne(r.Context(), storage.SubscriptionFilter{TxHash: txHash})
+	if err == nil {
+		sendErrorCode(w, codeTxAlreadyUsed, "Transaction has already been used to record a subscription", http.StatusConflict)
+		return false
+	}
+	if err != storage.ErrNotFound {
+		sendError(w, "Failed to check transaction: "+err.Error(), http.StatusInternalServerError)
+		return false
+	}
+	return true
+}
+
+func sendVerificationError(w http.ResponseWriter, kind string, err error) {
+	var verr *chain.VerificationError
+	if errors.As(err, &verr) {
+		sendErrorCode(w, verr.Code, verr.Message, http.StatusUnprocessableEntity)
+		return
+	}
+	sendError(w, "Failed to verify "+kind+" transaction: "+err.Error(), http.StatusBadGateway)
+}
@@ -0,0 +1,234 @@
+package routes
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gorilla/mux"
+
+	"arjunmal1311/fans_flow_on_chain/backend/auth"
+	"arjunmal1311/fans_flow_on_chain/backend/chain"
+	"arjunmal1311/fans_flow_on_chain/backend/models"
+	"arjunmal1311/fans_flow_on_chain/backend/storage"
+)
+
+const (
+	testChain   = "testnet"
+	testTokenID = "7000000000000000001"
+	aliceWallet = "0x00000000000000000000000000000000000a11ce"
+	bobWallet   = "0x0000000000000000000000000000000000000b0b"
+)
+
+func TestMain(m *testing.M) {
+	dir, err := os.MkdirTemp("", "routes")
+	if err != nil {
+		log.Fatal(err)
+	}
+	registry := filepath.Join(dir, "chains.json")
+	if err := os.WriteFile(registry, []byte(`{"chains": [{"name": "`+testChain+`", "chainId": 31337}]}`), 0o600); err != nil {
+		log.Fatal(err)
+	}
+	os.Setenv("CHAINS_CONFIG", registry)
+	os.Setenv("SESSION_SECRET", "routes-test-secret")
+	if err := chain.LoadRegistry(); err != nil {
+		log.Fatal(err)
+	}
+	if err := auth.Init(); err != nil {
+		log.Fatal(err)
+	}
+
+	code := m.Run()
+	os.RemoveAll(dir)
+	os.Exit(code)
+}
+
+// testResponse is types.UserResponse with Data left undecoded.
+type testResponse struct {
+	Success bool            `json:"success"`
+	Data    json.RawMessage `json:"data"`
+	Error   string          `json:"error"`
+	Code    string          `json:"code"`
+}
+
+func newTestRouter(repos storage.Repos) *mux.Router {
+	server := NewServer(repos, nil)
+	router := mux.NewRouter()
+	server.SetupUserRoutes(router)
+	server.SetupSubscriptionRoutes(router)
+	return router
+}
+
+// call serves method path on router, signed in as wallet unless it is
+// empty, and decodes the response.
+func call(t *testing.T, router http.Handler, method, path, wallet string, body interface{}) (int, testResponse) {
+	t.Helper()
+
+	var reader bytes.Buffer
+	if body != nil {
+		if err := json.NewEncoder(&reader).Encode(body); err != nil {
+			t.Fatal(err)
+		}
+	}
+	req := httptest.NewRequest(method, path, &reader)
+	if wallet != "" {
+		token, _, err := auth.IssueToken(wallet, 31337)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	var resp testResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("%s %s: decoding %q: %v", method, path, rec.Body.String(), err)
+	}
+	return rec.Code, resp
+}
+
+func TestRegister(t *testing.T) {
+	router := newTestRouter(storage.NewMemory())
+
+	alice := map[string]string{"username": "alice", "email": "alice@example.com", "wallet_address": aliceWallet}
+
+	if status, _ := call(t, router, "POST", "/register", "", alice); status != http.StatusUnauthorized {
+		t.Fatalf("register without a session: status %d, want 401", status)
+	}
+	if status, resp := call(t, router, "POST", "/register", bobWallet, alice); status != http.StatusForbidden || resp.Code != auth.CodeWalletMismatch {
+		t.Fatalf("register for another wallet: status %d code %q, want 403 %s", status, resp.Code, auth.CodeWalletMismatch)
+	}
+
+	status, resp := call(t, router, "POST", "/register", aliceWallet, alice)
+	if status != http.StatusCreated {
+		t.Fatalf("register: status %d (%s), want 201", status, resp.Error)
+	}
+	var user models.User
+	if err := json.Unmarshal(resp.Data, &user); err != nil {
+		t.Fatal(err)
+	}
+	if user.ID.IsZero() || user.Username != "alice" {
+		t.Fatalf("registered %+v", user)
+	}
+
+	duplicates := []map[string]string{
+		alice,
+		{"username": "alice", "email": "other@example.com", "wallet_address": aliceWallet},
+		{"username": "other", "email": "alice@example.com", "wallet_address": aliceWallet},
+		// Wallet addresses are compared case-insensitively.
+		{"username": "other", "email": "other@example.com", "wallet_address": "0x00000000000000000000000000000000000A11CE"},
+	}
+	for _, body := range duplicates {
+		if status, resp := call(t, router, "POST", "/register", aliceWallet, body); status != http.StatusConflict {
+			t.Errorf("register %v again: status %d (%s), want 409", body, status, resp.Error)
+		}
+	}
+}
+
+// seedSubscription registers alice and bob and gives alice a subscription
+// to a model on testChain.
+func seedSubscription(t *testing.T, repos storage.Repos) (alice, bob models.User) {
+	t.Helper()
+	ctx := context.Background()
+
+	alice = models.User{Username: "alice", Email: "alice@example.com", WalletAddress: aliceWallet}
+	bob = models.User{Username: "bob", Email: "bob@example.com", WalletAddress: bobWallet}
+	for _, u := range []*models.User{&alice, &bob} {
+		if err := repos.Users.Create(ctx, u); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	model := models.Model{Name: "Model", ModelID: "7", Email: "model@example.com", WalletAddress: "0x0000000000000000000000000000000000000007", Slug: "model"}
+	if err := repos.Models.Create(ctx, &model); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := repos.Subscriptions.RecordPurchase(ctx, models.Subscription{Chain: testChain, TokenID: testTokenID, UserID: alice.ID, ModelID: model.ID}); err != nil {
+		t.Fatal(err)
+	}
+	return alice, bob
+}
+
+// listed returns the subscriptions GET /chains/{chain}/subscriptions/listed
+// reports.
+func listed(t *testing.T, router http.Handler) []map[string]interface{} {
+	t.Helper()
+	status, resp := call(t, router, "GET", "/chains/"+testChain+"/subscriptions/listed", "", nil)
+	if status != http.StatusOK {
+		t.Fatalf("listed subscriptions: status %d (%s)", status, resp.Error)
+	}
+	var subs []map[string]interface{}
+	if len(resp.Data) > 0 {
+		if err := json.Unmarshal(resp.Data, &subs); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return subs
+}
+
+func TestListAndUpdateSubscription(t *testing.T) {
+	repos := storage.NewMemory()
+	router := newTestRouter(repos)
+	alice, bob := seedSubscription(t, repos)
+
+	subscription := "/chains/" + testChain + "/subscriptions/" + testTokenID
+	listing := map[string]string{"price": "25", "listingId": "3"}
+
+	if status, _ := call(t, router, "PATCH", "/chains/unknown/subscriptions/"+testTokenID+"/listing", aliceWallet, listing); status != http.StatusNotFound {
+		t.Fatalf("listing on an unknown chain: status %d, want 404", status)
+	}
+	if status, resp := call(t, router, "PATCH", subscription+"/listing", bobWallet, listing); status != http.StatusForbidden || resp.Code != auth.CodeNotSubscriptionHolder {
+		t.Fatalf("listing someone else's subscription: status %d code %q, want 403 %s", status, resp.Code, auth.CodeNotSubscriptionHolder)
+	}
+	if subs := listed(t, router); len(subs) != 0 {
+		t.Fatalf("listed before listing: %v", subs)
+	}
+
+	if status, resp := call(t, router, "PATCH", subscription+"/listing", aliceWallet, listing); status != http.StatusOK {
+		t.Fatalf("listing: status %d (%s), want 200", status, resp.Error)
+	}
+	subs := listed(t, router)
+	if len(subs) != 1 || subs[0]["user_id"] != alice.ID.Hex() || subs[0]["price"] != "25" || subs[0]["listing_id"] != "3" {
+		t.Fatalf("listed after listing: %v", subs)
+	}
+
+	// Bob can neither move Alice's subscription to her nor claim it without
+	// the marketplace sale.
+	if status, resp := call(t, router, "PATCH", subscription, bobWallet, map[string]interface{}{"walletAddress": aliceWallet}); status != http.StatusForbidden || resp.Code != auth.CodeTransferForbidden {
+		t.Fatalf("updating for another wallet: status %d code %q, want 403 %s", status, resp.Code, auth.CodeTransferForbidden)
+	}
+	if status, resp := call(t, router, "PATCH", subscription, bobWallet, map[string]interface{}{"walletAddress": bobWallet}); status != http.StatusForbidden || resp.Code != auth.CodeTransferForbidden {
+		t.Fatalf("claiming without txHash: status %d code %q, want 403 %s", status, resp.Code, auth.CodeTransferForbidden)
+	}
+
+	// Alice delists her own subscription.
+	status, resp := call(t, router, "PATCH", subscription, aliceWallet, map[string]interface{}{"walletAddress": aliceWallet, "isListed": false})
+	if status != http.StatusOK {
+		t.Fatalf("delisting: status %d (%s), want 200", status, resp.Error)
+	}
+	var updated models.Subscription
+	if err := json.Unmarshal(resp.Data, &updated); err != nil {
+		t.Fatal(err)
+	}
+	if updated.IsListed || updated.UserID != alice.ID {
+		t.Fatalf("delisted subscription: %+v", updated)
+	}
+	if subs := listed(t, router); len(subs) != 0 {
+		t.Fatalf("listed after delisting: %v", subs)
+	}
+
+	// Nothing moved to Bob.
+	held := storage.SubscriptionFilter{Chain: testChain, TokenID: testTokenID, UserID: &bob.ID}
+	if _, err := repos.Subscriptions.FindOne(context.Background(), held); err != storage.ErrNotFound {
+		t.Fatalf("Bob's subscription: %v, want ErrNotFound", err)
+	}
+}
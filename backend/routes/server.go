@@ -0,0 +1,18 @@
+package routes
+
+import (
+	"arjunmal1311/fans_flow_on_chain/backend/imageproc"
+	"arjunmal1311/fans_flow_on_chain/backend/storage"
+)
+
+// Server holds what the handlers that read users, models and subscriptions
+// depend on, so they can run against any storage backend.
+type Server struct {
+	storage.Repos
+	// Pipeline renders the previews /media serves.
+	Pipeline *imageproc.Pipeline
+}
+
+func NewServer(repos storage.Repos, pipeline *imageproc.Pipeline) *Server {
+	return &Server{Repos: repos, Pipeline: pipeline}
+}
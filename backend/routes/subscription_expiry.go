@@ -0,0 +1,92 @@
+package routes
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"time"
+
+	"arjunmal1311/fans_flow_on_chain/backend/chain"
+	"arjunmal1311/fans_flow_on_chain/backend/models"
+	"arjunmal1311/fans_flow_on_chain/backend/storage"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	codeInvalidStatus       = "INVALID_STATUS"
+	codeOptionModelMismatch = "OPTION_MODEL_MISMATCH"
+
+	statusAll = "all"
+)
+
+// loadSubscriptionOption returns the option a purchase was made with, or nil
+// when the request did not name one.
+func (s *Server) loadSubscriptionOption(w http.ResponseWriter, r *http.Request, optionId, modelId string) (*models.SubscriptionOption, bool) {
+	if optionId == "" {
+		return nil, true
+	}
+
+	id, err := primitive.ObjectIDFromHex(optionId)
+	if err != nil {
+		sendError(w, "Invalid subscriptionOptionId", http.StatusBadRequest)
+		return nil, false
+	}
+
+	option, err := s.Options.Get(r.Context(), id)
+	if err != nil {
+		if err == storage.ErrNotFound {
+			sendError(w, "Subscription option not found", http.StatusNotFound)
+			return nil, false
+		}
+		sendError(w, "Failed to retrieve subscription option: "+err.Error(), http.StatusInternalServerError)
+		return nil, false
+	}
+
+	if option.ModelID != modelId {
+		sendErrorCode(w, codeOptionModelMismatch, "Subscription option does not belong to model "+modelId, http.StatusBadRequest)
+		return nil, false
+	}
+
+	return option, true
+}
+
+// subscriptionTerm works out when a purchased subscription starts and
+// expires. The NFT contract's expirationTimes is authoritative; when the
+// chain cannot be read the option's duration (in days) is used instead.
+// Either value is nil when it cannot be determined.
+func subscriptionTerm(ctx context.Context, networkName string, purchase *chain.SubscriptionPurchased, option *models.SubscriptionOption) (startsAt, expiresAt *time.Time) {
+	if network, ok := chain.NetworkByName(networkName); ok && network.NFT != "" {
+		start, end, err := chain.PurchaseTerm(ctx, network, purchase)
+		if err == nil && !end.IsZero() {
+			return &start, &end
+		}
+		if err != nil {
+			log.Printf("Failed to read expiry of token %s on %s: %v", purchase.TokenID, networkName, err)
+		}
+	}
+
+	start := time.Now().UTC()
+	if option == nil || option.Duration <= 0 {
+		return &start, nil
+	}
+	end := start.Add(time.Duration(option.Duration) * 24 * time.Hour)
+	return &start, &end
+}
+
+// requestStatus reads the ?status= parameter narrowing a subscription
+// query: "active" (the default), "expired" or "all", which returns "". It
+// writes a 400 and returns false for any other value.
+func requestStatus(w http.ResponseWriter, r *http.Request) (string, bool) {
+	switch status := r.URL.Query().Get("status"); status {
+	case "", models.SubscriptionActive:
+		return models.SubscriptionActive, true
+	case models.SubscriptionExpired:
+		return models.SubscriptionExpired, true
+	case statusAll:
+		return "", true
+	default:
+		sendErrorCode(w, codeInvalidStatus, "status must be active, expired or all", http.StatusBadRequest)
+		return "", false
+	}
+}
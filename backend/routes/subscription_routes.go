@@ -0,0 +1,550 @@
+package routes
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"time"
+
+	"arjunmal1311/fans_flow_on_chain/backend/auth"
+	"arjunmal1311/fans_flow_on_chain/backend/chain"
+	"arjunmal1311/fans_flow_on_chain/backend/models"
+	"arjunmal1311/fans_flow_on_chain/backend/storage"
+	"arjunmal1311/fans_flow_on_chain/backend/tokenid"
+	"arjunmal1311/fans_flow_on_chain/backend/types"
+
+	"github.com/gorilla/mux"
+)
+
+const (
+	codeUnknownChain       = "UNKNOWN_CHAIN"
+	codeInvalidTokenID     = "INVALID_TOKEN_ID"
+	codeTokenModelMismatch = "TOKEN_MODEL_MISMATCH"
+)
+
+func (s *Server) SetupSubscriptionRoutes(router *mux.Router) {
+	router.HandleFunc("/chains/{chain}/user-info", s.GetUserInfoHandler).Methods("GET")
+	router.HandleFunc("/chains/{chain}/subscriptions", requireSession(s.PurchaseSubscriptionHandler)).Methods("POST")
+	router.HandleFunc("/chains/{chain}/subscriptions/listed", s.GetListedSubscriptionsHandler).Methods("GET")
+	router.HandleFunc("/chains/{chain}/subscriptions/{tokenId}/listing", requireSession(s.ListSubscriptionHandler)).Methods("PATCH")
+	router.HandleFunc("/chains/{chain}/subscriptions/{tokenId}", requireSession(s.UpdateSubscriptionHandler)).Methods("PATCH")
+
+	// Legacy per-network routes, kept for existing clients
+	router.HandleFunc("/user-info", withChain("default", s.GetUserInfoHandler)).Methods("GET")
+	router.HandleFunc("/user-info-moonbeam", withChain("moonbeam", s.GetUserInfoHandler)).Methods("GET")
+	router.HandleFunc("/user-info-metis", withChain("metis", s.GetUserInfoHandler)).Methods("GET")
+
+	legacy := []struct {
+		suffix string
+		chain  string
+	}{
+		{"", "default"},
+		{"-zkevm", "zkevm"},
+		{"-moonbeam", "moonbeam"},
+		{"-metis", "metis"},
+	}
+	for _, l := range legacy {
+		router.HandleFunc("/purchase-subscription"+l.suffix, withChain(l.chain, requireSession(s.PurchaseSubscriptionHandler))).Methods("POST")
+		router.HandleFunc("/list-subscription"+l.suffix, withChain(l.chain, requireSession(s.ListSubscriptionHandler))).Methods("PATCH")
+		router.HandleFunc("/update-subscription"+l.suffix, withChain(l.chain, requireSession(s.UpdateSubscriptionHandler))).Methods("PATCH")
+		router.HandleFunc("/listed-subscriptions"+l.suffix, withChain(l.chain, s.GetListedSubscriptionsHandler)).Methods("GET")
+	}
+}
+
+// withChain serves a chain-parameterised handler on a route without a
+// {chain} variable.
+func withChain(chainName string, handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := map[string]string{}
+		for k, v := range mux.Vars(r) {
+			vars[k] = v
+		}
+		vars["chain"] = chainName
+		handler(w, mux.SetURLVars(r, vars))
+	}
+}
+
+func requestChain(w http.ResponseWriter, r *http.Request) (string, bool) {
+	chainName := mux.Vars(r)["chain"]
+	if _, ok := chain.NetworkByName(chainName); !ok {
+		sendErrorCode(w, codeUnknownChain, "Unknown chain: "+chainName, http.StatusNotFound)
+		return "", false
+	}
+	return chainName, true
+}
+
+func (s *Server) GetUserInfoHandler(w http.ResponseWriter, r *http.Request) {
+	chainName, ok := requestChain(w, r)
+	if !ok {
+		return
+	}
+
+	walletAddress := r.URL.Query().Get("wallet_address")
+	email := r.URL.Query().Get("email")
+	if walletAddress == "" && email == "" {
+		sendError(w, "Wallet address or email is required", http.StatusBadRequest)
+		return
+	}
+
+	var user *models.User
+	var err error
+	if walletAddress != "" {
+		user, err = s.Users.FindByWallet(r.Context(), walletAddress)
+	} else {
+		user, err = s.Users.FindByEmail(r.Context(), email)
+	}
+	if err != nil {
+		if err == storage.ErrNotFound {
+			if walletAddress == "" {
+				sendError(w, "User not found", http.StatusNotFound)
+				return
+			}
+			model, err := s.Models.FindByWallet(r.Context(), walletAddress)
+			if err != nil {
+				sendError(w, "No user or model found", http.StatusNotFound)
+				return
+			}
+			response := types.UserResponse{
+				Success: true,
+				Message: "Model retrieved successfully",
+				Data: map[string]interface{}{
+					"user": model,
+				},
+			}
+			sendJSON(w, response, http.StatusOK)
+			return
+		}
+		sendError(w, "Failed to retrieve user: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	status, ok := requestStatus(w, r)
+	if !ok {
+		return
+	}
+
+	subscriptions, err := s.Subscriptions.FindWithModels(r.Context(), storage.SubscriptionFilter{Chain: chainName, UserID: &user.ID, Status: status})
+	if err != nil {
+		sendError(w, "Failed to retrieve subscriptions: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	now := time.Now()
+	var subscriptionDetails []types.SubscriptionDetails
+	var orphaned []types.OrphanedSubscription
+	for _, sub := range subscriptions {
+		model := sub.Model
+		if model == nil {
+			orphaned = append(orphaned, orphan(sub.Subscription))
+			continue
+		}
+
+		subscriptionDetails = append(subscriptionDetails, types.SubscriptionDetails{
+			Chain:     sub.Chain,
+			ModelID:   model.ModelID,
+			ModelName: model.Name,
+			IpfsUrl:   model.IpfsUrl,
+			TokenID:   sub.TokenID,
+			IsListed:  sub.IsListed,
+			Price:     sub.Price,
+			Status:    sub.Status(now),
+			StartsAt:  sub.StartsAt,
+			ExpiresAt: sub.ExpiresAt,
+		})
+	}
+
+	result := types.UserInfoResponse{
+		User:          *user,
+		Subscriptions: subscriptionDetails,
+	}
+
+	response := types.UserResponse{
+		Success:  true,
+		Message:  "User retrieved successfully",
+		Data:     result,
+		Orphaned: orphaned,
+	}
+
+	sendJSON(w, response, http.StatusOK)
+}
+
+func (s *Server) PurchaseSubscriptionHandler(w http.ResponseWriter, r *http.Request) {
+	chainName, ok := requestChain(w, r)
+	if !ok {
+		return
+	}
+
+	var req types.PurchaseSubscriptionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		sendError(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if req.Email == "" || req.ModelId == "" || req.TokenId == "" || req.TxHash == "" {
+		sendError(w, "Email, modelId, tokenId, and txHash are required", http.StatusBadRequest)
+		return
+	}
+
+	tokenModelID, err := tokenid.ModelID(req.TokenId)
+	if err != nil {
+		sendErrorCode(w, codeInvalidTokenID, "Invalid tokenId: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if !tokenid.SameModel(tokenModelID, req.ModelId) {
+		sendErrorCode(w, codeTokenModelMismatch, "tokenId "+req.TokenId+" belongs to model "+tokenModelID.String()+", not "+req.ModelId, http.StatusBadRequest)
+		return
+	}
+
+	user, err := s.Users.FindByEmail(r.Context(), req.Email)
+	if err != nil {
+		if err == storage.ErrNotFound {
+			sendError(w, "User not found with provided email", http.StatusNotFound)
+			return
+		}
+		sendError(w, "Failed to retrieve user: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if !authorize(w, auth.CanActAs(session(r), user.WalletAddress)) {
+		return
+	}
+
+	model, err := s.Models.FindByModelID(r.Context(), req.ModelId)
+	if err != nil {
+		if err == storage.ErrNotFound {
+			sendError(w, "Model not found with provided modelId", http.StatusNotFound)
+			return
+		}
+		sendError(w, "Failed to retrieve model: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	option, ok := s.loadSubscriptionOption(w, r, req.SubscriptionOptionId, req.ModelId)
+	if !ok {
+		return
+	}
+
+	purchase, ok := s.verifyPurchaseTx(w, r, chainName, *user, req.ModelId, req.TokenId, req.TxHash)
+	if !ok {
+		return
+	}
+
+	startsAt, expiresAt := subscriptionTerm(r.Context(), chainName, purchase, option)
+	sub := models.Subscription{
+		Chain:     chainName,
+		TokenID:   req.TokenId,
+		UserID:    user.ID,
+		ModelID:   model.ID,
+		TxHash:    req.TxHash,
+		StartsAt:  startsAt,
+		ExpiresAt: expiresAt,
+	}
+	if option != nil {
+		sub.OptionID = &option.ID
+	}
+
+	// The indexer may already have recorded this purchase from the chain, so
+	// upsert on the same chain/token/holder key it uses.
+	if err := s.Subscriptions.RecordPurchase(r.Context(), sub); err != nil {
+		sendError(w, "Failed to create subscription: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	result := types.PurchaseSubscriptionResponse{
+		Chain:     chainName,
+		UserId:    user.ID,
+		ModelId:   model.ID,
+		TokenId:   req.TokenId,
+		StartsAt:  startsAt,
+		ExpiresAt: expiresAt,
+	}
+
+	response := types.UserResponse{
+		Success: true,
+		Message: "Subscription purchased successfully",
+		Data:    result,
+	}
+
+	sendJSON(w, response, http.StatusOK)
+}
+
+func (s *Server) ListSubscriptionHandler(w http.ResponseWriter, r *http.Request) {
+	chainName, ok := requestChain(w, r)
+	if !ok {
+		return
+	}
+
+	var req types.ListSubscriptionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		sendError(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	if tokenId := mux.Vars(r)["tokenId"]; tokenId != "" {
+		req.TokenId = tokenId
+	}
+
+	if req.TokenId == "" || req.Price == "" {
+		sendError(w, "TokenId and price are required", http.StatusBadRequest)
+		return
+	}
+
+	caller, ok := s.sessionUser(w, r)
+	if !ok {
+		return
+	}
+
+	sub, holder, err := s.findSubscription(r.Context(), chainName, req.TokenId, caller)
+	if err != nil {
+		if err == storage.ErrNotFound {
+			sendError(w, "Subscription not found", http.StatusNotFound)
+			return
+		}
+		sendError(w, "Failed to retrieve subscription: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if !authorize(w, auth.CanManageSubscription(session(r), *holder)) {
+		return
+	}
+
+	listed := true
+	update := storage.SubscriptionUpdate{Price: &req.Price, IsListed: &listed}
+	if req.ListingId != "" {
+		update.ListingID = &req.ListingId
+	}
+
+	updatedSubscription, err := s.Subscriptions.Update(r.Context(), sub.ID, update)
+	if err != nil {
+		if err == storage.ErrNotFound {
+			sendError(w, "Subscription not found", http.StatusNotFound)
+			return
+		}
+		sendError(w, "Failed to update subscription: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	response := types.UserResponse{
+		Success: true,
+		Message: "Subscription listed successfully",
+		Data:    updatedSubscription,
+	}
+
+	sendJSON(w, response, http.StatusOK)
+}
+
+func (s *Server) UpdateSubscriptionHandler(w http.ResponseWriter, r *http.Request) {
+	chainName, ok := requestChain(w, r)
+	if !ok {
+		return
+	}
+
+	var req types.UpdateSubscriptionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		sendError(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	if tokenId := mux.Vars(r)["tokenId"]; tokenId != "" {
+		req.TokenId = tokenId
+	}
+
+	if req.TokenId == "" || (req.WalletAddress == "" && req.Email == "") {
+		sendError(w, "TokenId and walletAddress or email are required", http.StatusBadRequest)
+		return
+	}
+
+	var recipient *models.User
+	var err error
+	if req.WalletAddress != "" {
+		recipient, err = s.Users.FindByWallet(r.Context(), req.WalletAddress)
+	} else {
+		recipient, err = s.Users.FindByEmail(r.Context(), req.Email)
+	}
+	if err != nil {
+		if err == storage.ErrNotFound {
+			sendError(w, "User not found", http.StatusNotFound)
+			return
+		}
+		sendError(w, "Failed to retrieve user: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	caller, ok := s.sessionUser(w, r)
+	if !ok {
+		return
+	}
+
+	update := storage.SubscriptionUpdate{
+		IsListed: &req.IsListed,
+		Price:    req.Price,
+	}
+
+	sub, err := s.Subscriptions.FindOne(r.Context(), storage.SubscriptionFilter{Chain: chainName, TokenID: req.TokenId, UserID: &caller.ID})
+	switch {
+	case err == nil:
+		// The caller updates their own subscription.
+		if !authorize(w, auth.CanTransferSubscription(session(r), caller, *recipient)) {
+			return
+		}
+	case err == storage.ErrNotFound:
+		// Otherwise this claims the listed subscription the caller bought,
+		// which is the seller's in the verified sale.
+		sub, ok = s.claimSubscription(w, r, chainName, req.TokenId, req.TxHash, *recipient)
+		if !ok {
+			return
+		}
+		update.TxHash = &req.TxHash
+	default:
+		sendError(w, "Failed to retrieve subscription: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	updatedSubscription, err := s.Subscriptions.Update(r.Context(), sub.ID, update)
+	if err != nil {
+		if err == storage.ErrNotFound {
+			sendError(w, "Subscription not found", http.StatusNotFound)
+			return
+		}
+		sendError(w, "Failed to update subscription: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	response := types.UserResponse{
+		Success: true,
+		Message: "Subscription updated successfully",
+		Data:    updatedSubscription,
+	}
+
+	sendJSON(w, response, http.StatusOK)
+}
+
+// claimSubscription moves the listed subscription sold in txHash from its
+// seller to recipient, returning the subscription recipient now holds. It
+// writes the error response itself and returns false when the claim fails.
+func (s *Server) claimSubscription(w http.ResponseWriter, r *http.Request, chainName, tokenId, txHash string, recipient models.User) (*models.Subscription, bool) {
+	// The seller is only known once the sale is verified, so only the
+	// recipient is checked up front.
+	if !authorize(w, auth.CanTransferSubscription(session(r), models.User{}, recipient)) {
+		return nil, false
+	}
+	if txHash == "" {
+		sendErrorCode(w, auth.CodeTransferForbidden, "txHash of the marketplace sale is required to transfer a subscription", http.StatusForbidden)
+		return nil, false
+	}
+
+	sale, ok := s.verifySaleTx(w, r, chainName, recipient, tokenId, txHash)
+	if !ok {
+		return nil, false
+	}
+
+	seller, err := s.Users.FindByWallet(r.Context(), sale.Seller)
+	if err != nil {
+		if err == storage.ErrNotFound {
+			sendError(w, "Subscription not found", http.StatusNotFound)
+			return nil, false
+		}
+		sendError(w, "Failed to retrieve seller: "+err.Error(), http.StatusInternalServerError)
+		return nil, false
+	}
+
+	listed := true
+	sub, err := s.Subscriptions.FindOne(r.Context(), storage.SubscriptionFilter{Chain: chainName, TokenID: tokenId, UserID: &seller.ID, Listed: &listed})
+	if err != nil {
+		if err == storage.ErrNotFound {
+			sendError(w, "Subscription not found", http.StatusNotFound)
+			return nil, false
+		}
+		sendError(w, "Failed to retrieve subscription: "+err.Error(), http.StatusInternalServerError)
+		return nil, false
+	}
+
+	claimed, err := s.Subscriptions.Transfer(r.Context(), sub.ID, recipient.ID)
+	if err != nil {
+		sendError(w, "Failed to transfer subscription: "+err.Error(), http.StatusInternalServerError)
+		return nil, false
+	}
+	return claimed, true
+}
+
+func (s *Server) GetListedSubscriptionsHandler(w http.ResponseWriter, r *http.Request) {
+	chainName, ok := requestChain(w, r)
+	if !ok {
+		return
+	}
+
+	status, ok := requestStatus(w, r)
+	if !ok {
+		return
+	}
+
+	listed := true
+	subscriptions, err := s.Subscriptions.FindWithModels(r.Context(), storage.SubscriptionFilter{Chain: chainName, Listed: &listed, Status: status})
+	if err != nil {
+		sendError(w, "Failed to retrieve subscriptions: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	now := time.Now()
+	var listedSubscriptions []types.ListedSubscriptionResponse
+	var orphaned []types.OrphanedSubscription
+	for _, sub := range subscriptions {
+		model := sub.Model
+		if model == nil {
+			orphaned = append(orphaned, orphan(sub.Subscription))
+			continue
+		}
+
+		listedSubscriptions = append(listedSubscriptions, types.ListedSubscriptionResponse{
+			ID:        sub.ID,
+			Chain:     sub.Chain,
+			UserID:    sub.UserID,
+			ModelID:   sub.ModelID,
+			TokenID:   sub.TokenID,
+			ListingID: sub.ListingID,
+			Price:     sub.Price,
+			IsListed:  sub.IsListed,
+			Status:    sub.Status(now),
+			ExpiresAt: sub.ExpiresAt,
+			Model:     modelInfo(*model),
+		})
+	}
+
+	response := types.UserResponse{
+		Success:  true,
+		Message:  "Listed subscriptions retrieved successfully",
+		Data:     listedSubscriptions,
+		Orphaned: orphaned,
+	}
+
+	sendJSON(w, response, http.StatusOK)
+}
+
+// orphan reports a subscription whose model is missing. Such
+// subscriptions are left out of responses rather than failing them.
+func orphan(sub models.Subscription) types.OrphanedSubscription {
+	log.Printf("Subscription %s on %s (token %s) refers to missing model %s", sub.ID.Hex(), sub.Chain, sub.TokenID, sub.ModelID.Hex())
+	return types.OrphanedSubscription{
+		ID:      sub.ID,
+		Chain:   sub.Chain,
+		TokenID: sub.TokenID,
+		ModelID: sub.ModelID,
+	}
+}
+
+func modelInfo(model models.Model) types.ModelInfo {
+	info := types.ModelInfo{
+		ID:       model.ID,
+		ModelID:  model.ModelID,
+		Name:     model.Name,
+		Slug:     model.Slug,
+		Location: model.Location,
+		AboutMe:  model.AboutMe,
+		Value:    model.Value,
+		Views:    model.Views,
+		Tease:    model.Tease,
+		Posts:    model.Posts,
+		IpfsUrl:  model.IpfsUrl,
+	}
+	info.Image.Src = model.Image.Src
+	info.Icon.Src = model.Icon.Src
+	return info
+}
@@ -1,60 +1,34 @@
 package routes
 
 import (
-	"context"
 	"encoding/json"
+	"errors"
 	"log"
 	"net/http"
 	"strings"
 	"time"
 
-	"arjunmal1311/fans_flow_on_chain/backend/db"
+	"arjunmal1311/fans_flow_on_chain/backend/auth"
+	"arjunmal1311/fans_flow_on_chain/backend/imageproc"
+	"arjunmal1311/fans_flow_on_chain/backend/ipfs"
 	"arjunmal1311/fans_flow_on_chain/backend/models"
+	"arjunmal1311/fans_flow_on_chain/backend/storage"
 	"arjunmal1311/fans_flow_on_chain/backend/types"
 
 	"github.com/gorilla/mux"
-	"go.mongodb.org/mongo-driver/bson"
-	"go.mongodb.org/mongo-driver/bson/primitive"
-	"go.mongodb.org/mongo-driver/mongo"
-	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
-func SetupUserRoutes(router *mux.Router) {
-	router.HandleFunc("/register", RegisterHandler).Methods("POST")
-	router.HandleFunc("/register-model", RegisterModelHandler).Methods("POST")
-	router.HandleFunc("/user-info", GetUserInfoHandler).Methods("GET")
-	router.HandleFunc("/user-info-moonbeam", GetUserInfoMoonbeamHandler).Methods("GET")
-	router.HandleFunc("/user-info-metis", GetUserInfoMetisHandler).Methods("GET")
-	router.HandleFunc("/user-model-info", GetUserModelInfoHandler).Methods("GET")
-	router.HandleFunc("/purchase-subscription", PurchaseSubscriptionHandler).Methods("POST")
-	router.HandleFunc("/list-subscription", ListSubscriptionHandler).Methods("PATCH")
-	router.HandleFunc("/update-subscription", UpdateSubscriptionHandler).Methods("PATCH")
-	router.HandleFunc("/listed-subscriptions", GetListedSubscriptionsHandler).Methods("GET")
-	router.HandleFunc("/models", GetAllModelsHandler).Methods("GET")
-	router.HandleFunc("/model/{slug}", GetModelBySlugHandler).Methods("GET")
-	router.HandleFunc("/subscription-options", CreateSubscriptionOptionHandler).Methods("POST")
-	router.HandleFunc("/subscription-options/{modelId}", GetSubscriptionOptionsHandler).Methods("GET")
-
-	// ZkEVM routes
-	router.HandleFunc("/purchase-subscription-zkevm", PurchaseSubscriptionZkEVMHandler).Methods("POST")
-	router.HandleFunc("/list-subscription-zkevm", ListSubscriptionZkEVMHandler).Methods("PATCH")
-	router.HandleFunc("/update-subscription-zkevm", UpdateSubscriptionZkEVMHandler).Methods("PATCH")
-	router.HandleFunc("/listed-subscriptions-zkevm", GetListedSubscriptionsZkEVMHandler).Methods("GET")
-
-	// Moonbeam routes
-	router.HandleFunc("/purchase-subscription-moonbeam", PurchaseSubscriptionMoonbeamHandler).Methods("POST")
-	router.HandleFunc("/list-subscription-moonbeam", ListSubscriptionMoonbeamHandler).Methods("PATCH")
-	router.HandleFunc("/update-subscription-moonbeam", UpdateSubscriptionMoonbeamHandler).Methods("PATCH")
-	router.HandleFunc("/listed-subscriptions-moonbeam", GetListedSubscriptionsMoonbeamHandler).Methods("GET")
-
-	// Metis routes
-	router.HandleFunc("/purchase-subscription-metis", PurchaseSubscriptionMetisHandler).Methods("POST")
-	router.HandleFunc("/list-subscription-metis", ListSubscriptionMetisHandler).Methods("PATCH")
-	router.HandleFunc("/update-subscription-metis", UpdateSubscriptionMetisHandler).Methods("PATCH")
-	router.HandleFunc("/listed-subscriptions-metis", GetListedSubscriptionsMetisHandler).Methods("GET")
+func (s *Server) SetupUserRoutes(router *mux.Router) {
+	router.HandleFunc("/register", requireSession(s.RegisterHandler)).Methods("POST")
+	router.HandleFunc("/register-model", requireSession(s.RegisterModelHandler)).Methods("POST")
+	router.HandleFunc("/user-model-info", s.GetUserModelInfoHandler).Methods("GET")
+	router.HandleFunc("/models", s.GetAllModelsHandler).Methods("GET")
+	router.HandleFunc("/model/{slug}", s.GetModelBySlugHandler).Methods("GET")
+	router.HandleFunc("/subscription-options", requireSession(s.CreateSubscriptionOptionHandler)).Methods("POST")
+	router.HandleFunc("/subscription-options/{modelId}", s.GetSubscriptionOptionsHandler).Methods("GET")
 }
 
-func RegisterHandler(w http.ResponseWriter, r *http.Request) {
+func (s *Server) RegisterHandler(w http.ResponseWriter, r *http.Request) {
 	var req types.RegisterRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		sendError(w, "Invalid request body", http.StatusBadRequest)
@@ -66,25 +40,11 @@ func RegisterHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	collection := db.GetCollection("users")
-
-	filter := bson.M{
-		"$or": []bson.M{
-			{"email": req.Email},
-			{"username": req.Username},
-			{"wallet_address": req.WalletAddress},
-		},
-	}
-
-	var existingUser models.User
-	err := collection.FindOne(context.Background(), filter).Decode(&existingUser)
-	if err == nil {
-		sendError(w, "User already exists with the provided email, username, or wallet address", http.StatusConflict)
+	if !authorize(w, auth.CanActAs(session(r), req.WalletAddress)) {
 		return
 	}
 
 	newUser := models.User{
-		ID:            primitive.NewObjectID(),
 		Username:      req.Username,
 		Email:         req.Email,
 		WalletAddress: req.WalletAddress,
@@ -92,12 +52,19 @@ func RegisterHandler(w http.ResponseWriter, r *http.Request) {
 		OpenAiTokenId: req.OpenAiTokenId,
 	}
 
-	_, err = collection.InsertOne(context.Background(), newUser)
-	if err != nil {
+	if err := s.Users.Create(r.Context(), &newUser); err != nil {
+		if errors.Is(err, storage.ErrDuplicate) {
+			sendError(w, "User already exists with the provided email, username, or wallet address", http.StatusConflict)
+			return
+		}
 		sendError(w, "Failed to register user: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
 
+	if err := ipfs.LinkPins(r.Context(), newUser.IpfsUrl, "user_id", newUser.ID); err != nil {
+		log.Printf("Failed to link pins to user %s: %v", newUser.ID.Hex(), err)
+	}
+
 	response := types.UserResponse{
 		Success: true,
 		Message: "User registered successfully",
@@ -107,7 +74,7 @@ func RegisterHandler(w http.ResponseWriter, r *http.Request) {
 	sendJSON(w, response, http.StatusCreated)
 }
 
-func RegisterModelHandler(w http.ResponseWriter, r *http.Request) {
+func (s *Server) RegisterModelHandler(w http.ResponseWriter, r *http.Request) {
 	var req types.RegisterModelRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		sendError(w, "Invalid request body", http.StatusBadRequest)
@@ -119,25 +86,25 @@ func RegisterModelHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	collection := db.GetCollection("models")
-
-	filter := bson.M{
-		"$or": []bson.M{
-			{"model_id": req.ModelId},
-			{"email": req.Email},
-			{"wallet_address": req.WalletAddress},
-		},
+	if !authorize(w, auth.CanActAs(session(r), req.WalletAddress)) {
+		return
 	}
 
-	var existingModel models.Model
-	err := collection.FindOne(context.Background(), filter).Decode(&existingModel)
-	if err == nil {
-		sendError(w, "Model already exists with the provided model_id, email, or wallet address", http.StatusConflict)
-		return
+	if req.AssetID != "" {
+		asset, ok := ownedAsset(w, r, req.AssetID)
+		if !ok {
+			return
+		}
+		card, icon := asset.Renditions[imageproc.Card].URL, asset.Renditions[imageproc.Icon].URL
+		if card == "" || icon == "" {
+			sendErrorCode(w, codeAssetNotPublished, "Publish the asset with /create-nft-pin-metadata first", http.StatusConflict)
+			return
+		}
+		req.Image.Src = card
+		req.Icon.Src = icon
 	}
 
 	newModel := models.Model{
-		ID:            primitive.NewObjectID(),
 		Name:          req.Name,
 		ModelID:       req.ModelId,
 		Email:         req.Email,
@@ -150,7 +117,6 @@ func RegisterModelHandler(w http.ResponseWriter, r *http.Request) {
 		Value:         req.Value,
 		Views:         req.Views,
 		Tease:         req.Tease,
-		Posts:         req.Posts,
 		Image: struct {
 			Src string `bson:"src" json:"src"`
 		}{
@@ -163,236 +129,29 @@ func RegisterModelHandler(w http.ResponseWriter, r *http.Request) {
 		},
 	}
 
-	_, err = collection.InsertOne(context.Background(), newModel)
-	if err != nil {
-		sendError(w, "Failed to register model: "+err.Error(), http.StatusInternalServerError)
-		return
-	}
-
-	response := types.UserResponse{
-		Success: true,
-		Message: "Model registered successfully",
-		Data:    newModel,
-	}
-
-	sendJSON(w, response, http.StatusCreated)
-}
-
-func GetUserInfoHandler(w http.ResponseWriter, r *http.Request) {
-	walletAddress := r.URL.Query().Get("wallet_address")
-	if walletAddress == "" {
-		sendError(w, "Wallet address is required", http.StatusBadRequest)
-		return
-	}
-
-	usersCollection := db.GetCollection("users")
-	modelsCollection := db.GetCollection("models")
-	subscriptionsCollection := db.GetCollection("subscriptions")
-
-	var user models.User
-	err := usersCollection.FindOne(context.Background(), bson.M{"wallet_address": walletAddress}).Decode(&user)
-	if err != nil {
-		if err == mongo.ErrNoDocuments {
-			var model models.Model
-			err = modelsCollection.FindOne(context.Background(), bson.M{"wallet_address": walletAddress}).Decode(&model)
-			if err != nil {
-				sendError(w, "No user or model found", http.StatusNotFound)
-				return
-			}
-			response := types.UserResponse{
-				Success: true,
-				Message: "Model retrieved successfully",
-				Data: map[string]interface{}{
-					"user": model,
-				},
-			}
-			sendJSON(w, response, http.StatusOK)
-			return
-		}
-		sendError(w, "Failed to retrieve user: "+err.Error(), http.StatusInternalServerError)
-		return
-	}
-
-	cursor, err := subscriptionsCollection.Find(context.Background(), bson.M{"user_id": user.ID})
-	if err != nil {
-		sendError(w, "Failed to retrieve subscriptions: "+err.Error(), http.StatusInternalServerError)
-		return
-	}
-	defer cursor.Close(context.Background())
-
-	var subscriptions []models.Subscription
-	if err = cursor.All(context.Background(), &subscriptions); err != nil {
-		sendError(w, "Failed to decode subscriptions: "+err.Error(), http.StatusInternalServerError)
-		return
-	}
-
-	var subscriptionDetails []types.SubscriptionDetails
-	for _, sub := range subscriptions {
-		var model models.Model
-		err := modelsCollection.FindOne(context.Background(), bson.M{"_id": sub.ModelID}).Decode(&model)
-		if err != nil {
-			continue
-		}
-
-		subscriptionDetails = append(subscriptionDetails, types.SubscriptionDetails{
-			ModelID:   model.ModelID,
-			ModelName: model.Name,
-			IpfsUrl:   model.IpfsUrl,
-			TokenID:   sub.TokenID,
-			IsListed:  sub.IsListed,
-			Price:     sub.Price,
-		})
-	}
-
-	result := types.UserInfoResponse{
-		User:          user,
-		Subscriptions: subscriptionDetails,
-	}
-
-	response := types.UserResponse{
-		Success: true,
-		Message: "User retrieved successfully",
-		Data:    result,
-	}
-
-	sendJSON(w, response, http.StatusOK)
-}
-
-func GetUserInfoMoonbeamHandler(w http.ResponseWriter, r *http.Request) {
-	email := r.URL.Query().Get("email")
-	if email == "" {
-		sendError(w, "Email is required", http.StatusBadRequest)
-		return
-	}
-
-	usersCollection := db.GetCollection("users")
-	moonbeamSubsCollection := db.GetCollection("subscriptions_moonbeam")
-	modelsCollection := db.GetCollection("models")
-
-	var user models.User
-	err := usersCollection.FindOne(context.Background(), bson.M{"email": email}).Decode(&user)
-	if err != nil {
-		if err == mongo.ErrNoDocuments {
-			sendError(w, "User not found", http.StatusNotFound)
-			return
-		}
-		sendError(w, "Failed to retrieve user: "+err.Error(), http.StatusInternalServerError)
-		return
-	}
-
-	cursor, err := moonbeamSubsCollection.Find(context.Background(), bson.M{"user_id": user.ID})
-	if err != nil {
-		sendError(w, "Failed to retrieve Moonbeam subscriptions: "+err.Error(), http.StatusInternalServerError)
-		return
-	}
-	defer cursor.Close(context.Background())
-
-	var subscriptions []models.SubscriptionMoonbeam
-	if err = cursor.All(context.Background(), &subscriptions); err != nil {
-		sendError(w, "Failed to decode Moonbeam subscriptions: "+err.Error(), http.StatusInternalServerError)
-		return
-	}
-
-	var subscriptionDetails []types.SubscriptionDetails
-	for _, sub := range subscriptions {
-		var model models.Model
-		err := modelsCollection.FindOne(context.Background(), bson.M{"_id": sub.ModelID}).Decode(&model)
-		if err != nil {
-			continue
-		}
-
-		subscriptionDetails = append(subscriptionDetails, types.SubscriptionDetails{
-			ModelID:   model.ModelID,
-			ModelName: model.Name,
-			IpfsUrl:   model.IpfsUrl,
-			TokenID:   sub.TokenID,
-			IsListed:  sub.IsListed,
-			Price:     sub.Price,
-		})
-	}
-
-	result := types.UserInfoResponse{
-		User:          user,
-		Subscriptions: subscriptionDetails,
-	}
-
-	response := types.UserResponse{
-		Success: true,
-		Message: "User retrieved successfully",
-		Data:    result,
-	}
-
-	sendJSON(w, response, http.StatusOK)
-}
-
-func GetUserInfoMetisHandler(w http.ResponseWriter, r *http.Request) {
-	email := r.URL.Query().Get("email")
-	if email == "" {
-		sendError(w, "Email is required", http.StatusBadRequest)
-		return
-	}
-
-	usersCollection := db.GetCollection("users")
-	metisSubsCollection := db.GetCollection("subscriptions_metis")
-	modelsCollection := db.GetCollection("models")
-
-	var user models.User
-	err := usersCollection.FindOne(context.Background(), bson.M{"email": email}).Decode(&user)
-	if err != nil {
-		if err == mongo.ErrNoDocuments {
-			sendError(w, "User not found", http.StatusNotFound)
+	if err := s.Models.Create(r.Context(), &newModel); err != nil {
+		if errors.Is(err, storage.ErrDuplicate) {
+			sendError(w, "Model already exists with the provided model_id, email, wallet address or slug", http.StatusConflict)
 			return
 		}
-		sendError(w, "Failed to retrieve user: "+err.Error(), http.StatusInternalServerError)
-		return
-	}
-
-	cursor, err := metisSubsCollection.Find(context.Background(), bson.M{"user_id": user.ID})
-	if err != nil {
-		sendError(w, "Failed to retrieve Metis subscriptions: "+err.Error(), http.StatusInternalServerError)
-		return
-	}
-	defer cursor.Close(context.Background())
-
-	var subscriptions []models.SubscriptionMetis
-	if err = cursor.All(context.Background(), &subscriptions); err != nil {
-		sendError(w, "Failed to decode Metis subscriptions: "+err.Error(), http.StatusInternalServerError)
+		sendError(w, "Failed to register model: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
 
-	var subscriptionDetails []types.SubscriptionDetails
-	for _, sub := range subscriptions {
-		var model models.Model
-		err := modelsCollection.FindOne(context.Background(), bson.M{"_id": sub.ModelID}).Decode(&model)
-		if err != nil {
-			continue
-		}
-
-		subscriptionDetails = append(subscriptionDetails, types.SubscriptionDetails{
-			ModelID:   model.ModelID,
-			ModelName: model.Name,
-			IpfsUrl:   model.IpfsUrl,
-			TokenID:   sub.TokenID,
-			IsListed:  sub.IsListed,
-			Price:     sub.Price,
-		})
-	}
-
-	result := types.UserInfoResponse{
-		User:          user,
-		Subscriptions: subscriptionDetails,
+	if err := ipfs.LinkPins(r.Context(), newModel.IpfsUrl, "model_id", newModel.ID); err != nil {
+		log.Printf("Failed to link pins to model %s: %v", newModel.ID.Hex(), err)
 	}
 
 	response := types.UserResponse{
 		Success: true,
-		Message: "User retrieved successfully",
-		Data:    result,
+		Message: "Model registered successfully",
+		Data:    newModel,
 	}
 
-	sendJSON(w, response, http.StatusOK)
+	sendJSON(w, response, http.StatusCreated)
 }
 
-func GetUserModelInfoHandler(w http.ResponseWriter, r *http.Request) {
+func (s *Server) GetUserModelInfoHandler(w http.ResponseWriter, r *http.Request) {
 	walletAddress := r.URL.Query().Get("wallet_address")
 	tokenId := r.URL.Query().Get("tokenId")
 
@@ -401,12 +160,9 @@ func GetUserModelInfoHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	usersCollection := db.GetCollection("users")
-
-	var user models.User
-	err := usersCollection.FindOne(context.Background(), bson.M{"openai_token_id": tokenId}).Decode(&user)
+	user, err := s.Users.FindByOpenAiTokenID(r.Context(), tokenId)
 	if err != nil {
-		if err == mongo.ErrNoDocuments {
+		if err == storage.ErrNotFound {
 			log.Printf("No user found with openai_token_id: %s", tokenId)
 			sendError(w, "No user matches the provided details", http.StatusNotFound)
 			return
@@ -422,7 +178,7 @@ func GetUserModelInfoHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	result := types.UserModelInfoResponse{
-		User: user,
+		User: *user,
 	}
 
 	response := types.UserResponse{
@@ -434,1080 +190,143 @@ func GetUserModelInfoHandler(w http.ResponseWriter, r *http.Request) {
 	sendJSON(w, response, http.StatusOK)
 }
 
-func PurchaseSubscriptionHandler(w http.ResponseWriter, r *http.Request) {
-	var req types.PurchaseSubscriptionRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		sendError(w, "Invalid request body", http.StatusBadRequest)
-		return
-	}
-
-	if req.Email == "" || req.ModelId == "" || req.TokenId == "" {
-		sendError(w, "Email, modelId, and tokenId are required", http.StatusBadRequest)
-		return
-	}
-
-	usersCollection := db.GetCollection("users")
-	modelsCollection := db.GetCollection("models")
-	subscriptionsCollection := db.GetCollection("subscriptions")
-
-	var user models.User
-	err := usersCollection.FindOne(context.Background(), bson.M{"email": req.Email}).Decode(&user)
-	if err != nil {
-		if err == mongo.ErrNoDocuments {
-			sendError(w, "User not found with provided email", http.StatusNotFound)
-			return
-		}
-		sendError(w, "Failed to retrieve user: "+err.Error(), http.StatusInternalServerError)
-		return
-	}
-
-	var model models.Model
-	err = modelsCollection.FindOne(context.Background(), bson.M{"model_id": req.ModelId}).Decode(&model)
-	if err != nil {
-		if err == mongo.ErrNoDocuments {
-			sendError(w, "Model not found with provided modelId", http.StatusNotFound)
-			return
-		}
-		sendError(w, "Failed to retrieve model: "+err.Error(), http.StatusInternalServerError)
-		return
-	}
-
-	newSubscription := models.Subscription{
-		ID:      primitive.NewObjectID(),
-		UserID:  user.ID,
-		ModelID: model.ID,
-		TokenID: req.TokenId,
-	}
-
-	_, err = subscriptionsCollection.InsertOne(context.Background(), newSubscription)
+func (s *Server) GetAllModelsHandler(w http.ResponseWriter, r *http.Request) {
+	models, err := s.Models.List(r.Context())
 	if err != nil {
-		sendError(w, "Failed to create subscription: "+err.Error(), http.StatusInternalServerError)
+		sendError(w, "Failed to retrieve models: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
 
-	result := types.PurchaseSubscriptionResponse{
-		UserId:  user.ID,
-		ModelId: model.ID,
-		TokenId: req.TokenId,
-	}
-
 	response := types.UserResponse{
 		Success: true,
-		Message: "Subscription purchased successfully",
-		Data:    result,
+		Message: "Models retrieved successfully",
+		Data:    models,
 	}
 
 	sendJSON(w, response, http.StatusOK)
 }
 
-func ListSubscriptionHandler(w http.ResponseWriter, r *http.Request) {
-	var req types.ListSubscriptionRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		sendError(w, "Invalid request body", http.StatusBadRequest)
-		return
-	}
+func (s *Server) GetModelBySlugHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	slug := vars["slug"]
 
-	if req.TokenId == "" || req.ListingId == "" || req.Price == "" {
-		sendError(w, "TokenId, listingId, and price are required", http.StatusBadRequest)
+	if slug == "" {
+		sendError(w, "Slug is required", http.StatusBadRequest)
 		return
 	}
 
-	collection := db.GetCollection("subscriptions")
-
-	update := bson.M{
-		"$set": bson.M{
-			"price":      req.Price,
-			"listing_id": req.ListingId,
-			"is_listed":  true,
-		},
-	}
-
-	var updatedSubscription models.Subscription
-	err := collection.FindOneAndUpdate(
-		context.Background(),
-		bson.M{"token_id": req.TokenId},
-		update,
-		options.FindOneAndUpdate().SetReturnDocument(options.After),
-	).Decode(&updatedSubscription)
-
+	model, err := s.Models.FindBySlug(r.Context(), slug)
 	if err != nil {
-		if err == mongo.ErrNoDocuments {
-			sendError(w, "Subscription not found", http.StatusNotFound)
+		if err == storage.ErrNotFound {
+			sendError(w, "Model not found", http.StatusNotFound)
 			return
 		}
-		sendError(w, "Failed to update subscription: "+err.Error(), http.StatusInternalServerError)
+		sendError(w, "Failed to retrieve model: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
 
 	response := types.UserResponse{
 		Success: true,
-		Message: "Subscription listed successfully",
-		Data:    updatedSubscription,
+		Message: "Model retrieved successfully",
+		Data:    model,
 	}
 
 	sendJSON(w, response, http.StatusOK)
 }
 
-func UpdateSubscriptionHandler(w http.ResponseWriter, r *http.Request) {
-	var req types.UpdateSubscriptionRequest
+func (s *Server) CreateSubscriptionOptionHandler(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		ModelID     string `json:"modelId"`
+		Price       string `json:"price"`
+		Duration    int    `json:"duration"`
+		Description string `json:"description"`
+	}
+
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		sendError(w, "Invalid request body", http.StatusBadRequest)
 		return
 	}
 
-	if req.TokenId == "" || req.WalletAddress == "" {
-		sendError(w, "TokenId and walletAddress are required", http.StatusBadRequest)
+	if req.ModelID == "" || req.Price == "" || req.Duration == 0 {
+		sendError(w, "ModelId, price, and duration are required", http.StatusBadRequest)
 		return
 	}
 
-	usersCollection := db.GetCollection("users")
-	subscriptionsCollection := db.GetCollection("subscriptions")
-
-	var user models.User
-	err := usersCollection.FindOne(context.Background(), bson.M{"wallet_address": req.WalletAddress}).Decode(&user)
+	model, err := s.Models.FindByModelID(r.Context(), req.ModelID)
 	if err != nil {
-		if err == mongo.ErrNoDocuments {
-			sendError(w, "User not found", http.StatusNotFound)
+		if err == storage.ErrNotFound {
+			sendError(w, "Model not found", http.StatusNotFound)
 			return
 		}
-		sendError(w, "Failed to retrieve user: "+err.Error(), http.StatusInternalServerError)
+		sendError(w, "Failed to verify model: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
 
-	update := bson.M{
-		"$set": bson.M{
-			"user_id":   user.ID,
-			"is_listed": req.IsListed,
-			"price":     req.Price,
-		},
-	}
-
-	var updatedSubscription models.Subscription
-	err = subscriptionsCollection.FindOneAndUpdate(
-		context.Background(),
-		bson.M{"token_id": req.TokenId},
-		update,
-		options.FindOneAndUpdate().SetReturnDocument(options.After),
-	).Decode(&updatedSubscription)
-
-	if err != nil {
-		if err == mongo.ErrNoDocuments {
-			sendError(w, "Subscription not found", http.StatusNotFound)
-			return
-		}
-		sendError(w, "Failed to update subscription: "+err.Error(), http.StatusInternalServerError)
+	if !authorize(w, auth.CanManageModel(session(r), *model)) {
 		return
 	}
 
-	response := types.UserResponse{
-		Success: true,
-		Message: "Subscription updated successfully",
-		Data:    updatedSubscription,
-	}
-
-	sendJSON(w, response, http.StatusOK)
-}
-
-type ListedSubscriptionResponse struct {
-	ID        primitive.ObjectID `json:"id"`
-	UserID    primitive.ObjectID `json:"user_id"`
-	ModelID   primitive.ObjectID `json:"model_id"`
-	TokenID   string             `json:"token_id"`
-	ListingID string             `json:"listing_id,omitempty"`
-	Price     string             `json:"price,omitempty"`
-	IsListed  bool               `json:"is_listed"`
-	Model     struct {
-		ID      primitive.ObjectID `json:"id"`
-		ModelID string             `json:"model_id"`
-		Name    string             `json:"name"`
-		IpfsUrl string             `json:"ipfs_url"`
-	} `json:"model"`
-}
-
-func GetListedSubscriptionsHandler(w http.ResponseWriter, r *http.Request) {
-	subscriptionsCollection := db.GetCollection("subscriptions")
-	modelsCollection := db.GetCollection("models")
-
-	cursor, err := subscriptionsCollection.Find(context.Background(), bson.M{"is_listed": true})
-	if err != nil {
-		sendError(w, "Failed to retrieve subscriptions: "+err.Error(), http.StatusInternalServerError)
-		return
+	subscriptionOption := models.SubscriptionOption{
+		ModelID:     req.ModelID,
+		Price:       req.Price,
+		Duration:    req.Duration,
+		Description: req.Description,
+		CreatedAt:   time.Now(),
 	}
-	defer cursor.Close(context.Background())
 
-	var subscriptions []models.Subscription
-	if err = cursor.All(context.Background(), &subscriptions); err != nil {
-		sendError(w, "Failed to decode subscriptions: "+err.Error(), http.StatusInternalServerError)
+	if err := s.Options.Create(r.Context(), &subscriptionOption); err != nil {
+		sendError(w, "Failed to create subscription option: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
 
-	var listedSubscriptions []ListedSubscriptionResponse
-	for _, sub := range subscriptions {
-		var model models.Model
-		err := modelsCollection.FindOne(context.Background(), bson.M{"_id": sub.ModelID}).Decode(&model)
-		if err != nil {
-			continue
-		}
-
-		listedSub := ListedSubscriptionResponse{
-			ID:        sub.ID,
-			UserID:    sub.UserID,
-			ModelID:   sub.ModelID,
-			TokenID:   sub.TokenID,
-			ListingID: sub.ListingID,
-			Price:     sub.Price,
-			IsListed:  sub.IsListed,
-		}
-		listedSub.Model.ID = model.ID
-		listedSub.Model.ModelID = model.ModelID
-		listedSub.Model.Name = model.Name
-		listedSub.Model.IpfsUrl = model.IpfsUrl
-
-		listedSubscriptions = append(listedSubscriptions, listedSub)
-	}
-
 	response := types.UserResponse{
 		Success: true,
-		Message: "Listed subscriptions retrieved successfully",
-		Data:    listedSubscriptions,
+		Message: "Subscription option created successfully",
+		Data:    subscriptionOption,
 	}
 
-	sendJSON(w, response, http.StatusOK)
+	sendJSON(w, response, http.StatusCreated)
 }
 
-func PurchaseSubscriptionZkEVMHandler(w http.ResponseWriter, r *http.Request) {
-	var req types.ChainSubscriptionRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		sendError(w, "Invalid request body", http.StatusBadRequest)
-		return
-	}
-
-	if req.Email == "" || req.ModelId == "" || req.TokenId == "" {
-		sendError(w, "Email, modelId, and tokenId are required", http.StatusBadRequest)
-		return
-	}
-
-	usersCollection := db.GetCollection("users")
-	modelsCollection := db.GetCollection("models")
-	subscriptionsCollection := db.GetCollection("subscriptions_zkevm")
-
-	var user models.User
-	err := usersCollection.FindOne(context.Background(), bson.M{"email": req.Email}).Decode(&user)
-	if err != nil {
-		if err == mongo.ErrNoDocuments {
-			sendError(w, "User not found with provided email", http.StatusNotFound)
-			return
-		}
-		sendError(w, "Failed to retrieve user: "+err.Error(), http.StatusInternalServerError)
-		return
-	}
+func (s *Server) GetSubscriptionOptionsHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	modelId := vars["modelId"]
 
-	var model models.Model
-	err = modelsCollection.FindOne(context.Background(), bson.M{"model_id": req.ModelId}).Decode(&model)
-	if err != nil {
-		if err == mongo.ErrNoDocuments {
-			sendError(w, "Model not found with provided modelId", http.StatusNotFound)
-			return
-		}
-		sendError(w, "Failed to retrieve model: "+err.Error(), http.StatusInternalServerError)
+	if modelId == "" {
+		sendError(w, "ModelId is required", http.StatusBadRequest)
 		return
 	}
 
-	newSubscription := models.SubscriptionZkEVM{
-		ID:      primitive.NewObjectID(),
-		UserID:  user.ID,
-		ModelID: model.ID,
-		TokenID: req.TokenId,
-	}
-
-	_, err = subscriptionsCollection.InsertOne(context.Background(), newSubscription)
+	options, err := s.Options.ListByModel(r.Context(), modelId)
 	if err != nil {
-		sendError(w, "Failed to create subscription: "+err.Error(), http.StatusInternalServerError)
+		sendError(w, "Failed to retrieve subscription options: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
 
-	result := types.ChainSubscriptionResponse{
-		UserId:  user.ID,
-		ModelId: model.ID,
-		TokenId: req.TokenId,
-	}
-
 	response := types.UserResponse{
 		Success: true,
-		Message: "Subscription purchased successfully",
-		Data:    result,
+		Message: "Subscription options retrieved successfully",
+		Data:    options,
 	}
 
 	sendJSON(w, response, http.StatusOK)
 }
 
-func ListSubscriptionZkEVMHandler(w http.ResponseWriter, r *http.Request) {
-	var req types.ChainListSubscriptionRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		sendError(w, "Invalid request body", http.StatusBadRequest)
-		return
-	}
-
-	if req.TokenId == "" {
-		sendError(w, "TokenId is required", http.StatusBadRequest)
-		return
-	}
+func sendJSON(w http.ResponseWriter, data interface{}, status int) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(data)
+}
 
-	collection := db.GetCollection("subscriptions_zkevm")
-
-	update := bson.M{
-		"$set": bson.M{
-			"is_listed": true,
-		},
-	}
-
-	var updatedSubscription models.SubscriptionZkEVM
-	err := collection.FindOneAndUpdate(
-		context.Background(),
-		bson.M{"token_id": req.TokenId},
-		update,
-		options.FindOneAndUpdate().SetReturnDocument(options.After),
-	).Decode(&updatedSubscription)
-
-	if err != nil {
-		if err == mongo.ErrNoDocuments {
-			sendError(w, "Subscription not found", http.StatusNotFound)
-			return
-		}
-		sendError(w, "Failed to update subscription: "+err.Error(), http.StatusInternalServerError)
-		return
-	}
-
-	response := types.UserResponse{
-		Success: true,
-		Message: "Subscription listed successfully",
-		Data:    updatedSubscription,
-	}
-
-	sendJSON(w, response, http.StatusOK)
-}
-
-func UpdateSubscriptionZkEVMHandler(w http.ResponseWriter, r *http.Request) {
-	var req types.ChainUpdateSubscriptionRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		sendError(w, "Invalid request body", http.StatusBadRequest)
-		return
-	}
-
-	if req.TokenId == "" || req.Email == "" {
-		sendError(w, "TokenId and email are required", http.StatusBadRequest)
-		return
-	}
-
-	usersCollection := db.GetCollection("users")
-	subscriptionsCollection := db.GetCollection("subscriptions_zkevm")
-
-	var user models.User
-	err := usersCollection.FindOne(context.Background(), bson.M{"email": req.Email}).Decode(&user)
-	if err != nil {
-		if err == mongo.ErrNoDocuments {
-			sendError(w, "User not found", http.StatusNotFound)
-			return
-		}
-		sendError(w, "Failed to retrieve user: "+err.Error(), http.StatusInternalServerError)
-		return
-	}
-
-	update := bson.M{
-		"$set": bson.M{
-			"user_id":   user.ID,
-			"is_listed": false,
-		},
-	}
-
-	var updatedSubscription models.SubscriptionZkEVM
-	err = subscriptionsCollection.FindOneAndUpdate(
-		context.Background(),
-		bson.M{"token_id": req.TokenId},
-		update,
-		options.FindOneAndUpdate().SetReturnDocument(options.After),
-	).Decode(&updatedSubscription)
-
-	if err != nil {
-		if err == mongo.ErrNoDocuments {
-			sendError(w, "Subscription not found", http.StatusNotFound)
-			return
-		}
-		sendError(w, "Failed to update subscription: "+err.Error(), http.StatusInternalServerError)
-		return
-	}
-
-	response := types.UserResponse{
-		Success: true,
-		Message: "Subscription updated successfully",
-		Data:    updatedSubscription,
-	}
-
-	sendJSON(w, response, http.StatusOK)
-}
-
-func GetListedSubscriptionsZkEVMHandler(w http.ResponseWriter, r *http.Request) {
-	subscriptionsCollection := db.GetCollection("subscriptions_zkevm")
-	modelsCollection := db.GetCollection("models")
-
-	cursor, err := subscriptionsCollection.Find(context.Background(), bson.M{"is_listed": true})
-	if err != nil {
-		sendError(w, "Failed to retrieve subscriptions: "+err.Error(), http.StatusInternalServerError)
-		return
-	}
-	defer cursor.Close(context.Background())
-
-	var subscriptions []models.SubscriptionZkEVM
-	if err = cursor.All(context.Background(), &subscriptions); err != nil {
-		sendError(w, "Failed to decode subscriptions: "+err.Error(), http.StatusInternalServerError)
-		return
-	}
-
-	var listedSubscriptions []types.ChainListedSubscriptionResponse
-	for _, sub := range subscriptions {
-		var model models.Model
-		err := modelsCollection.FindOne(context.Background(), bson.M{"_id": sub.ModelID}).Decode(&model)
-		if err != nil {
-			continue
-		}
-
-		listedSub := types.ChainListedSubscriptionResponse{
-			ID:       sub.ID,
-			UserID:   sub.UserID,
-			ModelID:  sub.ModelID,
-			TokenID:  sub.TokenID,
-			IsListed: sub.IsListed,
-		}
-		listedSub.Model = types.ModelInfo{
-			ID:      model.ID,
-			ModelID: model.ModelID,
-			Name:    model.Name,
-			IpfsUrl: model.IpfsUrl,
-		}
-
-		listedSubscriptions = append(listedSubscriptions, listedSub)
-	}
-
-	response := types.UserResponse{
-		Success: true,
-		Message: "Listed subscriptions retrieved successfully",
-		Data:    listedSubscriptions,
-	}
-
-	sendJSON(w, response, http.StatusOK)
-}
-
-func PurchaseSubscriptionMoonbeamHandler(w http.ResponseWriter, r *http.Request) {
-	var req types.ChainSubscriptionRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		sendError(w, "Invalid request body", http.StatusBadRequest)
-		return
-	}
-
-	if req.Email == "" || req.ModelId == "" || req.TokenId == "" {
-		sendError(w, "Email, modelId, and tokenId are required", http.StatusBadRequest)
-		return
-	}
-
-	usersCollection := db.GetCollection("users")
-	modelsCollection := db.GetCollection("models")
-	subscriptionsCollection := db.GetCollection("subscriptions_moonbeam")
-
-	var user models.User
-	err := usersCollection.FindOne(context.Background(), bson.M{"email": req.Email}).Decode(&user)
-	if err != nil {
-		if err == mongo.ErrNoDocuments {
-			sendError(w, "User not found with provided email", http.StatusNotFound)
-			return
-		}
-		sendError(w, "Failed to retrieve user: "+err.Error(), http.StatusInternalServerError)
-		return
-	}
-
-	var model models.Model
-	err = modelsCollection.FindOne(context.Background(), bson.M{"model_id": req.ModelId}).Decode(&model)
-	if err != nil {
-		if err == mongo.ErrNoDocuments {
-			sendError(w, "Model not found with provided modelId", http.StatusNotFound)
-			return
-		}
-		sendError(w, "Failed to retrieve model: "+err.Error(), http.StatusInternalServerError)
-		return
-	}
-
-	newSubscription := models.SubscriptionMoonbeam{
-		ID:      primitive.NewObjectID(),
-		UserID:  user.ID,
-		ModelID: model.ID,
-		TokenID: req.TokenId,
-	}
-
-	_, err = subscriptionsCollection.InsertOne(context.Background(), newSubscription)
-	if err != nil {
-		sendError(w, "Failed to create subscription: "+err.Error(), http.StatusInternalServerError)
-		return
-	}
-
-	result := types.ChainSubscriptionResponse{
-		UserId:  user.ID,
-		ModelId: model.ID,
-		TokenId: req.TokenId,
-	}
-
-	response := types.UserResponse{
-		Success: true,
-		Message: "Subscription purchased successfully",
-		Data:    result,
-	}
-
-	sendJSON(w, response, http.StatusOK)
-}
-
-func ListSubscriptionMoonbeamHandler(w http.ResponseWriter, r *http.Request) {
-	var req types.ChainListSubscriptionRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		sendError(w, "Invalid request body", http.StatusBadRequest)
-		return
-	}
-
-	if req.TokenId == "" || req.Price == "" {
-		sendError(w, "TokenId and price are required", http.StatusBadRequest)
-		return
-	}
-
-	collection := db.GetCollection("subscriptions_moonbeam")
-
-	update := bson.M{
-		"$set": bson.M{
-			"price":     req.Price,
-			"is_listed": true,
-		},
-	}
-
-	var updatedSubscription models.SubscriptionMoonbeam
-	err := collection.FindOneAndUpdate(
-		context.Background(),
-		bson.M{"token_id": req.TokenId},
-		update,
-		options.FindOneAndUpdate().SetReturnDocument(options.After),
-	).Decode(&updatedSubscription)
-
-	if err != nil {
-		if err == mongo.ErrNoDocuments {
-			sendError(w, "Subscription not found", http.StatusNotFound)
-			return
-		}
-		sendError(w, "Failed to update subscription: "+err.Error(), http.StatusInternalServerError)
-		return
-	}
-
-	response := types.UserResponse{
-		Success: true,
-		Message: "Subscription listed successfully",
-		Data:    updatedSubscription,
-	}
-
-	sendJSON(w, response, http.StatusOK)
-}
-
-func UpdateSubscriptionMoonbeamHandler(w http.ResponseWriter, r *http.Request) {
-	var req types.ChainUpdateSubscriptionRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		sendError(w, "Invalid request body", http.StatusBadRequest)
-		return
-	}
-
-	if req.TokenId == "" || req.Email == "" {
-		sendError(w, "TokenId and email are required", http.StatusBadRequest)
-		return
-	}
-
-	usersCollection := db.GetCollection("users")
-	subscriptionsCollection := db.GetCollection("subscriptions_moonbeam")
-
-	var user models.User
-	err := usersCollection.FindOne(context.Background(), bson.M{"email": req.Email}).Decode(&user)
-	if err != nil {
-		if err == mongo.ErrNoDocuments {
-			sendError(w, "User not found", http.StatusNotFound)
-			return
-		}
-		sendError(w, "Failed to retrieve user: "+err.Error(), http.StatusInternalServerError)
-		return
-	}
-
-	update := bson.M{
-		"$set": bson.M{
-			"user_id":   user.ID,
-			"is_listed": false,
-		},
-	}
-
-	var updatedSubscription models.SubscriptionMoonbeam
-	err = subscriptionsCollection.FindOneAndUpdate(
-		context.Background(),
-		bson.M{"token_id": req.TokenId},
-		update,
-		options.FindOneAndUpdate().SetReturnDocument(options.After),
-	).Decode(&updatedSubscription)
-
-	if err != nil {
-		if err == mongo.ErrNoDocuments {
-			sendError(w, "Subscription not found", http.StatusNotFound)
-			return
-		}
-		sendError(w, "Failed to update subscription: "+err.Error(), http.StatusInternalServerError)
-		return
-	}
-
-	response := types.UserResponse{
-		Success: true,
-		Message: "Subscription updated successfully",
-		Data:    updatedSubscription,
-	}
-
-	sendJSON(w, response, http.StatusOK)
-}
-
-func GetListedSubscriptionsMoonbeamHandler(w http.ResponseWriter, r *http.Request) {
-	subscriptionsCollection := db.GetCollection("subscriptions_moonbeam")
-	modelsCollection := db.GetCollection("models")
-
-	cursor, err := subscriptionsCollection.Find(context.Background(), bson.M{"is_listed": true})
-	if err != nil {
-		sendError(w, "Failed to retrieve subscriptions: "+err.Error(), http.StatusInternalServerError)
-		return
-	}
-	defer cursor.Close(context.Background())
-
-	var subscriptions []models.SubscriptionMoonbeam
-	if err = cursor.All(context.Background(), &subscriptions); err != nil {
-		sendError(w, "Failed to decode subscriptions: "+err.Error(), http.StatusInternalServerError)
-		return
-	}
-
-	var listedSubscriptions []types.ChainListedSubscriptionResponse
-	for _, sub := range subscriptions {
-		var model models.Model
-		err := modelsCollection.FindOne(context.Background(), bson.M{"_id": sub.ModelID}).Decode(&model)
-		if err != nil {
-			continue
-		}
-
-		listedSub := types.ChainListedSubscriptionResponse{
-			ID:       sub.ID,
-			UserID:   sub.UserID,
-			ModelID:  sub.ModelID,
-			TokenID:  sub.TokenID,
-			Price:    sub.Price,
-			IsListed: sub.IsListed,
-		}
-		listedSub.Model = types.ModelInfo{
-			ID:      model.ID,
-			ModelID: model.ModelID,
-			Name:    model.Name,
-			IpfsUrl: model.IpfsUrl,
-		}
-
-		listedSubscriptions = append(listedSubscriptions, listedSub)
-	}
-
-	response := types.UserResponse{
-		Success: true,
-		Message: "Listed subscriptions retrieved successfully",
-		Data:    listedSubscriptions,
-	}
-
-	sendJSON(w, response, http.StatusOK)
-}
-
-func PurchaseSubscriptionMetisHandler(w http.ResponseWriter, r *http.Request) {
-	var req types.ChainSubscriptionRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		sendError(w, "Invalid request body", http.StatusBadRequest)
-		return
-	}
-
-	if req.Email == "" || req.ModelId == "" || req.TokenId == "" {
-		sendError(w, "Email, modelId, and tokenId are required", http.StatusBadRequest)
-		return
-	}
-
-	usersCollection := db.GetCollection("users")
-	modelsCollection := db.GetCollection("models")
-	subscriptionsCollection := db.GetCollection("subscriptions_metis")
-
-	var user models.User
-	err := usersCollection.FindOne(context.Background(), bson.M{"email": req.Email}).Decode(&user)
-	if err != nil {
-		if err == mongo.ErrNoDocuments {
-			sendError(w, "User not found with provided email", http.StatusNotFound)
-			return
-		}
-		sendError(w, "Failed to retrieve user: "+err.Error(), http.StatusInternalServerError)
-		return
-	}
-
-	var model models.Model
-	err = modelsCollection.FindOne(context.Background(), bson.M{"model_id": req.ModelId}).Decode(&model)
-	if err != nil {
-		if err == mongo.ErrNoDocuments {
-			sendError(w, "Model not found with provided modelId", http.StatusNotFound)
-			return
-		}
-		sendError(w, "Failed to retrieve model: "+err.Error(), http.StatusInternalServerError)
-		return
-	}
-
-	newSubscription := models.SubscriptionMetis{
-		ID:      primitive.NewObjectID(),
-		UserID:  user.ID,
-		ModelID: model.ID,
-		TokenID: req.TokenId,
-	}
-
-	_, err = subscriptionsCollection.InsertOne(context.Background(), newSubscription)
-	if err != nil {
-		sendError(w, "Failed to create subscription: "+err.Error(), http.StatusInternalServerError)
-		return
-	}
-
-	result := types.ChainSubscriptionResponse{
-		UserId:  user.ID,
-		ModelId: model.ID,
-		TokenId: req.TokenId,
-	}
-
-	response := types.UserResponse{
-		Success: true,
-		Message: "Subscription purchased successfully",
-		Data:    result,
-	}
-
-	sendJSON(w, response, http.StatusOK)
-}
-
-func ListSubscriptionMetisHandler(w http.ResponseWriter, r *http.Request) {
-	var req types.ChainListSubscriptionRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		sendError(w, "Invalid request body", http.StatusBadRequest)
-		return
-	}
-
-	if req.TokenId == "" || req.Price == "" {
-		sendError(w, "TokenId and price are required", http.StatusBadRequest)
-		return
-	}
-
-	collection := db.GetCollection("subscriptions_metis")
-
-	update := bson.M{
-		"$set": bson.M{
-			"price":     req.Price,
-			"is_listed": true,
-		},
-	}
-
-	var updatedSubscription models.SubscriptionMetis
-	err := collection.FindOneAndUpdate(
-		context.Background(),
-		bson.M{"token_id": req.TokenId},
-		update,
-		options.FindOneAndUpdate().SetReturnDocument(options.After),
-	).Decode(&updatedSubscription)
-
-	if err != nil {
-		if err == mongo.ErrNoDocuments {
-			sendError(w, "Subscription not found", http.StatusNotFound)
-			return
-		}
-		sendError(w, "Failed to update subscription: "+err.Error(), http.StatusInternalServerError)
-		return
-	}
-
-	response := types.UserResponse{
-		Success: true,
-		Message: "Subscription listed successfully",
-		Data:    updatedSubscription,
-	}
-
-	sendJSON(w, response, http.StatusOK)
-}
-
-func UpdateSubscriptionMetisHandler(w http.ResponseWriter, r *http.Request) {
-	var req types.ChainUpdateSubscriptionRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		sendError(w, "Invalid request body", http.StatusBadRequest)
-		return
-	}
-
-	if req.TokenId == "" || req.Email == "" {
-		sendError(w, "TokenId and email are required", http.StatusBadRequest)
-		return
-	}
-
-	usersCollection := db.GetCollection("users")
-	subscriptionsCollection := db.GetCollection("subscriptions_metis")
-
-	var user models.User
-	err := usersCollection.FindOne(context.Background(), bson.M{"email": req.Email}).Decode(&user)
-	if err != nil {
-		if err == mongo.ErrNoDocuments {
-			sendError(w, "User not found", http.StatusNotFound)
-			return
-		}
-		sendError(w, "Failed to retrieve user: "+err.Error(), http.StatusInternalServerError)
-		return
-	}
-
-	update := bson.M{
-		"$set": bson.M{
-			"user_id":   user.ID,
-			"is_listed": false,
-		},
-	}
-
-	var updatedSubscription models.SubscriptionMetis
-	err = subscriptionsCollection.FindOneAndUpdate(
-		context.Background(),
-		bson.M{"token_id": req.TokenId},
-		update,
-		options.FindOneAndUpdate().SetReturnDocument(options.After),
-	).Decode(&updatedSubscription)
-
-	if err != nil {
-		if err == mongo.ErrNoDocuments {
-			sendError(w, "Subscription not found", http.StatusNotFound)
-			return
-		}
-		sendError(w, "Failed to update subscription: "+err.Error(), http.StatusInternalServerError)
-		return
-	}
-
-	response := types.UserResponse{
-		Success: true,
-		Message: "Subscription updated successfully",
-		Data:    updatedSubscription,
-	}
-
-	sendJSON(w, response, http.StatusOK)
-}
-
-func GetListedSubscriptionsMetisHandler(w http.ResponseWriter, r *http.Request) {
-	subscriptionsCollection := db.GetCollection("subscriptions_metis")
-	modelsCollection := db.GetCollection("models")
-
-	cursor, err := subscriptionsCollection.Find(context.Background(), bson.M{"is_listed": true})
-	if err != nil {
-		sendError(w, "Failed to retrieve subscriptions: "+err.Error(), http.StatusInternalServerError)
-		return
-	}
-	defer cursor.Close(context.Background())
-
-	var subscriptions []models.SubscriptionMetis
-	if err = cursor.All(context.Background(), &subscriptions); err != nil {
-		sendError(w, "Failed to decode subscriptions: "+err.Error(), http.StatusInternalServerError)
-		return
-	}
-
-	var listedSubscriptions []types.ChainListedSubscriptionResponse
-	for _, sub := range subscriptions {
-		var model models.Model
-		err := modelsCollection.FindOne(context.Background(), bson.M{"_id": sub.ModelID}).Decode(&model)
-		if err != nil {
-			continue
-		}
-
-		listedSub := types.ChainListedSubscriptionResponse{
-			ID:       sub.ID,
-			UserID:   sub.UserID,
-			ModelID:  sub.ModelID,
-			TokenID:  sub.TokenID,
-			Price:    sub.Price,
-			IsListed: sub.IsListed,
-		}
-		listedSub.Model = types.ModelInfo{
-			ID:      model.ID,
-			ModelID: model.ModelID,
-			Name:    model.Name,
-			IpfsUrl: model.IpfsUrl,
-		}
-
-		listedSubscriptions = append(listedSubscriptions, listedSub)
-	}
-
-	response := types.UserResponse{
-		Success: true,
-		Message: "Listed subscriptions retrieved successfully",
-		Data:    listedSubscriptions,
-	}
-
-	sendJSON(w, response, http.StatusOK)
-}
-
-func GetAllModelsHandler(w http.ResponseWriter, r *http.Request) {
-	collection := db.GetCollection("models")
-
-	cursor, err := collection.Find(context.Background(), bson.M{})
-	if err != nil {
-		sendError(w, "Failed to retrieve models: "+err.Error(), http.StatusInternalServerError)
-		return
-	}
-	defer cursor.Close(context.Background())
-
-	var models []models.Model
-	if err = cursor.All(context.Background(), &models); err != nil {
-		sendError(w, "Failed to decode models: "+err.Error(), http.StatusInternalServerError)
-		return
-	}
-
-	response := types.UserResponse{
-		Success: true,
-		Message: "Models retrieved successfully",
-		Data:    models,
-	}
-
-	sendJSON(w, response, http.StatusOK)
-}
-
-func GetModelBySlugHandler(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
-	slug := vars["slug"]
-
-	if slug == "" {
-		sendError(w, "Slug is required", http.StatusBadRequest)
-		return
-	}
-
-	collection := db.GetCollection("models")
-
-	var model models.Model
-	err := collection.FindOne(context.Background(), bson.M{"slug": slug}).Decode(&model)
-	if err != nil {
-		if err == mongo.ErrNoDocuments {
-			sendError(w, "Model not found", http.StatusNotFound)
-			return
-		}
-		sendError(w, "Failed to retrieve model: "+err.Error(), http.StatusInternalServerError)
-		return
-	}
-
-	response := types.UserResponse{
-		Success: true,
-		Message: "Model retrieved successfully",
-		Data:    model,
-	}
-
-	sendJSON(w, response, http.StatusOK)
-}
-
-type SubscriptionOption struct {
-	ID          primitive.ObjectID `bson:"_id" json:"id"`
-	ModelID     string             `bson:"model_id" json:"modelId"`
-	Price       string             `bson:"price" json:"price"`
-	Duration    int                `bson:"duration" json:"duration"`
-	Description string             `bson:"description" json:"description"`
-	CreatedAt   time.Time          `bson:"created_at" json:"createdAt"`
-}
-
-func CreateSubscriptionOptionHandler(w http.ResponseWriter, r *http.Request) {
-	var req struct {
-		ModelID     string `json:"modelId"`
-		Price       string `json:"price"`
-		Duration    int    `json:"duration"`
-		Description string `json:"description"`
-	}
-
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		sendError(w, "Invalid request body", http.StatusBadRequest)
-		return
-	}
-
-	if req.ModelID == "" || req.Price == "" || req.Duration == 0 {
-		sendError(w, "ModelId, price, and duration are required", http.StatusBadRequest)
-		return
-	}
-
-	modelsCollection := db.GetCollection("models")
-	var model models.Model
-	err := modelsCollection.FindOne(context.Background(), bson.M{"model_id": req.ModelID}).Decode(&model)
-	if err != nil {
-		if err == mongo.ErrNoDocuments {
-			sendError(w, "Model not found", http.StatusNotFound)
-			return
-		}
-		sendError(w, "Failed to verify model: "+err.Error(), http.StatusInternalServerError)
-		return
-	}
-
-	subscriptionOption := SubscriptionOption{
-		ID:          primitive.NewObjectID(),
-		ModelID:     req.ModelID,
-		Price:       req.Price,
-		Duration:    req.Duration,
-		Description: req.Description,
-		CreatedAt:   time.Now(),
-	}
-
-	collection := db.GetCollection("subscription_options")
-	_, err = collection.InsertOne(context.Background(), subscriptionOption)
-	if err != nil {
-		sendError(w, "Failed to create subscription option: "+err.Error(), http.StatusInternalServerError)
-		return
-	}
-
-	response := types.UserResponse{
-		Success: true,
-		Message: "Subscription option created successfully",
-		Data:    subscriptionOption,
-	}
-
-	sendJSON(w, response, http.StatusCreated)
-}
-
-func GetSubscriptionOptionsHandler(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
-	modelId := vars["modelId"]
-
-	if modelId == "" {
-		sendError(w, "ModelId is required", http.StatusBadRequest)
-		return
-	}
-
-	collection := db.GetCollection("subscription_options")
-
-	cursor, err := collection.Find(context.Background(), bson.M{"model_id": modelId})
-	if err != nil {
-		sendError(w, "Failed to retrieve subscription options: "+err.Error(), http.StatusInternalServerError)
-		return
-	}
-	defer cursor.Close(context.Background())
-
-	var options []SubscriptionOption
-	if err = cursor.All(context.Background(), &options); err != nil {
-		sendError(w, "Failed to decode subscription options: "+err.Error(), http.StatusInternalServerError)
-		return
-	}
-
-	response := types.UserResponse{
-		Success: true,
-		Message: "Subscription options retrieved successfully",
-		Data:    options,
-	}
-
-	sendJSON(w, response, http.StatusOK)
-}
-
-func sendJSON(w http.ResponseWriter, data interface{}, status int) {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(status)
-	json.NewEncoder(w).Encode(data)
+func sendError(w http.ResponseWriter, message string, status int) {
+	sendErrorCode(w, "", message, status)
 }
 
-func sendError(w http.ResponseWriter, message string, status int) {
+func sendErrorCode(w http.ResponseWriter, code string, message string, status int) {
 	response := types.UserResponse{
 		Success: false,
 		Error:   message,
+		Code:    code,
 	}
 	sendJSON(w, response, status)
 }
@@ -0,0 +1,202 @@
+package scheduler
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule decides when a job runs next.
+type Schedule interface {
+	// Next returns the first run time strictly after after, or the zero
+	// time if there is none.
+	Next(after time.Time) time.Time
+	String() string
+}
+
+type interval time.Duration
+
+// Every runs a job at a fixed interval.
+func Every(d time.Duration) Schedule {
+	return interval(d)
+}
+
+func (i interval) Next(after time.Time) time.Time {
+	return after.Add(time.Duration(i))
+}
+
+func (i interval) String() string {
+	return "@every " + time.Duration(i).String()
+}
+
+// Parse reads a schedule spec: "@every <duration>", one of the shorthands
+// @hourly, @daily, @weekly and @monthly, or a five-field cron expression
+// (minute hour day-of-month month day-of-week) supporting *, lists, ranges
+// and steps, with ? accepted for * in the day fields.
+func Parse(spec string) (Schedule, error) {
+	spec = strings.TrimSpace(spec)
+
+	if rest, ok := strings.CutPrefix(spec, "@every "); ok {
+		d, err := time.ParseDuration(strings.TrimSpace(rest))
+		if err != nil {
+			return nil, fmt.Errorf("invalid interval %q: %v", rest, err)
+		}
+		if d <= 0 {
+			return nil, fmt.Errorf("interval must be positive, got %s", d)
+		}
+		return Every(d), nil
+	}
+
+	switch spec {
+	case "@hourly":
+		spec = "0 * * * *"
+	case "@daily", "@midnight":
+		spec = "0 0 * * *"
+	case "@weekly":
+		spec = "0 0 * * 0"
+	case "@monthly":
+		spec = "0 0 1 * *"
+	}
+
+	return parseCron(spec)
+}
+
+type cron struct {
+	spec   string
+	minute [60]bool
+	hour   [24]bool
+	dom    [32]bool
+	month  [13]bool
+	dow    [7]bool
+	// Per cron convention, when both day fields are restricted a day
+	// matches if either does. A field covering its whole range, however it
+	// is written, is unrestricted.
+	domAny, dowAny bool
+}
+
+func parseCron(spec string) (*cron, error) {
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("cron spec %q must have 5 fields", spec)
+	}
+
+	for _, i := range []int{2, 4} {
+		if fields[i] == "?" {
+			fields[i] = "*"
+		}
+	}
+
+	c := &cron{spec: spec}
+	parsers := []struct {
+		field    string
+		min, max int
+		set      func(int)
+	}{
+		{fields[0], 0, 59, func(v int) { c.minute[v] = true }},
+		{fields[1], 0, 23, func(v int) { c.hour[v] = true }},
+		{fields[2], 1, 31, func(v int) { c.dom[v] = true }},
+		{fields[3], 1, 12, func(v int) { c.month[v] = true }},
+		// 7 is accepted as Sunday as well as 0.
+		{fields[4], 0, 7, func(v int) { c.dow[v%7] = true }},
+	}
+	for _, p := range parsers {
+		if err := parseField(p.field, p.min, p.max, p.set); err != nil {
+			return nil, fmt.Errorf("cron spec %q: %v", spec, err)
+		}
+	}
+	c.domAny = all(c.dom[1:])
+	c.dowAny = all(c.dow[:])
+	return c, nil
+}
+
+func all(set []bool) bool {
+	for _, ok := range set {
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func parseField(field string, min, max int, set func(int)) error {
+	for _, part := range strings.Split(field, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(part, "/")
+
+		step := 1
+		if hasStep {
+			var err error
+			step, err = strconv.Atoi(stepPart)
+			if err != nil || step <= 0 {
+				return fmt.Errorf("invalid step in %q", part)
+			}
+		}
+
+		lo, hi := min, max
+		if rangePart != "*" {
+			from, to, isRange := strings.Cut(rangePart, "-")
+			var err error
+			if lo, err = strconv.Atoi(from); err != nil {
+				return fmt.Errorf("invalid value in %q", part)
+			}
+			hi = lo
+			if isRange {
+				if hi, err = strconv.Atoi(to); err != nil {
+					return fmt.Errorf("invalid value in %q", part)
+				}
+			} else if hasStep {
+				hi = max
+			}
+		}
+		if lo < min || hi > max || lo > hi {
+			return fmt.Errorf("%q is outside %d-%d", part, min, max)
+		}
+
+		for v := lo; v <= hi; v += step {
+			set(v)
+		}
+	}
+	return nil
+}
+
+func (c *cron) Next(after time.Time) time.Time {
+	loc := after.Location()
+	t := after.Truncate(time.Minute).Add(time.Minute)
+
+	// Every valid spec matches within a few years; the limit only guards
+	// against ones that never do, such as February 30th.
+	limit := t.AddDate(5, 0, 0)
+	for t.Before(limit) {
+		switch {
+		case !c.month[t.Month()]:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+		case !c.dayMatches(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+		case !c.hour[t.Hour()]:
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+		case !c.minute[t.Minute()]:
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+func (c *cron) dayMatches(t time.Time) bool {
+	dom, dow := c.dom[t.Day()], c.dow[t.Weekday()]
+	switch {
+	case c.domAny && c.dowAny:
+		return true
+	case c.domAny:
+		return dow
+	case c.dowAny:
+		return dom
+	default:
+		return dom || dow
+	}
+}
+
+func (c *cron) String() string {
+	return c.spec
+}
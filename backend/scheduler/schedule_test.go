@@ -0,0 +1,82 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseNext(t *testing.T) {
+	// A Wednesday.
+	wednesday := time.Date(2026, 10, 14, 10, 7, 30, 0, time.UTC)
+
+	tests := []struct {
+		spec  string
+		after time.Time
+		want  time.Time
+	}{
+		{"0 3 * * 1", wednesday, time.Date(2026, 10, 19, 3, 0, 0, 0, time.UTC)},
+		{"*/15 * * * *", wednesday, time.Date(2026, 10, 14, 10, 15, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2026, 10, 14, 10, 45, 0, 0, time.UTC), time.Date(2026, 10, 14, 11, 0, 0, 0, time.UTC)},
+		{"30 9-17/4 * * *", wednesday, time.Date(2026, 10, 14, 13, 30, 0, 0, time.UTC)},
+		{"0 0 1,15 * *", wednesday, time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC)},
+		{"@hourly", wednesday, time.Date(2026, 10, 14, 11, 0, 0, 0, time.UTC)},
+		{"@daily", wednesday, time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC)},
+		{"@weekly", wednesday, time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)},
+		{"@monthly", wednesday, time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)},
+		{"@every 5m", wednesday, wednesday.Add(5 * time.Minute)},
+		// Both day fields restricted: either matches.
+		{"0 0 13 * 5", wednesday, time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC)},
+		{"0 0 1 * 7", wednesday, time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)},
+		// A day field covering its whole range is unrestricted however it
+		// is written, so only the other one applies.
+		{"0 0 */1 * 1", wednesday, time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)},
+		{"0 0 1-31 * 1", wednesday, time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)},
+		{"0 0 ? * 1", wednesday, time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)},
+		{"0 0 1 * 0-6", wednesday, time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 1 * */1", wednesday, time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 1 * ?", wednesday, time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", wednesday, time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)},
+		// Never matches.
+		{"0 0 30 2 *", wednesday, time.Time{}},
+	}
+
+	for _, tt := range tests {
+		schedule, err := Parse(tt.spec)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", tt.spec, err)
+			continue
+		}
+		if got := schedule.Next(tt.after); !got.Equal(tt.want) {
+			t.Errorf("Parse(%q).Next(%s) = %s, want %s", tt.spec, tt.after, got, tt.want)
+		}
+	}
+}
+
+func TestParseRejects(t *testing.T) {
+	specs := []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"a * * * *",
+		"1-a * * * *",
+		"? * * * *",
+		"@every",
+		"@every 0s",
+		"@every -1m",
+		"@every soon",
+		"@yearly",
+	}
+
+	for _, spec := range specs {
+		if _, err := Parse(spec); err == nil {
+			t.Errorf("Parse(%q) succeeded, want an error", spec)
+		}
+	}
+}
@@ -0,0 +1,205 @@
+// Package scheduler runs recurring background jobs inside the server.
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Job is a recurring task. Run is never called concurrently with itself:
+// a run that is due while the previous one is still going is skipped.
+type Job struct {
+	Name     string
+	Schedule Schedule
+	// Jitter delays the first run by a random amount up to this long, so
+	// jobs do not all fire at startup.
+	Jitter time.Duration
+	// Timeout bounds a single run; zero means no limit.
+	Timeout time.Duration
+	Run     func(ctx context.Context) error
+}
+
+// JobStatus is a snapshot of a job for the admin endpoint.
+type JobStatus struct {
+	Name         string     `json:"name"`
+	Schedule     string     `json:"schedule"`
+	Running      bool       `json:"running"`
+	NextRun      *time.Time `json:"nextRun,omitempty"`
+	LastStarted  *time.Time `json:"lastStarted,omitempty"`
+	LastFinished *time.Time `json:"lastFinished,omitempty"`
+	LastDuration string     `json:"lastDuration,omitempty"`
+	LastError    string     `json:"lastError,omitempty"`
+	Runs         int        `json:"runs"`
+	Failures     int        `json:"failures"`
+	Skipped      int        `json:"skipped"`
+}
+
+type entry struct {
+	job    Job
+	mu     sync.Mutex
+	status JobStatus
+}
+
+type Scheduler struct {
+	mu      sync.Mutex
+	entries map[string]*entry
+	ctx     context.Context
+}
+
+func New() *Scheduler {
+	return &Scheduler{entries: map[string]*entry{}}
+}
+
+// Add registers a job. Jobs added after Start begin immediately.
+func (s *Scheduler) Add(job Job) error {
+	if job.Name == "" || job.Schedule == nil || job.Run == nil {
+		return fmt.Errorf("job needs a name, schedule and run function")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.entries[job.Name]; exists {
+		return fmt.Errorf("job %q is already registered", job.Name)
+	}
+
+	e := &entry{job: job, status: JobStatus{Name: job.Name, Schedule: job.Schedule.String()}}
+	s.entries[job.Name] = e
+	if s.ctx != nil {
+		go s.loop(s.ctx, e)
+	}
+	return nil
+}
+
+// Start runs every registered job on its schedule until ctx is done.
+func (s *Scheduler) Start(ctx context.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.ctx = ctx
+	for _, e := range s.entries {
+		go s.loop(ctx, e)
+	}
+}
+
+func (s *Scheduler) loop(ctx context.Context, e *entry) {
+	next := time.Now()
+	if e.job.Jitter > 0 {
+		next = next.Add(time.Duration(rand.Int63n(int64(e.job.Jitter))))
+	}
+
+	for {
+		e.mu.Lock()
+		e.status.NextRun = &next
+		e.mu.Unlock()
+
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		go s.run(ctx, e)
+
+		next = e.job.Schedule.Next(time.Now())
+		if next.IsZero() {
+			log.Printf("Scheduler: job %s has no further runs", e.job.Name)
+			e.mu.Lock()
+			e.status.NextRun = nil
+			e.mu.Unlock()
+			return
+		}
+	}
+}
+
+// run executes the job once unless it is already running.
+func (s *Scheduler) run(ctx context.Context, e *entry) bool {
+	e.mu.Lock()
+	if e.status.Running {
+		e.status.Skipped++
+		e.mu.Unlock()
+		log.Printf("Scheduler: skipping %s, previous run still in progress", e.job.Name)
+		return false
+	}
+	started := time.Now()
+	e.status.Running = true
+	e.status.LastStarted = &started
+	e.mu.Unlock()
+
+	if e.job.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, e.job.Timeout)
+		defer cancel()
+	}
+
+	err := e.job.Run(ctx)
+
+	finished := time.Now()
+	e.mu.Lock()
+	e.status.Running = false
+	e.status.LastFinished = &finished
+	e.status.LastDuration = finished.Sub(started).Round(time.Millisecond).String()
+	e.status.Runs++
+	e.status.LastError = ""
+	if err != nil {
+		e.status.Failures++
+		e.status.LastError = err.Error()
+	}
+	e.mu.Unlock()
+
+	if err != nil {
+		log.Printf("Scheduler: job %s failed: %v", e.job.Name, err)
+	}
+	return true
+}
+
+// Trigger starts a run of the named job now, outside its schedule. found is
+// false for an unknown job and started is false if it is already running.
+func (s *Scheduler) Trigger(name string) (found bool, started bool) {
+	s.mu.Lock()
+	e, ok := s.entries[name]
+	ctx := s.ctx
+	s.mu.Unlock()
+	if !ok {
+		return false, false
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	e.mu.Lock()
+	running := e.status.Running
+	e.mu.Unlock()
+	if running {
+		return true, false
+	}
+
+	go s.run(ctx, e)
+	return true, true
+}
+
+// Status returns a snapshot of every job, sorted by name.
+func (s *Scheduler) Status() []JobStatus {
+	s.mu.Lock()
+	entries := make([]*entry, 0, len(s.entries))
+	for _, e := range s.entries {
+		entries = append(entries, e)
+	}
+	s.mu.Unlock()
+
+	statuses := make([]JobStatus, 0, len(entries))
+	for _, e := range entries {
+		e.mu.Lock()
+		statuses = append(statuses, e.status)
+		e.mu.Unlock()
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Name < statuses[j].Name })
+	return statuses
+}
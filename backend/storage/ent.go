@@ -0,0 +1,605 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"arjunmal1311/fans_flow_on_chain/backend/ent"
+	entmodel "arjunmal1311/fans_flow_on_chain/backend/ent/model"
+	"arjunmal1311/fans_flow_on_chain/backend/ent/predicate"
+	"arjunmal1311/fans_flow_on_chain/backend/ent/subscription"
+	"arjunmal1311/fans_flow_on_chain/backend/ent/subscriptionoption"
+	"arjunmal1311/fans_flow_on_chain/backend/ent/user"
+	"arjunmal1311/fans_flow_on_chain/backend/models"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// NewEnt returns repositories backed by client. Rows are keyed by the hex
+// form of the ObjectIDs the other backends use, so IDs handed out by one
+// backend stay valid after the data is copied to another.
+//
+// Unlike MongoDB, the SQL schema enforces its relations: subscriptions
+// need an existing user and model, and options an existing model.
+func NewEnt(client *ent.Client) Repos {
+	return Repos{
+		Users:         &entUsers{client},
+		Models:        &entModels{client},
+		Subscriptions: &entSubscriptions{client},
+		Options:       &entOptions{client},
+	}
+}
+
+// objectID parses an ID written by this backend, which always came from an
+// ObjectID.
+func objectID(id string) primitive.ObjectID {
+	oid, _ := primitive.ObjectIDFromHex(id)
+	return oid
+}
+
+// entError maps ent's errors to the package's.
+func entError(err error) error {
+	switch {
+	case ent.IsNotFound(err):
+		return ErrNotFound
+	case sqlgraph.IsUniqueConstraintError(err):
+		return fmt.Errorf("%w: %v", ErrDuplicate, err)
+	}
+	return err
+}
+
+type entUsers struct {
+	client *ent.Client
+}
+
+func (r *entUsers) Create(ctx context.Context, u *models.User) error {
+	u.WalletAddress = strings.ToLower(u.WalletAddress)
+	existing, err := r.client.User.Query().
+		Where(user.Or(
+			user.Username(u.Username),
+			user.Email(u.Email),
+			user.WalletAddress(u.WalletAddress),
+		)).
+		First(ctx)
+	if err == nil {
+		switch {
+		case existing.Username == u.Username:
+			return fmt.Errorf("%w username", ErrDuplicate)
+		case existing.Email == u.Email:
+			return fmt.Errorf("%w email", ErrDuplicate)
+		default:
+			return fmt.Errorf("%w wallet_address", ErrDuplicate)
+		}
+	}
+	if !ent.IsNotFound(err) {
+		return err
+	}
+
+	if u.ID.IsZero() {
+		u.ID = primitive.NewObjectID()
+	}
+	_, err = r.client.User.Create().
+		SetID(u.ID.Hex()).
+		SetUsername(u.Username).
+		SetEmail(u.Email).
+		SetWalletAddress(u.WalletAddress).
+		SetIpfsURL(u.IpfsUrl).
+		SetOpenaiTokenID(u.OpenAiTokenId).
+		Save(ctx)
+	return entError(err)
+}
+
+func (r *entUsers) Get(ctx context.Context, id primitive.ObjectID) (*models.User, error) {
+	return r.findOne(ctx, user.ID(id.Hex()))
+}
+
+func (r *entUsers) FindByWallet(ctx context.Context, wallet string) (*models.User, error) {
+	return r.findOne(ctx, user.WalletAddress(strings.ToLower(wallet)))
+}
+
+func (r *entUsers) FindByEmail(ctx context.Context, email string) (*models.User, error) {
+	return r.findOne(ctx, user.Email(email))
+}
+
+func (r *entUsers) FindByOpenAiTokenID(ctx context.Context, tokenID string) (*models.User, error) {
+	return r.findOne(ctx, user.OpenaiTokenID(tokenID))
+}
+
+func (r *entUsers) List(ctx context.Context) ([]models.User, error) {
+	rows, err := r.client.User.Query().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]models.User, 0, len(rows))
+	for _, row := range rows {
+		list = append(list, *toUser(row))
+	}
+	return list, nil
+}
+
+func (r *entUsers) findOne(ctx context.Context, where predicate.User) (*models.User, error) {
+	row, err := r.client.User.Query().Where(where).First(ctx)
+	if err != nil {
+		return nil, entError(err)
+	}
+	return toUser(row), nil
+}
+
+func toUser(row *ent.User) *models.User {
+	return &models.User{
+		ID:            objectID(row.ID),
+		Username:      row.Username,
+		Email:         row.Email,
+		WalletAddress: row.WalletAddress,
+		IpfsUrl:       row.IpfsURL,
+		OpenAiTokenId: row.OpenaiTokenID,
+	}
+}
+
+type entModels struct {
+	client *ent.Client
+}
+
+func (r *entModels) Create(ctx context.Context, m *models.Model) error {
+	m.WalletAddress = strings.ToLower(m.WalletAddress)
+	existing, err := r.client.Model.Query().
+		Where(entmodel.Or(
+			entmodel.ModelID(m.ModelID),
+			entmodel.Email(m.Email),
+			entmodel.WalletAddress(m.WalletAddress),
+			entmodel.SlugEQ(m.Slug),
+		)).
+		First(ctx)
+	if err == nil {
+		switch {
+		case existing.ModelID == m.ModelID:
+			return fmt.Errorf("%w model_id", ErrDuplicate)
+		case existing.Email == m.Email:
+			return fmt.Errorf("%w email", ErrDuplicate)
+		case existing.WalletAddress == m.WalletAddress:
+			return fmt.Errorf("%w wallet_address", ErrDuplicate)
+		default:
+			return fmt.Errorf("%w slug", ErrDuplicate)
+		}
+	}
+	if !ent.IsNotFound(err) {
+		return err
+	}
+
+	var slug *string
+	if m.Slug != "" {
+		slug = &m.Slug
+	}
+	if m.ID.IsZero() {
+		m.ID = primitive.NewObjectID()
+	}
+	_, err = r.client.Model.Create().
+		SetID(m.ID.Hex()).
+		SetName(m.Name).
+		SetModelID(m.ModelID).
+		SetEmail(m.Email).
+		SetWalletAddress(m.WalletAddress).
+		SetIpfsURL(m.IpfsUrl).
+		SetOpenaiTokenID(m.OpenAiTokenId).
+		SetNillableSlug(slug).
+		SetLocation(m.Location).
+		SetAboutMe(m.AboutMe).
+		SetValue(m.Value).
+		SetViews(m.Views).
+		SetTease(m.Tease).
+		SetPosts(m.Posts).
+		SetImageSrc(m.Image.Src).
+		SetIconSrc(m.Icon.Src).
+		Save(ctx)
+	return entError(err)
+}
+
+func (r *entModels) Get(ctx context.Context, id primitive.ObjectID) (*models.Model, error) {
+	return r.findOne(ctx, entmodel.ID(id.Hex()))
+}
+
+func (r *entModels) FindByModelID(ctx context.Context, modelID string) (*models.Model, error) {
+	return r.findOne(ctx, entmodel.ModelID(modelID))
+}
+
+func (r *entModels) FindBySlug(ctx context.Context, slug string) (*models.Model, error) {
+	return r.findOne(ctx, entmodel.Slug(slug))
+}
+
+func (r *entModels) FindByWallet(ctx context.Context, wallet string) (*models.Model, error) {
+	return r.findOne(ctx, entmodel.WalletAddress(strings.ToLower(wallet)))
+}
+
+func (r *entModels) List(ctx context.Context) ([]models.Model, error) {
+	rows, err := r.client.Model.Query().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]models.Model, 0, len(rows))
+	for _, row := range rows {
+		list = append(list, *toModel(row))
+	}
+	return list, nil
+}
+
+func (r *entModels) SetPosts(ctx context.Context, id primitive.ObjectID, count int64) error {
+	_, err := r.client.Model.Update().
+		Where(entmodel.ID(id.Hex())).
+		SetPosts(count).
+		Save(ctx)
+	return err
+}
+
+func (r *entModels) findOne(ctx context.Context, where predicate.Model) (*models.Model, error) {
+	row, err := r.client.Model.Query().Where(where).First(ctx)
+	if err != nil {
+		return nil, entError(err)
+	}
+	return toModel(row), nil
+}
+
+func toModel(row *ent.Model) *models.Model {
+	m := &models.Model{
+		ID:            objectID(row.ID),
+		Name:          row.Name,
+		ModelID:       row.ModelID,
+		Email:         row.Email,
+		WalletAddress: row.WalletAddress,
+		IpfsUrl:       row.IpfsURL,
+		OpenAiTokenId: row.OpenaiTokenID,
+		Location:      row.Location,
+		AboutMe:       row.AboutMe,
+		Value:         row.Value,
+		Views:         row.Views,
+		Tease:         row.Tease,
+		Posts:         row.Posts,
+	}
+	if row.Slug != nil {
+		m.Slug = *row.Slug
+	}
+	m.Image.Src = row.ImageSrc
+	m.Icon.Src = row.IconSrc
+	return m
+}
+
+type entSubscriptions struct {
+	client *ent.Client
+}
+
+// subscriptionWhere translates filter into ent predicates, matching what
+// SubscriptionQuery selects in MongoDB.
+func subscriptionWhere(filter SubscriptionFilter) []predicate.Subscription {
+	var where []predicate.Subscription
+	if filter.Chain != "" {
+		where = append(where, subscription.Chain(filter.Chain))
+	}
+	if filter.TokenID != "" {
+		where = append(where, subscription.TokenID(filter.TokenID))
+	}
+	if filter.UserID != nil {
+		where = append(where, subscription.UserID(filter.UserID.Hex()))
+	}
+	if filter.ModelID != nil {
+		where = append(where, subscription.ModelID(filter.ModelID.Hex()))
+	}
+	if filter.TxHash != "" {
+		where = append(where, subscription.TxHash(strings.ToLower(filter.TxHash)))
+	}
+	if filter.Listed != nil {
+		where = append(where, subscription.IsListed(*filter.Listed))
+	}
+	if filter.Expired != nil {
+		where = append(where, subscription.Expired(*filter.Expired))
+	}
+
+	switch filter.Status {
+	case models.SubscriptionActive:
+		where = append(where, subscription.Or(
+			subscription.ExpiresAtIsNil(),
+			subscription.ExpiresAtGT(filter.now()),
+		))
+	case models.SubscriptionExpired:
+		where = append(where, subscription.ExpiresAtLTE(filter.now()))
+	}
+	return where
+}
+
+func (r *entSubscriptions) Find(ctx context.Context, filter SubscriptionFilter) ([]models.Subscription, error) {
+	rows, err := r.client.Subscription.Query().Where(subscriptionWhere(filter)...).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]models.Subscription, 0, len(rows))
+	for _, row := range rows {
+		list = append(list, *toSubscription(row))
+	}
+	return list, nil
+}
+
+// FindWithModels loads the models with a second query; the foreign key
+// means no subscription is orphaned.
+func (r *entSubscriptions) FindWithModels(ctx context.Context, filter SubscriptionFilter) ([]SubscriptionWithModel, error) {
+	rows, err := r.client.Subscription.Query().
+		Where(subscriptionWhere(filter)...).
+		WithModel().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]SubscriptionWithModel, 0, len(rows))
+	for _, row := range rows {
+		joined := SubscriptionWithModel{Subscription: *toSubscription(row)}
+		if row.Edges.Model != nil {
+			joined.Model = toModel(row.Edges.Model)
+		}
+		list = append(list, joined)
+	}
+	return list, nil
+}
+
+func (r *entSubscriptions) FindOne(ctx context.Context, filter SubscriptionFilter) (*models.Subscription, error) {
+	row, err := r.client.Subscription.Query().Where(subscriptionWhere(filter)...).First(ctx)
+	if err != nil {
+		return nil, entError(err)
+	}
+	return toSubscription(row), nil
+}
+
+func (r *entSubscriptions) Latest(ctx context.Context, chain, tokenID string) (*models.Subscription, error) {
+	row, err := r.client.Subscription.Query().
+		Where(subscriptionWhere(SubscriptionFilter{Chain: chain, TokenID: tokenID})...).
+		// PostgreSQL sorts NULLs first when descending; MongoDB puts
+		// subscriptions without an expiry last.
+		Order(subscription.ByExpiresAt(sql.OrderDesc(), sql.OrderNullsLast())).
+		First(ctx)
+	if err != nil {
+		return nil, entError(err)
+	}
+	return toSubscription(row), nil
+}
+
+func (r *entSubscriptions) RecordPurchase(ctx context.Context, sub models.Subscription) error {
+	// Two concurrent first purchases can both miss and insert; the unique
+	// index rejects the second, which then finds the first's row and
+	// updates it, as the mongo upsert does.
+	err := r.recordPurchase(ctx, sub)
+	if sqlgraph.IsUniqueConstraintError(err) {
+		err = r.recordPurchase(ctx, sub)
+	}
+	return entError(err)
+}
+
+// recordPurchase updates or inserts sub in one transaction, returning ent's
+// error unmapped.
+func (r *entSubscriptions) recordPurchase(ctx context.Context, sub models.Subscription) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	existing, err := tx.Subscription.Query().
+		Where(
+			subscription.Chain(sub.Chain),
+			subscription.TokenID(sub.TokenID),
+			subscription.UserID(sub.UserID.Hex()),
+		).
+		First(ctx)
+	switch {
+	case err == nil:
+		update := tx.Subscription.UpdateOne(existing).SetModelID(sub.ModelID.Hex())
+		if sub.TxHash != "" {
+			update.SetTxHash(strings.ToLower(sub.TxHash))
+		}
+		update.SetNillableStartsAt(sub.StartsAt).SetNillableExpiresAt(sub.ExpiresAt)
+		if sub.OptionID != nil {
+			update.SetSubscriptionOptionID(sub.OptionID.Hex())
+		}
+		_, err = update.Save(ctx)
+	case ent.IsNotFound(err):
+		create := tx.Subscription.Create().
+			SetID(primitive.NewObjectID().Hex()).
+			SetChain(sub.Chain).
+			SetTokenID(sub.TokenID).
+			SetUserID(sub.UserID.Hex()).
+			SetModelID(sub.ModelID.Hex()).
+			SetTxHash(strings.ToLower(sub.TxHash)).
+			SetNillableStartsAt(sub.StartsAt).
+			SetNillableExpiresAt(sub.ExpiresAt).
+			SetIsListed(false)
+		if sub.OptionID != nil {
+			create.SetSubscriptionOptionID(sub.OptionID.Hex())
+		}
+		_, err = create.Save(ctx)
+	}
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func (r *entSubscriptions) Update(ctx context.Context, id primitive.ObjectID, update SubscriptionUpdate) (*models.Subscription, error) {
+	u := r.client.Subscription.UpdateOneID(id.Hex())
+	if update.UserID != nil {
+		u.SetUserID(update.UserID.Hex())
+	}
+	u.SetNillablePrice(update.Price).
+		SetNillableListingID(update.ListingID).
+		SetNillableIsListed(update.IsListed).
+		SetNillableExpiresAt(update.ExpiresAt).
+		SetNillableHeldOnChain(update.HeldOnChain).
+		SetNillableReconciledAt(update.ReconciledAt)
+	if update.TxHash != nil {
+		u.SetTxHash(strings.ToLower(*update.TxHash))
+	}
+
+	row, err := u.Save(ctx)
+	if err != nil {
+		return nil, entError(err)
+	}
+	return toSubscription(row), nil
+}
+
+func (r *entSubscriptions) Transfer(ctx context.Context, id, to primitive.ObjectID) (*models.Subscription, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	row, err := transferSubscription(ctx, tx, id, to)
+	if err != nil {
+		tx.Rollback()
+		return nil, entError(err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return toSubscription(row), nil
+}
+
+func transferSubscription(ctx context.Context, tx *ent.Tx, id, to primitive.ObjectID) (*ent.Subscription, error) {
+	source, err := tx.Subscription.Get(ctx, id.Hex())
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := tx.Subscription.Query().
+		Where(
+			subscription.Chain(source.Chain),
+			subscription.TokenID(source.TokenID),
+			subscription.UserID(to.Hex()),
+			subscription.IDNEQ(source.ID),
+		).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return tx.Subscription.UpdateOne(source).
+			SetUserID(to.Hex()).
+			SetIsListed(false).
+			Save(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	expiresAt, expired := mergedTerm(*toSubscription(source), *toSubscription(target), time.Now())
+	update := tx.Subscription.UpdateOne(target).SetExpired(expired)
+	if expiresAt != nil {
+		update.SetExpiresAt(*expiresAt)
+	} else {
+		update.ClearExpiresAt()
+	}
+	merged, err := update.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Subscription.DeleteOne(source).Exec(ctx); err != nil {
+		return nil, err
+	}
+	return merged, nil
+}
+
+func (r *entSubscriptions) ExpireDue(ctx context.Context, now time.Time) (int64, error) {
+	n, err := r.client.Subscription.Update().
+		Where(subscription.ExpiresAtLTE(now), subscription.Expired(false)).
+		SetExpired(true).
+		SetIsListed(false).
+		Save(ctx)
+	return int64(n), err
+}
+
+func toSubscription(row *ent.Subscription) *models.Subscription {
+	sub := &models.Subscription{
+		ID:           objectID(row.ID),
+		Chain:        row.Chain,
+		UserID:       objectID(row.UserID),
+		ModelID:      objectID(row.ModelID),
+		TokenID:      row.TokenID,
+		TxHash:       row.TxHash,
+		ListingID:    row.ListingID,
+		Price:        row.Price,
+		IsListed:     row.IsListed,
+		StartsAt:     row.StartsAt,
+		ExpiresAt:    row.ExpiresAt,
+		Expired:      row.Expired,
+		HeldOnChain:  row.HeldOnChain,
+		ReconciledAt: row.ReconciledAt,
+	}
+	if row.SubscriptionOptionID != nil {
+		optionID := objectID(*row.SubscriptionOptionID)
+		sub.OptionID = &optionID
+	}
+	return sub
+}
+
+type entOptions struct {
+	client *ent.Client
+}
+
+// Create inserts option for the model whose model_id is option.ModelID,
+// returning ErrNotFound when there is no such model.
+func (r *entOptions) Create(ctx context.Context, option *models.SubscriptionOption) error {
+	owner, err := r.client.Model.Query().Where(entmodel.ModelID(option.ModelID)).First(ctx)
+	if err != nil {
+		return entError(err)
+	}
+
+	if option.ID.IsZero() {
+		option.ID = primitive.NewObjectID()
+	}
+	create := r.client.SubscriptionOption.Create().
+		SetID(option.ID.Hex()).
+		SetModel(owner).
+		SetPrice(option.Price).
+		SetDuration(option.Duration).
+		SetDescription(option.Description)
+	if !option.CreatedAt.IsZero() {
+		create.SetCreatedAt(option.CreatedAt)
+	}
+	row, err := create.Save(ctx)
+	if err != nil {
+		return entError(err)
+	}
+	option.CreatedAt = row.CreatedAt
+	return nil
+}
+
+func (r *entOptions) Get(ctx context.Context, id primitive.ObjectID) (*models.SubscriptionOption, error) {
+	row, err := r.client.SubscriptionOption.Query().
+		Where(subscriptionoption.ID(id.Hex())).
+		WithModel().
+		First(ctx)
+	if err != nil {
+		return nil, entError(err)
+	}
+	return toSubscriptionOption(row), nil
+}
+
+func (r *entOptions) ListByModel(ctx context.Context, modelID string) ([]models.SubscriptionOption, error) {
+	rows, err := r.client.SubscriptionOption.Query().
+		Where(subscriptionoption.HasModelWith(entmodel.ModelID(modelID))).
+		WithModel().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]models.SubscriptionOption, 0, len(rows))
+	for _, row := range rows {
+		list = append(list, *toSubscriptionOption(row))
+	}
+	return list, nil
+}
+
+// toSubscriptionOption converts row, which must have its model loaded.
+func toSubscriptionOption(row *ent.SubscriptionOption) *models.SubscriptionOption {
+	return &models.SubscriptionOption{
+		ID:          objectID(row.ID),
+		ModelID:     row.Edges.Model.ModelID,
+		Price:       row.Price,
+		Duration:    row.Duration,
+		Description: row.Description,
+		CreatedAt:   row.CreatedAt,
+	}
+}
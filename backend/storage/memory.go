@@ -0,0 +1,447 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"arjunmal1311/fans_flow_on_chain/backend/models"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// NewMemory returns empty repositories held in memory, enforcing the same
+// unique constraints as the MongoDB ones. Records are stored and returned
+// by value, so callers changing what they got back do not change the store.
+func NewMemory() Repos {
+	modelRepo := &memoryModels{}
+	return Repos{
+		Users:         &memoryUsers{},
+		Models:        modelRepo,
+		Subscriptions: &memorySubscriptions{models: modelRepo},
+		Options:       &memoryOptions{},
+	}
+}
+
+type memoryUsers struct {
+	mu    sync.RWMutex
+	users []models.User
+}
+
+func (r *memoryUsers) Create(ctx context.Context, user *models.User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	user.WalletAddress = strings.ToLower(user.WalletAddress)
+	for _, u := range r.users {
+		switch {
+		case u.Username == user.Username:
+			return fmt.Errorf("%w username", ErrDuplicate)
+		case u.Email == user.Email:
+			return fmt.Errorf("%w email", ErrDuplicate)
+		case u.WalletAddress == user.WalletAddress:
+			return fmt.Errorf("%w wallet_address", ErrDuplicate)
+		case !user.ID.IsZero() && u.ID == user.ID:
+			return fmt.Errorf("%w _id", ErrDuplicate)
+		}
+	}
+	if user.ID.IsZero() {
+		user.ID = primitive.NewObjectID()
+	}
+	r.users = append(r.users, *user)
+	return nil
+}
+
+func (r *memoryUsers) Get(ctx context.Context, id primitive.ObjectID) (*models.User, error) {
+	return r.find(func(u models.User) bool { return u.ID == id })
+}
+
+func (r *memoryUsers) FindByWallet(ctx context.Context, wallet string) (*models.User, error) {
+	return r.find(func(u models.User) bool { return u.WalletAddress == strings.ToLower(wallet) })
+}
+
+func (r *memoryUsers) FindByEmail(ctx context.Context, email string) (*models.User, error) {
+	return r.find(func(u models.User) bool { return u.Email == email })
+}
+
+func (r *memoryUsers) FindByOpenAiTokenID(ctx context.Context, tokenID string) (*models.User, error) {
+	return r.find(func(u models.User) bool { return u.OpenAiTokenId == tokenID })
+}
+
+func (r *memoryUsers) List(ctx context.Context) ([]models.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return append([]models.User(nil), r.users...), nil
+}
+
+func (r *memoryUsers) find(match func(models.User) bool) (*models.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, u := range r.users {
+		if match(u) {
+			return &u, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+type memoryModels struct {
+	mu     sync.RWMutex
+	models []models.Model
+}
+
+func (r *memoryModels) Create(ctx context.Context, model *models.Model) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	model.WalletAddress = strings.ToLower(model.WalletAddress)
+	for _, m := range r.models {
+		switch {
+		case m.ModelID == model.ModelID:
+			return fmt.Errorf("%w model_id", ErrDuplicate)
+		case m.Email == model.Email:
+			return fmt.Errorf("%w email", ErrDuplicate)
+		case m.WalletAddress == model.WalletAddress:
+			return fmt.Errorf("%w wallet_address", ErrDuplicate)
+		case model.Slug != "" && m.Slug == model.Slug:
+			return fmt.Errorf("%w slug", ErrDuplicate)
+		case !model.ID.IsZero() && m.ID == model.ID:
+			return fmt.Errorf("%w _id", ErrDuplicate)
+		}
+	}
+	if model.ID.IsZero() {
+		model.ID = primitive.NewObjectID()
+	}
+	r.models = append(r.models, *model)
+	return nil
+}
+
+func (r *memoryModels) Get(ctx context.Context, id primitive.ObjectID) (*models.Model, error) {
+	return r.find(func(m models.Model) bool { return m.ID == id })
+}
+
+func (r *memoryModels) FindByModelID(ctx context.Context, modelID string) (*models.Model, error) {
+	return r.find(func(m models.Model) bool { return m.ModelID == modelID })
+}
+
+func (r *memoryModels) FindBySlug(ctx context.Context, slug string) (*models.Model, error) {
+	return r.find(func(m models.Model) bool { return m.Slug == slug })
+}
+
+func (r *memoryModels) FindByWallet(ctx context.Context, wallet string) (*models.Model, error) {
+	return r.find(func(m models.Model) bool { return m.WalletAddress == strings.ToLower(wallet) })
+}
+
+func (r *memoryModels) List(ctx context.Context) ([]models.Model, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return append([]models.Model(nil), r.models...), nil
+}
+
+func (r *memoryModels) SetPosts(ctx context.Context, id primitive.ObjectID, count int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i := range r.models {
+		if r.models[i].ID == id {
+			r.models[i].Posts = count
+		}
+	}
+	return nil
+}
+
+func (r *memoryModels) find(match func(models.Model) bool) (*models.Model, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, m := range r.models {
+		if match(m) {
+			return &m, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+type memorySubscriptions struct {
+	mu   sync.RWMutex
+	subs []models.Subscription
+	// models is joined by FindWithModels.
+	models *memoryModels
+}
+
+// matches mirrors SubscriptionQuery.
+func (f SubscriptionFilter) matches(sub models.Subscription) bool {
+	switch {
+	case f.Chain != "" && sub.Chain != f.Chain:
+		return false
+	case f.TokenID != "" && sub.TokenID != f.TokenID:
+		return false
+	case f.UserID != nil && sub.UserID != *f.UserID:
+		return false
+	case f.ModelID != nil && sub.ModelID != *f.ModelID:
+		return false
+	case f.TxHash != "" && sub.TxHash != strings.ToLower(f.TxHash):
+		return false
+	case f.Listed != nil && sub.IsListed != *f.Listed:
+		return false
+	case f.Expired != nil && sub.Expired != *f.Expired:
+		return false
+	case f.Status != "" && sub.Status(f.now()) != f.Status:
+		return false
+	}
+	return true
+}
+
+func (r *memorySubscriptions) Find(ctx context.Context, filter SubscriptionFilter) ([]models.Subscription, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var list []models.Subscription
+	for _, sub := range r.subs {
+		if filter.matches(sub) {
+			list = append(list, sub)
+		}
+	}
+	return list, nil
+}
+
+func (r *memorySubscriptions) FindOne(ctx context.Context, filter SubscriptionFilter) (*models.Subscription, error) {
+	list, err := r.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, ErrNotFound
+	}
+	return &list[0], nil
+}
+
+func (r *memorySubscriptions) FindWithModels(ctx context.Context, filter SubscriptionFilter) ([]SubscriptionWithModel, error) {
+	subs, err := r.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]SubscriptionWithModel, 0, len(subs))
+	for _, sub := range subs {
+		joined := SubscriptionWithModel{Subscription: sub}
+		if model, err := r.models.Get(ctx, sub.ModelID); err == nil {
+			joined.Model = model
+		}
+		list = append(list, joined)
+	}
+	return list, nil
+}
+
+func (r *memorySubscriptions) Latest(ctx context.Context, chain, tokenID string) (*models.Subscription, error) {
+	list, err := r.Find(ctx, SubscriptionFilter{Chain: chain, TokenID: tokenID})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, ErrNotFound
+	}
+
+	// Like a descending sort in MongoDB, subscriptions without an expiry
+	// come last.
+	sort.SliceStable(list, func(i, j int) bool {
+		a, b := list[i].ExpiresAt, list[j].ExpiresAt
+		return a != nil && (b == nil || a.After(*b))
+	})
+	return &list[0], nil
+}
+
+func (r *memorySubscriptions) RecordPurchase(ctx context.Context, sub models.Subscription) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	i := -1
+	for j, s := range r.subs {
+		if s.Chain == sub.Chain && s.TokenID == sub.TokenID && s.UserID == sub.UserID {
+			i = j
+			break
+		}
+	}
+	if i < 0 {
+		r.subs = append(r.subs, models.Subscription{
+			ID:      primitive.NewObjectID(),
+			Chain:   sub.Chain,
+			TokenID: sub.TokenID,
+			UserID:  sub.UserID,
+		})
+		i = len(r.subs) - 1
+	}
+
+	existing := &r.subs[i]
+	existing.ModelID = sub.ModelID
+	if sub.TxHash != "" {
+		existing.TxHash = strings.ToLower(sub.TxHash)
+	}
+	if sub.StartsAt != nil {
+		existing.StartsAt = sub.StartsAt
+	}
+	if sub.ExpiresAt != nil {
+		existing.ExpiresAt = sub.ExpiresAt
+	}
+	if sub.OptionID != nil {
+		existing.OptionID = sub.OptionID
+	}
+	return nil
+}
+
+// holds reports whether a subscription other than except already has the
+// given chain, token and holder. The caller holds r.mu.
+func (r *memorySubscriptions) holds(chain, tokenID string, userID, except primitive.ObjectID) bool {
+	for _, s := range r.subs {
+		if s.ID != except && s.Chain == chain && s.TokenID == tokenID && s.UserID == userID {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *memorySubscriptions) Update(ctx context.Context, id primitive.ObjectID, update SubscriptionUpdate) (*models.Subscription, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i := range r.subs {
+		sub := &r.subs[i]
+		if sub.ID != id {
+			continue
+		}
+		if update.UserID != nil {
+			if r.holds(sub.Chain, sub.TokenID, *update.UserID, id) {
+				return nil, fmt.Errorf("%w user_id", ErrDuplicate)
+			}
+			sub.UserID = *update.UserID
+		}
+		if update.Price != nil {
+			sub.Price = *update.Price
+		}
+		if update.ListingID != nil {
+			sub.ListingID = *update.ListingID
+		}
+		if update.IsListed != nil {
+			sub.IsListed = *update.IsListed
+		}
+		if update.TxHash != nil {
+			sub.TxHash = strings.ToLower(*update.TxHash)
+		}
+		if update.ExpiresAt != nil {
+			expiresAt := *update.ExpiresAt
+			sub.ExpiresAt = &expiresAt
+		}
+		if update.HeldOnChain != nil {
+			held := *update.HeldOnChain
+			sub.HeldOnChain = &held
+		}
+		if update.ReconciledAt != nil {
+			reconciledAt := *update.ReconciledAt
+			sub.ReconciledAt = &reconciledAt
+		}
+		updated := *sub
+		return &updated, nil
+	}
+	return nil, ErrNotFound
+}
+
+func (r *memorySubscriptions) Transfer(ctx context.Context, id, to primitive.ObjectID) (*models.Subscription, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	source, target := -1, -1
+	for i, sub := range r.subs {
+		if sub.ID == id {
+			source = i
+		}
+	}
+	if source < 0 {
+		return nil, ErrNotFound
+	}
+	for i, sub := range r.subs {
+		if i != source && sub.Chain == r.subs[source].Chain && sub.TokenID == r.subs[source].TokenID && sub.UserID == to {
+			target = i
+		}
+	}
+
+	if target < 0 {
+		sub := &r.subs[source]
+		sub.UserID = to
+		sub.IsListed = false
+		transferred := *sub
+		return &transferred, nil
+	}
+
+	merged := &r.subs[target]
+	merged.ExpiresAt, merged.Expired = mergedTerm(r.subs[source], *merged, time.Now())
+	result := *merged
+	r.subs = append(r.subs[:source], r.subs[source+1:]...)
+	return &result, nil
+}
+
+func (r *memorySubscriptions) ExpireDue(ctx context.Context, now time.Time) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var changed int64
+	for i := range r.subs {
+		sub := &r.subs[i]
+		if sub.Expired || sub.Status(now) != models.SubscriptionExpired {
+			continue
+		}
+		sub.Expired = true
+		sub.IsListed = false
+		changed++
+	}
+	return changed, nil
+}
+
+type memoryOptions struct {
+	mu      sync.RWMutex
+	options []models.SubscriptionOption
+}
+
+func (r *memoryOptions) Create(ctx context.Context, option *models.SubscriptionOption) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if option.ID.IsZero() {
+		option.ID = primitive.NewObjectID()
+	}
+	for _, o := range r.options {
+		if o.ID == option.ID {
+			return fmt.Errorf("%w _id", ErrDuplicate)
+		}
+	}
+	r.options = append(r.options, *option)
+	return nil
+}
+
+func (r *memoryOptions) Get(ctx context.Context, id primitive.ObjectID) (*models.SubscriptionOption, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, o := range r.options {
+		if o.ID == id {
+			return &o, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+func (r *memoryOptions) ListByModel(ctx context.Context, modelID string) ([]models.SubscriptionOption, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var list []models.SubscriptionOption
+	for _, o := range r.options {
+		if o.ModelID == modelID {
+			list = append(list, o)
+		}
+	}
+	return list, nil
+}
@@ -0,0 +1,473 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"arjunmal1311/fans_flow_on_chain/backend/db"
+	"arjunmal1311/fans_flow_on_chain/backend/models"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// NewMongo returns repositories backed by database, which db.InitDB has
+// already given its indexes.
+func NewMongo(database *mongo.Database) Repos {
+	return Repos{
+		Users:         &mongoUsers{database.Collection("users")},
+		Models:        &mongoModels{database.Collection("models")},
+		Subscriptions: &mongoSubscriptions{database.Collection("subscriptions")},
+		Options:       &mongoOptions{database.Collection("subscription_options")},
+	}
+}
+
+// findOne decodes the first document matching filter into v, mapping a
+// miss to ErrNotFound.
+func findOne(ctx context.Context, c *mongo.Collection, filter interface{}, v interface{}, opts ...*options.FindOneOptions) error {
+	err := c.FindOne(ctx, filter, opts...).Decode(v)
+	if err == mongo.ErrNoDocuments {
+		return ErrNotFound
+	}
+	return err
+}
+
+// conflict reports which of fields an existing document shares with the
+// new one. The unique indexes catch the same cases, but are only created
+// on a best-effort basis at startup.
+func conflict(ctx context.Context, c *mongo.Collection, fields bson.D) error {
+	clauses := make([]bson.M, 0, len(fields))
+	for _, f := range fields {
+		clauses = append(clauses, bson.M{f.Key: f.Value})
+	}
+
+	var existing bson.M
+	err := findOne(ctx, c, bson.M{"$or": clauses}, &existing)
+	if err == ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	for _, f := range fields {
+		if existing[f.Key] == f.Value {
+			return fmt.Errorf("%w %s", ErrDuplicate, f.Key)
+		}
+	}
+	return ErrDuplicate
+}
+
+func insert(ctx context.Context, c *mongo.Collection, doc interface{}) error {
+	_, err := c.InsertOne(ctx, doc)
+	if mongo.IsDuplicateKeyError(err) {
+		return fmt.Errorf("%w: %v", ErrDuplicate, err)
+	}
+	return err
+}
+
+type mongoUsers struct {
+	c *mongo.Collection
+}
+
+func (r *mongoUsers) Create(ctx context.Context, user *models.User) error {
+	user.WalletAddress = strings.ToLower(user.WalletAddress)
+	err := conflict(ctx, r.c, bson.D{
+		{Key: "username", Value: user.Username},
+		{Key: "email", Value: user.Email},
+		{Key: "wallet_address", Value: user.WalletAddress},
+	})
+	if err != nil {
+		return err
+	}
+	if user.ID.IsZero() {
+		user.ID = primitive.NewObjectID()
+	}
+	return insert(ctx, r.c, user)
+}
+
+func (r *mongoUsers) Get(ctx context.Context, id primitive.ObjectID) (*models.User, error) {
+	return r.findOne(ctx, bson.M{"_id": id})
+}
+
+func (r *mongoUsers) FindByWallet(ctx context.Context, wallet string) (*models.User, error) {
+	return r.findOne(ctx, db.WalletAddressFilter(wallet))
+}
+
+func (r *mongoUsers) FindByEmail(ctx context.Context, email string) (*models.User, error) {
+	return r.findOne(ctx, bson.M{"email": email})
+}
+
+func (r *mongoUsers) FindByOpenAiTokenID(ctx context.Context, tokenID string) (*models.User, error) {
+	return r.findOne(ctx, bson.M{"openai_token_id": tokenID})
+}
+
+func (r *mongoUsers) List(ctx context.Context) ([]models.User, error) {
+	cursor, err := r.c.Find(ctx, bson.M{})
+	if err != nil {
+		return nil, err
+	}
+	var list []models.User
+	if err := cursor.All(ctx, &list); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (r *mongoUsers) findOne(ctx context.Context, filter interface{}) (*models.User, error) {
+	var user models.User
+	if err := findOne(ctx, r.c, filter, &user); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+type mongoModels struct {
+	c *mongo.Collection
+}
+
+func (r *mongoModels) Create(ctx context.Context, model *models.Model) error {
+	model.WalletAddress = strings.ToLower(model.WalletAddress)
+	fields := bson.D{
+		{Key: "model_id", Value: model.ModelID},
+		{Key: "email", Value: model.Email},
+		{Key: "wallet_address", Value: model.WalletAddress},
+	}
+	if model.Slug != "" {
+		fields = append(fields, bson.E{Key: "slug", Value: model.Slug})
+	}
+	if err := conflict(ctx, r.c, fields); err != nil {
+		return err
+	}
+	if model.ID.IsZero() {
+		model.ID = primitive.NewObjectID()
+	}
+	return insert(ctx, r.c, model)
+}
+
+func (r *mongoModels) Get(ctx context.Context, id primitive.ObjectID) (*models.Model, error) {
+	return r.findOne(ctx, bson.M{"_id": id})
+}
+
+func (r *mongoModels) FindByModelID(ctx context.Context, modelID string) (*models.Model, error) {
+	return r.findOne(ctx, bson.M{"model_id": modelID})
+}
+
+func (r *mongoModels) FindBySlug(ctx context.Context, slug string) (*models.Model, error) {
+	return r.findOne(ctx, bson.M{"slug": slug})
+}
+
+func (r *mongoModels) FindByWallet(ctx context.Context, wallet string) (*models.Model, error) {
+	return r.findOne(ctx, db.WalletAddressFilter(wallet))
+}
+
+func (r *mongoModels) List(ctx context.Context) ([]models.Model, error) {
+	cursor, err := r.c.Find(ctx, bson.M{})
+	if err != nil {
+		return nil, err
+	}
+	var list []models.Model
+	if err := cursor.All(ctx, &list); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (r *mongoModels) SetPosts(ctx context.Context, id primitive.ObjectID, count int64) error {
+	_, err := r.c.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": bson.M{"posts": count}})
+	return err
+}
+
+func (r *mongoModels) findOne(ctx context.Context, filter interface{}) (*models.Model, error) {
+	var model models.Model
+	if err := findOne(ctx, r.c, filter, &model); err != nil {
+		return nil, err
+	}
+	return &model, nil
+}
+
+type mongoSubscriptions struct {
+	c *mongo.Collection
+}
+
+// SubscriptionQuery translates filter into a MongoDB query on
+// "subscriptions".
+func SubscriptionQuery(filter SubscriptionFilter) bson.M {
+	query := bson.M{}
+	if filter.Chain != "" {
+		query["chain"] = filter.Chain
+	}
+	if filter.TokenID != "" {
+		query["token_id"] = filter.TokenID
+	}
+	if filter.UserID != nil {
+		query["user_id"] = *filter.UserID
+	}
+	if filter.ModelID != nil {
+		query["model_id"] = *filter.ModelID
+	}
+	if filter.TxHash != "" {
+		query["tx_hash"] = strings.ToLower(filter.TxHash)
+	}
+	if filter.Listed != nil {
+		query["is_listed"] = *filter.Listed
+	}
+	if filter.Expired != nil {
+		if *filter.Expired {
+			query["expired"] = true
+		} else {
+			query["expired"] = bson.M{"$ne": true}
+		}
+	}
+
+	switch filter.Status {
+	case models.SubscriptionActive:
+		// Subscriptions recorded before expiry was tracked have none.
+		query["$or"] = []bson.M{
+			{"expires_at": bson.M{"$exists": false}},
+			{"expires_at": nil},
+			{"expires_at": bson.M{"$gt": filter.now()}},
+		}
+	case models.SubscriptionExpired:
+		query["expires_at"] = bson.M{"$lte": filter.now()}
+	}
+	return query
+}
+
+func (r *mongoSubscriptions) Find(ctx context.Context, filter SubscriptionFilter) ([]models.Subscription, error) {
+	cursor, err := r.c.Find(ctx, SubscriptionQuery(filter))
+	if err != nil {
+		return nil, err
+	}
+	var list []models.Subscription
+	if err := cursor.All(ctx, &list); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (r *mongoSubscriptions) FindOne(ctx context.Context, filter SubscriptionFilter) (*models.Subscription, error) {
+	var sub models.Subscription
+	if err := findOne(ctx, r.c, SubscriptionQuery(filter), &sub); err != nil {
+		return nil, err
+	}
+	return &sub, nil
+}
+
+func (r *mongoSubscriptions) FindWithModels(ctx context.Context, filter SubscriptionFilter) ([]SubscriptionWithModel, error) {
+	cursor, err := r.c.Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: SubscriptionQuery(filter)}},
+		{{Key: "$lookup", Value: bson.M{
+			"from":         "models",
+			"localField":   "model_id",
+			"foreignField": "_id",
+			"as":           "model",
+		}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []struct {
+		models.Subscription `bson:",inline"`
+		Model               []models.Model `bson:"model"`
+	}
+	if err := cursor.All(ctx, &rows); err != nil {
+		return nil, err
+	}
+
+	list := make([]SubscriptionWithModel, 0, len(rows))
+	for _, row := range rows {
+		joined := SubscriptionWithModel{Subscription: row.Subscription}
+		if len(row.Model) > 0 {
+			joined.Model = &row.Model[0]
+		}
+		list = append(list, joined)
+	}
+	return list, nil
+}
+
+func (r *mongoSubscriptions) Latest(ctx context.Context, chain, tokenID string) (*models.Subscription, error) {
+	var sub models.Subscription
+	err := findOne(ctx, r.c,
+		SubscriptionQuery(SubscriptionFilter{Chain: chain, TokenID: tokenID}),
+		&sub,
+		options.FindOne().SetSort(bson.D{{Key: "expires_at", Value: -1}}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &sub, nil
+}
+
+func (r *mongoSubscriptions) RecordPurchase(ctx context.Context, sub models.Subscription) error {
+	set := bson.M{"model_id": sub.ModelID}
+	if sub.TxHash != "" {
+		set["tx_hash"] = strings.ToLower(sub.TxHash)
+	}
+	if sub.StartsAt != nil {
+		set["starts_at"] = sub.StartsAt
+	}
+	if sub.ExpiresAt != nil {
+		set["expires_at"] = sub.ExpiresAt
+	}
+	if sub.OptionID != nil {
+		set["subscription_option_id"] = sub.OptionID
+	}
+
+	upsert := func() error {
+		_, err := r.c.UpdateOne(
+			ctx,
+			bson.M{"chain": sub.Chain, "token_id": sub.TokenID, "user_id": sub.UserID},
+			bson.M{
+				"$set":         set,
+				"$setOnInsert": bson.M{"is_listed": false},
+			},
+			options.Update().SetUpsert(true),
+		)
+		return err
+	}
+	// Two concurrent upserts can both miss and insert; the unique index
+	// rejects the second, which then finds the first's row and updates it.
+	err := upsert()
+	if mongo.IsDuplicateKeyError(err) {
+		err = upsert()
+	}
+	return err
+}
+
+func (r *mongoSubscriptions) Update(ctx context.Context, id primitive.ObjectID, update SubscriptionUpdate) (*models.Subscription, error) {
+	set := bson.M{}
+	if update.UserID != nil {
+		set["user_id"] = *update.UserID
+	}
+	if update.Price != nil {
+		set["price"] = *update.Price
+	}
+	if update.ListingID != nil {
+		set["listing_id"] = *update.ListingID
+	}
+	if update.IsListed != nil {
+		set["is_listed"] = *update.IsListed
+	}
+	if update.TxHash != nil {
+		set["tx_hash"] = strings.ToLower(*update.TxHash)
+	}
+	if update.ExpiresAt != nil {
+		set["expires_at"] = *update.ExpiresAt
+	}
+	if update.HeldOnChain != nil {
+		set["held_on_chain"] = *update.HeldOnChain
+	}
+	if update.ReconciledAt != nil {
+		set["reconciled_at"] = *update.ReconciledAt
+	}
+
+	var sub models.Subscription
+	err := r.c.FindOneAndUpdate(
+		ctx,
+		bson.M{"_id": id},
+		bson.M{"$set": set},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&sub)
+	if err == mongo.ErrNoDocuments {
+		return nil, ErrNotFound
+	}
+	if mongo.IsDuplicateKeyError(err) {
+		return nil, fmt.Errorf("%w user_id", ErrDuplicate)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &sub, nil
+}
+
+func (r *mongoSubscriptions) Transfer(ctx context.Context, id, to primitive.ObjectID) (*models.Subscription, error) {
+	var source models.Subscription
+	if err := findOne(ctx, r.c, bson.M{"_id": id}, &source); err != nil {
+		return nil, err
+	}
+
+	unlisted := false
+	var target models.Subscription
+	err := findOne(ctx, r.c, bson.M{"chain": source.Chain, "token_id": source.TokenID, "user_id": to}, &target)
+	if err == ErrNotFound || (err == nil && target.ID == source.ID) {
+		return r.Update(ctx, id, SubscriptionUpdate{UserID: &to, IsListed: &unlisted})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	expiresAt, expired := mergedTerm(source, target, time.Now())
+	set := bson.M{"expired": expired}
+	change := bson.M{"$set": set}
+	if expiresAt != nil {
+		set["expires_at"] = *expiresAt
+	} else {
+		change["$unset"] = bson.M{"expires_at": ""}
+	}
+
+	var merged models.Subscription
+	err = r.c.FindOneAndUpdate(
+		ctx,
+		bson.M{"_id": target.ID},
+		change,
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&merged)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := r.c.DeleteOne(ctx, bson.M{"_id": source.ID}); err != nil {
+		return nil, err
+	}
+	return &merged, nil
+}
+
+func (r *mongoSubscriptions) ExpireDue(ctx context.Context, now time.Time) (int64, error) {
+	result, err := r.c.UpdateMany(
+		ctx,
+		bson.M{
+			"expires_at": bson.M{"$lte": now},
+			"expired":    bson.M{"$ne": true},
+		},
+		bson.M{"$set": bson.M{"expired": true, "is_listed": false}},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.ModifiedCount, nil
+}
+
+type mongoOptions struct {
+	c *mongo.Collection
+}
+
+func (r *mongoOptions) Create(ctx context.Context, option *models.SubscriptionOption) error {
+	if option.ID.IsZero() {
+		option.ID = primitive.NewObjectID()
+	}
+	return insert(ctx, r.c, option)
+}
+
+func (r *mongoOptions) Get(ctx context.Context, id primitive.ObjectID) (*models.SubscriptionOption, error) {
+	var option models.SubscriptionOption
+	if err := findOne(ctx, r.c, bson.M{"_id": id}, &option); err != nil {
+		return nil, err
+	}
+	return &option, nil
+}
+
+func (r *mongoOptions) ListByModel(ctx context.Context, modelID string) ([]models.SubscriptionOption, error) {
+	cursor, err := r.c.Find(ctx, bson.M{"model_id": modelID})
+	if err != nil {
+		return nil, err
+	}
+	var list []models.SubscriptionOption
+	if err := cursor.All(ctx, &list); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
@@ -0,0 +1,92 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"arjunmal1311/fans_flow_on_chain/backend/db"
+	"arjunmal1311/fans_flow_on_chain/backend/ent"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	_ "modernc.org/sqlite"
+)
+
+// FromEnv returns the repositories of the backend named by STORAGE_BACKEND:
+// "mongo" (the default), which uses db.DB and so needs db.InitDB first, or
+// "sqlite" or "postgres", which open SQL_DATABASE_URL through ent and create
+// any missing tables. The returned function closes the SQL connection.
+//
+// Only users, models, subscriptions and subscription options are covered:
+// assets, pins, posts, nonces, avatar jobs and indexer cursors stay in
+// MongoDB, so db.InitDB is required with every backend.
+func FromEnv(ctx context.Context) (Repos, func() error, error) {
+	backend := os.Getenv("STORAGE_BACKEND")
+	if backend == "" {
+		backend = "mongo"
+	}
+
+	var driver, name string
+	switch backend {
+	case "mongo":
+		if db.DB == nil {
+			return Repos{}, nil, fmt.Errorf("storage: MongoDB is not connected")
+		}
+		log.Println("Storing users, models and subscriptions in MongoDB")
+		return NewMongo(db.DB), func() error { return nil }, nil
+	case "sqlite":
+		driver, name = "sqlite", dialect.SQLite
+	case "postgres":
+		driver, name = "pgx", dialect.Postgres
+	default:
+		return Repos{}, nil, fmt.Errorf("unknown STORAGE_BACKEND %q", backend)
+	}
+
+	dsn := os.Getenv("SQL_DATABASE_URL")
+	if dsn == "" {
+		return Repos{}, nil, fmt.Errorf("SQL_DATABASE_URL is required for the %s storage backend", backend)
+	}
+
+	client, err := OpenEnt(ctx, driver, name, dsn)
+	if err != nil {
+		return Repos{}, nil, err
+	}
+	log.Printf("Storing users, models and subscriptions in %s; everything else stays in MongoDB", backend)
+	return NewEnt(client), client.Close, nil
+}
+
+// OpenEnt connects to dsn with the database/sql driver and migrates the ent
+// schema. SQLite connections have foreign keys turned on, which ent relies
+// on for the relations between users, models and subscriptions.
+func OpenEnt(ctx context.Context, driver, name, dsn string) (*ent.Client, error) {
+	if name == dialect.SQLite && !strings.Contains(dsn, "foreign_keys") {
+		// Pragmas in the DSN apply to every connection the pool opens.
+		separator := "?"
+		if strings.Contains(dsn, "?") {
+			separator = "&"
+		}
+		dsn += separator + "_pragma=foreign_keys(1)"
+	}
+
+	conn, err := sql.Open(driver, dsn)
+	if err != nil {
+		return nil, err
+	}
+	if name == dialect.SQLite {
+		// SQLite allows one writer at a time; queue writes in the pool
+		// rather than failing them with SQLITE_BUSY.
+		conn.SetMaxOpenConns(1)
+	}
+
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(name, conn)))
+	if err := client.Schema.Create(ctx); err != nil {
+		client.Close()
+		return nil, fmt.Errorf("failed to migrate %s schema: %v", name, err)
+	}
+	return client, nil
+}
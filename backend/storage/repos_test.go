@@ -0,0 +1,356 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"arjunmal1311/fans_flow_on_chain/backend/ent"
+	"arjunmal1311/fans_flow_on_chain/backend/ent/enttest"
+	"arjunmal1311/fans_flow_on_chain/backend/models"
+)
+
+// backend is one repository implementation under the shared contract.
+type backend struct {
+	name  string
+	repos Repos
+	// orphan records sub, whose model does not exist, bypassing whatever
+	// the backend does to prevent that.
+	orphan func(t *testing.T, sub models.Subscription)
+}
+
+// forEachBackend runs test against fresh repositories of every backend
+// that runs without a server: memory, and ent on SQLite.
+func forEachBackend(t *testing.T, test func(t *testing.T, b backend)) {
+	t.Run("memory", func(t *testing.T) {
+		repos := NewMemory()
+		test(t, backend{name: "memory", repos: repos, orphan: func(t *testing.T, sub models.Subscription) {
+			if err := repos.Subscriptions.RecordPurchase(context.Background(), sub); err != nil {
+				t.Fatal(err)
+			}
+		}})
+	})
+
+	t.Run("sqlite", func(t *testing.T) {
+		conn, err := sql.Open("sqlite", "file:"+filepath.Join(t.TempDir(), "ofoc.db")+"?_pragma=foreign_keys(1)")
+		if err != nil {
+			t.Fatal(err)
+		}
+		// One connection, as OpenEnt uses, so the pragmas below apply to
+		// every query.
+		conn.SetMaxOpenConns(1)
+		client := enttest.NewClient(t, enttest.WithOptions(ent.Driver(entsql.OpenDB(dialect.SQLite, conn))))
+		t.Cleanup(func() { client.Close() })
+
+		repos := NewEnt(client)
+		test(t, backend{name: "sqlite", repos: repos, orphan: func(t *testing.T, sub models.Subscription) {
+			// Foreign keys keep SQL subscriptions from naming a missing
+			// model, so orphans only arise from changes made outside the
+			// application.
+			if _, err := conn.Exec("PRAGMA foreign_keys = OFF"); err != nil {
+				t.Fatal(err)
+			}
+			defer conn.Exec("PRAGMA foreign_keys = ON")
+			if err := repos.Subscriptions.RecordPurchase(context.Background(), sub); err != nil {
+				t.Fatal(err)
+			}
+		}})
+	})
+}
+
+func newUser(t *testing.T, repos Repos, name string) models.User {
+	t.Helper()
+	user := models.User{Username: name, Email: name + "@example.com", WalletAddress: "0x" + fmt.Sprintf("%040s", hex.EncodeToString([]byte(name)))}
+	if err := repos.Users.Create(context.Background(), &user); err != nil {
+		t.Fatal(err)
+	}
+	return user
+}
+
+func newModel(t *testing.T, repos Repos, modelID string) models.Model {
+	t.Helper()
+	model := models.Model{Name: "Model " + modelID, ModelID: modelID, Email: "model" + modelID + "@example.com", WalletAddress: "0x" + fmt.Sprintf("%040s", modelID), Slug: "model-" + modelID}
+	if err := repos.Models.Create(context.Background(), &model); err != nil {
+		t.Fatal(err)
+	}
+	return model
+}
+
+func TestUsersUnique(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, b backend) {
+		ctx := context.Background()
+		alice := models.User{Username: "alice", Email: "alice@example.com", WalletAddress: "0x00000000000000000000000000000000000A11CE"}
+		if err := b.repos.Users.Create(ctx, &alice); err != nil {
+			t.Fatal(err)
+		}
+		if alice.WalletAddress != "0x00000000000000000000000000000000000a11ce" {
+			t.Fatalf("stored wallet address %s, want it lower-cased", alice.WalletAddress)
+		}
+
+		found, err := b.repos.Users.FindByWallet(ctx, "0x00000000000000000000000000000000000A11CE")
+		if err != nil || found.ID != alice.ID {
+			t.Fatalf("FindByWallet with checksum casing = %v, %v", found, err)
+		}
+
+		for _, u := range []models.User{
+			{Username: "alice", Email: "other@example.com", WalletAddress: "0x0000000000000000000000000000000000000001"},
+			{Username: "other", Email: "alice@example.com", WalletAddress: "0x0000000000000000000000000000000000000002"},
+			{Username: "other", Email: "other@example.com", WalletAddress: "0x00000000000000000000000000000000000a11ce"},
+		} {
+			if err := b.repos.Users.Create(ctx, &u); !errors.Is(err, ErrDuplicate) {
+				t.Errorf("Create(%s, %s, %s) = %v, want ErrDuplicate", u.Username, u.Email, u.WalletAddress, err)
+			}
+		}
+	})
+}
+
+func TestModelsUnique(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, b backend) {
+		ctx := context.Background()
+		model := newModel(t, b.repos, "7")
+
+		for _, m := range []models.Model{
+			{Name: "Other", ModelID: "7", Email: "other@example.com", WalletAddress: "0x0000000000000000000000000000000000000001", Slug: "other"},
+			{Name: "Other", ModelID: "8", Email: "other@example.com", WalletAddress: "0x0000000000000000000000000000000000000001", Slug: model.Slug},
+		} {
+			if err := b.repos.Models.Create(ctx, &m); !errors.Is(err, ErrDuplicate) {
+				t.Errorf("Create(model_id %s, slug %s) = %v, want ErrDuplicate", m.ModelID, m.Slug, err)
+			}
+		}
+	})
+}
+
+func TestSubscriptionsUnique(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, b backend) {
+		ctx := context.Background()
+		repo := b.repos.Subscriptions
+		alice, bob, carol := newUser(t, b.repos, "alice"), newUser(t, b.repos, "bob"), newUser(t, b.repos, "carol")
+		model := newModel(t, b.repos, "7")
+
+		// Recording the same chain, token and holder twice updates one row.
+		for _, txHash := range []string{"0xAA", "0xBB"} {
+			if err := repo.RecordPurchase(ctx, models.Subscription{Chain: "default", TokenID: "1", UserID: alice.ID, ModelID: model.ID, TxHash: txHash}); err != nil {
+				t.Fatal(err)
+			}
+		}
+		subs, err := repo.Find(ctx, SubscriptionFilter{Chain: "default", TokenID: "1"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(subs) != 1 || subs[0].TxHash != "0xbb" || subs[0].IsListed {
+			t.Fatalf("after two purchases: %+v", subs)
+		}
+		held := subs[0]
+
+		if err := repo.RecordPurchase(ctx, models.Subscription{Chain: "default", TokenID: "1", UserID: bob.ID, ModelID: model.ID}); err != nil {
+			t.Fatal(err)
+		}
+		bobs, err := repo.FindOne(ctx, SubscriptionFilter{Chain: "default", TokenID: "1", UserID: &bob.ID})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := repo.Update(ctx, bobs.ID, SubscriptionUpdate{UserID: &alice.ID}); !errors.Is(err, ErrDuplicate) {
+			t.Fatalf("moving Bob's subscription to Alice: %v, want ErrDuplicate", err)
+		}
+		// Setting the holder a subscription already has is not a clash.
+		if _, err := repo.Update(ctx, held.ID, SubscriptionUpdate{UserID: &alice.ID}); err != nil {
+			t.Fatalf("keeping Alice's subscription hers: %v", err)
+		}
+
+		// The same token on another chain is a different subscription.
+		if err := repo.RecordPurchase(ctx, models.Subscription{Chain: "moonbeam", TokenID: "1", UserID: carol.ID, ModelID: model.ID}); err != nil {
+			t.Fatal(err)
+		}
+		other, err := repo.FindOne(ctx, SubscriptionFilter{Chain: "moonbeam", TokenID: "1"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := repo.Update(ctx, other.ID, SubscriptionUpdate{UserID: &alice.ID}); err != nil {
+			t.Fatalf("moving a moonbeam subscription to Alice: %v", err)
+		}
+
+		if _, err := repo.Update(ctx, primitive.NewObjectID(), SubscriptionUpdate{UserID: &alice.ID}); err != ErrNotFound {
+			t.Fatalf("updating a missing subscription: %v, want ErrNotFound", err)
+		}
+	})
+}
+
+func TestSubscriptionsTransfer(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, b backend) {
+		ctx := context.Background()
+		repo := b.repos.Subscriptions
+		alice, bob := newUser(t, b.repos, "alice"), newUser(t, b.repos, "bob")
+		model := newModel(t, b.repos, "7")
+
+		now := time.Now().UTC().Truncate(time.Second)
+		earlier, later := now.Add(24*time.Hour), now.Add(48*time.Hour)
+		listed, price := true, "25"
+
+		// A plain transfer moves the row to its new holder and delists it.
+		if err := repo.RecordPurchase(ctx, models.Subscription{Chain: "default", TokenID: "1", UserID: alice.ID, ModelID: model.ID, ExpiresAt: &later}); err != nil {
+			t.Fatal(err)
+		}
+		sub, err := repo.FindOne(ctx, SubscriptionFilter{Chain: "default", TokenID: "1", UserID: &alice.ID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := repo.Update(ctx, sub.ID, SubscriptionUpdate{IsListed: &listed, Price: &price}); err != nil {
+			t.Fatal(err)
+		}
+		moved, err := repo.Transfer(ctx, sub.ID, bob.ID)
+		if err != nil {
+			t.Fatalf("Transfer: %v", err)
+		}
+		if moved.ID != sub.ID || moved.UserID != bob.ID || moved.IsListed {
+			t.Fatalf("transferred %+v, want subscription %s held by Bob and unlisted", moved, sub.ID.Hex())
+		}
+
+		// Transferring to a holder of the same token merges into their row,
+		// which keeps the later expiry.
+		if err := repo.RecordPurchase(ctx, models.Subscription{Chain: "default", TokenID: "2", UserID: alice.ID, ModelID: model.ID, ExpiresAt: &later}); err != nil {
+			t.Fatal(err)
+		}
+		if err := repo.RecordPurchase(ctx, models.Subscription{Chain: "default", TokenID: "2", UserID: bob.ID, ModelID: model.ID, ExpiresAt: &earlier}); err != nil {
+			t.Fatal(err)
+		}
+		source, err := repo.FindOne(ctx, SubscriptionFilter{Chain: "default", TokenID: "2", UserID: &alice.ID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		target, err := repo.FindOne(ctx, SubscriptionFilter{Chain: "default", TokenID: "2", UserID: &bob.ID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		merged, err := repo.Transfer(ctx, source.ID, bob.ID)
+		if err != nil {
+			t.Fatalf("Transfer onto an existing holder: %v", err)
+		}
+		if merged.ID != target.ID || merged.UserID != bob.ID || merged.ExpiresAt == nil || !merged.ExpiresAt.Equal(later) {
+			t.Fatalf("merged %+v, want Bob's subscription %s expiring %s", merged, target.ID.Hex(), later)
+		}
+		rows, err := repo.Find(ctx, SubscriptionFilter{Chain: "default", TokenID: "2"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(rows) != 1 {
+			t.Fatalf("%d subscriptions to token 2 after merging, want 1", len(rows))
+		}
+
+		if _, err := repo.Transfer(ctx, primitive.NewObjectID(), bob.ID); err != ErrNotFound {
+			t.Fatalf("transferring a missing subscription: %v, want ErrNotFound", err)
+		}
+	})
+}
+
+func TestMergedTerm(t *testing.T) {
+	now := time.Now()
+	past, future := now.Add(-time.Hour), now.Add(time.Hour)
+
+	tests := []struct {
+		name           string
+		source, target models.Subscription
+		want           *time.Time
+		expired        bool
+	}{
+		{"source later", models.Subscription{ExpiresAt: &future}, models.Subscription{ExpiresAt: &past, Expired: true}, &future, false},
+		{"target later", models.Subscription{ExpiresAt: &past}, models.Subscription{ExpiresAt: &future}, &future, false},
+		{"source never expires", models.Subscription{}, models.Subscription{ExpiresAt: &past, Expired: true}, nil, false},
+		{"target never expires", models.Subscription{ExpiresAt: &future}, models.Subscription{}, nil, false},
+		{"both past", models.Subscription{ExpiresAt: &past}, models.Subscription{ExpiresAt: &past, Expired: true}, &past, true},
+	}
+
+	for _, tt := range tests {
+		got, expired := mergedTerm(tt.source, tt.target, now)
+		if (got == nil) != (tt.want == nil) || (got != nil && !got.Equal(*tt.want)) || expired != tt.expired {
+			t.Errorf("%s: mergedTerm = %v, %t, want %v, %t", tt.name, got, expired, tt.want, tt.expired)
+		}
+	}
+}
+
+func TestFindWithModelsOrphans(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, b backend) {
+		ctx := context.Background()
+		alice := newUser(t, b.repos, "alice")
+		model := newModel(t, b.repos, "7")
+
+		if err := b.repos.Subscriptions.RecordPurchase(ctx, models.Subscription{Chain: "default", TokenID: "1", UserID: alice.ID, ModelID: model.ID}); err != nil {
+			t.Fatal(err)
+		}
+		missing := primitive.NewObjectID()
+		b.orphan(t, models.Subscription{Chain: "default", TokenID: "2", UserID: alice.ID, ModelID: missing})
+
+		joined, err := b.repos.Subscriptions.FindWithModels(ctx, SubscriptionFilter{Chain: "default", UserID: &alice.ID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(joined) != 2 {
+			t.Fatalf("FindWithModels returned %d subscriptions, want 2", len(joined))
+		}
+		for _, sub := range joined {
+			switch sub.TokenID {
+			case "1":
+				if sub.Model == nil || sub.Model.ID != model.ID || sub.Model.Name != model.Name {
+					t.Errorf("token 1 joined model %+v, want %s", sub.Model, model.ID.Hex())
+				}
+			case "2":
+				if sub.Model != nil || sub.ModelID != missing {
+					t.Errorf("orphaned token 2 joined model %+v, model_id %s", sub.Model, sub.ModelID.Hex())
+				}
+			}
+		}
+	})
+}
+
+func TestExpireDue(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, b backend) {
+		ctx := context.Background()
+		repo := b.repos.Subscriptions
+		alice := newUser(t, b.repos, "alice")
+		model := newModel(t, b.repos, "7")
+
+		now := time.Now().UTC().Truncate(time.Second)
+		past, future := now.Add(-time.Hour), now.Add(time.Hour)
+		listed, price := true, "25"
+
+		for token, expiresAt := range map[string]*time.Time{"past": &past, "future": &future, "never": nil} {
+			if err := repo.RecordPurchase(ctx, models.Subscription{Chain: "default", TokenID: token, UserID: alice.ID, ModelID: model.ID, ExpiresAt: expiresAt}); err != nil {
+				t.Fatal(err)
+			}
+			sub, err := repo.FindOne(ctx, SubscriptionFilter{Chain: "default", TokenID: token})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := repo.Update(ctx, sub.ID, SubscriptionUpdate{IsListed: &listed, Price: &price}); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		n, err := repo.ExpireDue(ctx, now)
+		if err != nil || n != 1 {
+			t.Fatalf("ExpireDue = %d, %v, want 1", n, err)
+		}
+		for token, wantExpired := range map[string]bool{"past": true, "future": false, "never": false} {
+			sub, err := repo.FindOne(ctx, SubscriptionFilter{Chain: "default", TokenID: token})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if sub.Expired != wantExpired || sub.IsListed == wantExpired {
+				t.Errorf("%s: expired %t, listed %t, want expired %t and listed %t", token, sub.Expired, sub.IsListed, wantExpired, !wantExpired)
+			}
+		}
+
+		if n, err := repo.ExpireDue(ctx, now); err != nil || n != 0 {
+			t.Fatalf("second ExpireDue = %d, %v, want 0", n, err)
+		}
+	})
+}
@@ -0,0 +1,159 @@
+// Package storage defines the repositories the HTTP handlers read and write
+// users, models, subscriptions and subscription options through, with a
+// MongoDB implementation, an ent one for SQLite and PostgreSQL, and an
+// in-memory one for tests.
+package storage
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"arjunmal1311/fans_flow_on_chain/backend/models"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var (
+	// ErrNotFound is returned by lookups that match nothing.
+	ErrNotFound = errors.New("not found")
+	// ErrDuplicate is returned, wrapped with the offending field, when a
+	// write would break a unique constraint.
+	ErrDuplicate = errors.New("duplicate")
+)
+
+// Repos is the set of repositories a backend provides.
+type Repos struct {
+	Users         UserRepo
+	Models        ModelRepo
+	Subscriptions SubscriptionRepo
+	Options       SubscriptionOptionRepo
+}
+
+// UserRepo stores users. Username, email and wallet address are unique;
+// wallet addresses are stored lower-cased.
+type UserRepo interface {
+	// Create inserts user, assigning an ID if it has none. It returns
+	// ErrDuplicate when the username, email or wallet address is taken.
+	Create(ctx context.Context, user *models.User) error
+	Get(ctx context.Context, id primitive.ObjectID) (*models.User, error)
+	// FindByWallet matches the wallet address case-insensitively.
+	FindByWallet(ctx context.Context, wallet string) (*models.User, error)
+	FindByEmail(ctx context.Context, email string) (*models.User, error)
+	FindByOpenAiTokenID(ctx context.Context, tokenID string) (*models.User, error)
+	List(ctx context.Context) ([]models.User, error)
+}
+
+// ModelRepo stores models. model_id and non-empty slugs are unique, and a
+// model cannot be registered with the email or wallet address of another.
+// Wallet addresses are stored lower-cased.
+type ModelRepo interface {
+	// Create inserts model, assigning an ID if it has none. It returns
+	// ErrDuplicate when the model_id, email, wallet address or slug is
+	// taken.
+	Create(ctx context.Context, model *models.Model) error
+	Get(ctx context.Context, id primitive.ObjectID) (*models.Model, error)
+	FindByModelID(ctx context.Context, modelID string) (*models.Model, error)
+	FindBySlug(ctx context.Context, slug string) (*models.Model, error)
+	// FindByWallet matches the wallet address case-insensitively.
+	FindByWallet(ctx context.Context, wallet string) (*models.Model, error)
+	List(ctx context.Context) ([]models.Model, error)
+	// SetPosts records how many posts the model has.
+	SetPosts(ctx context.Context, id primitive.ObjectID, count int64) error
+}
+
+// SubscriptionFilter selects subscriptions. Zero fields match everything.
+type SubscriptionFilter struct {
+	Chain   string
+	TokenID string
+	UserID  *primitive.ObjectID
+	ModelID *primitive.ObjectID
+	TxHash  string
+	Listed  *bool
+	// Expired matches the flag set by ExpireDue, which is unset on
+	// subscriptions it has not swept.
+	Expired *bool
+	// Status is models.SubscriptionActive or models.SubscriptionExpired as
+	// of Now (the current time when zero).
+	Status string
+	Now    time.Time
+}
+
+func (f SubscriptionFilter) now() time.Time {
+	if f.Now.IsZero() {
+		return time.Now()
+	}
+	return f.Now
+}
+
+// SubscriptionUpdate changes the non-nil fields of a subscription.
+type SubscriptionUpdate struct {
+	UserID       *primitive.ObjectID
+	Price        *string
+	ListingID    *string
+	IsListed     *bool
+	TxHash       *string
+	ExpiresAt    *time.Time
+	HeldOnChain  *bool
+	ReconciledAt *time.Time
+}
+
+// SubscriptionWithModel is a subscription joined with its model. Model is
+// nil for orphaned subscriptions, whose model no longer exists.
+type SubscriptionWithModel struct {
+	models.Subscription
+	Model *models.Model
+}
+
+// SubscriptionRepo stores subscriptions, which are keyed by chain, token
+// and holder.
+type SubscriptionRepo interface {
+	Find(ctx context.Context, filter SubscriptionFilter) ([]models.Subscription, error)
+	FindOne(ctx context.Context, filter SubscriptionFilter) (*models.Subscription, error)
+	// FindWithModels is Find with each subscription's model joined in the
+	// same query.
+	FindWithModels(ctx context.Context, filter SubscriptionFilter) ([]SubscriptionWithModel, error)
+	// Latest returns the subscription to tokenID that expires last,
+	// optionally on one chain.
+	Latest(ctx context.Context, chain, tokenID string) (*models.Subscription, error)
+	// RecordPurchase creates or updates the subscription with sub's chain,
+	// token and holder, setting its model, transaction, term and option.
+	// New subscriptions start unlisted; listing details are kept.
+	RecordPurchase(ctx context.Context, sub models.Subscription) error
+	// Update applies update to the subscription with the given ID and
+	// returns the result, or ErrDuplicate when moving it to update.UserID
+	// would give that user a second subscription with the same chain and
+	// token.
+	Update(ctx context.Context, id primitive.ObjectID, update SubscriptionUpdate) (*models.Subscription, error)
+	// Transfer hands the subscription with the given ID to user to and
+	// delists it. If to already holds the token on that chain the two are
+	// merged instead: theirs keeps whichever expiry is later and the
+	// transferred one is removed. It returns the subscription to now holds.
+	Transfer(ctx context.Context, id, to primitive.ObjectID) (*models.Subscription, error)
+	// ExpireDue marks the subscriptions that expired by now as expired and
+	// delists them, returning how many changed.
+	ExpireDue(ctx context.Context, now time.Time) (int64, error)
+}
+
+// mergedTerm returns the expiry and expired flag target has once source
+// is merged into it by Transfer: the later of the two expiries, where nil
+// never expires, and expired only while that is still past.
+func mergedTerm(source, target models.Subscription, now time.Time) (*time.Time, bool) {
+	expiresAt := target.ExpiresAt
+	switch {
+	case source.ExpiresAt == nil:
+		expiresAt = nil
+	case expiresAt != nil && source.ExpiresAt.After(*expiresAt):
+		expiresAt = source.ExpiresAt
+	}
+	return expiresAt, target.Expired && expiresAt != nil && !expiresAt.After(now)
+}
+
+// SubscriptionOptionRepo stores the subscription options models offer.
+type SubscriptionOptionRepo interface {
+	// Create inserts option, assigning an ID if it has none.
+	Create(ctx context.Context, option *models.SubscriptionOption) error
+	Get(ctx context.Context, id primitive.ObjectID) (*models.SubscriptionOption, error)
+	// ListByModel returns the options of the model with the given model_id.
+	ListByModel(ctx context.Context, modelID string) ([]models.SubscriptionOption, error)
+}
@@ -0,0 +1,86 @@
+// Package tokenid mirrors the token ID encoding of the BlockTeaseNFTs
+// contract, where each subscription option of a model has its own ERC-1155
+// token: tokenId = modelId * 10**18 + subscriptionId.
+package tokenid
+
+import (
+	"errors"
+	"math/big"
+)
+
+var (
+	// modelFactor is the 10**18 multiplier of _encodeTokenId.
+	modelFactor = new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
+	// maxUint256 is the largest value a Solidity uint256 can hold.
+	maxUint256 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+)
+
+var (
+	ErrNegative = errors.New("token id components must not be negative")
+	ErrOverflow = errors.New("token id does not fit in uint256")
+	ErrInvalid  = errors.New("token id must be a decimal integer")
+)
+
+// EncodeTokenID matches _encodeTokenId. Inputs the contract would revert on
+// (overflow) or cannot represent (negative values) return an error.
+func EncodeTokenID(modelID, subscriptionID *big.Int) (*big.Int, error) {
+	if modelID.Sign() < 0 || subscriptionID.Sign() < 0 {
+		return nil, ErrNegative
+	}
+
+	tokenID := new(big.Int).Mul(modelID, modelFactor)
+	tokenID.Add(tokenID, subscriptionID)
+	if tokenID.Cmp(maxUint256) > 0 {
+		return nil, ErrOverflow
+	}
+	return tokenID, nil
+}
+
+// DecodeTokenID matches _decodeTokenId.
+func DecodeTokenID(tokenID *big.Int) (modelID, subscriptionID *big.Int, err error) {
+	if tokenID.Sign() < 0 {
+		return nil, nil, ErrNegative
+	}
+	if tokenID.Cmp(maxUint256) > 0 {
+		return nil, nil, ErrOverflow
+	}
+
+	modelID, subscriptionID = new(big.Int).QuoRem(tokenID, modelFactor, new(big.Int))
+	return modelID, subscriptionID, nil
+}
+
+// Parse reads a token ID in the decimal form it is stored in.
+func Parse(s string) (*big.Int, error) {
+	tokenID, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return nil, ErrInvalid
+	}
+	if tokenID.Sign() < 0 {
+		return nil, ErrNegative
+	}
+	if tokenID.Cmp(maxUint256) > 0 {
+		return nil, ErrOverflow
+	}
+	return tokenID, nil
+}
+
+// ModelID returns the model a decimal token ID belongs to.
+func ModelID(s string) (*big.Int, error) {
+	tokenID, err := Parse(s)
+	if err != nil {
+		return nil, err
+	}
+	modelID, _, err := DecodeTokenID(tokenID)
+	if err != nil {
+		return nil, err
+	}
+	return modelID, nil
+}
+
+// SameModel reports whether the decimal model ID s is modelID. Model IDs
+// are stored as strings, which may carry leading zeros the contract's
+// uint256 does not.
+func SameModel(modelID *big.Int, s string) bool {
+	other, ok := new(big.Int).SetString(s, 10)
+	return ok && other.Cmp(modelID) == 0
+}
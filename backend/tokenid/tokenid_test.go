@@ -0,0 +1,197 @@
+package tokenid
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+// solidity computes modelId * 10**18 + subscriptionId the way the contract
+// does, without its uint256 bound.
+func solidity(modelID, subscriptionID *big.Int) *big.Int {
+	factor := new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
+	tokenID := new(big.Int).Mul(modelID, factor)
+	return tokenID.Add(tokenID, subscriptionID)
+}
+
+func decimal(s string) *big.Int {
+	n, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		panic("bad decimal " + s)
+	}
+	return n
+}
+
+func TestEncodeTokenID(t *testing.T) {
+	tests := []struct {
+		name           string
+		modelID        string
+		subscriptionID string
+		want           string
+		err            error
+		// decoded is what DecodeTokenID returns when it differs from the
+		// inputs.
+		decodedModel, decodedSubscription string
+	}{
+		{name: "zero", modelID: "0", subscriptionID: "0", want: "0"},
+		{name: "first option", modelID: "7", subscriptionID: "1", want: "7000000000000000001"},
+		{name: "largest subscription id", modelID: "1", subscriptionID: "999999999999999999", want: "1999999999999999999"},
+		{
+			name:           "subscription id carries into model id",
+			modelID:        "1",
+			subscriptionID: "1000000000000000000",
+			want:           "2000000000000000000",
+			decodedModel:   "2", decodedSubscription: "0",
+		},
+		{name: "negative model id", modelID: "-1", subscriptionID: "0", err: ErrNegative},
+		{name: "negative subscription id", modelID: "1", subscriptionID: "-1", err: ErrNegative},
+		{
+			name:           "overflow",
+			modelID:        new(big.Int).Add(new(big.Int).Quo(maxUint256, modelFactor), big.NewInt(1)).String(),
+			subscriptionID: "0",
+			err:            ErrOverflow,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := EncodeTokenID(decimal(tt.modelID), decimal(tt.subscriptionID))
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("EncodeTokenID error = %v, want %v", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("EncodeTokenID: %v", err)
+			}
+			if got.String() != tt.want {
+				t.Fatalf("EncodeTokenID = %s, want %s", got, tt.want)
+			}
+
+			wantModel, wantSubscription := tt.modelID, tt.subscriptionID
+			if tt.decodedModel != "" {
+				wantModel, wantSubscription = tt.decodedModel, tt.decodedSubscription
+			}
+			modelID, subscriptionID, err := DecodeTokenID(got)
+			if err != nil {
+				t.Fatalf("DecodeTokenID: %v", err)
+			}
+			if modelID.String() != wantModel || subscriptionID.String() != wantSubscription {
+				t.Fatalf("DecodeTokenID = %s, %s, want %s, %s", modelID, subscriptionID, wantModel, wantSubscription)
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in  string
+		err error
+	}{
+		{in: "7000000000000000001"},
+		{in: maxUint256.String()},
+		{in: new(big.Int).Add(maxUint256, big.NewInt(1)).String(), err: ErrOverflow},
+		{in: "-1", err: ErrNegative},
+		{in: "0x10", err: ErrInvalid},
+		{in: "", err: ErrInvalid},
+	}
+
+	for _, tt := range tests {
+		got, err := Parse(tt.in)
+		if !errors.Is(err, tt.err) {
+			t.Errorf("Parse(%q) error = %v, want %v", tt.in, err, tt.err)
+			continue
+		}
+		if err == nil && got.String() != tt.in {
+			t.Errorf("Parse(%q) = %s", tt.in, got)
+		}
+	}
+}
+
+func TestSameModel(t *testing.T) {
+	tests := []struct {
+		modelID int64
+		s       string
+		want    bool
+	}{
+		{7, "7", true},
+		{7, "007", true},
+		{0, "0", true},
+		{7, "8", false},
+		{7, "", false},
+		{7, "seven", false},
+	}
+
+	for _, tt := range tests {
+		if got := SameModel(big.NewInt(tt.modelID), tt.s); got != tt.want {
+			t.Errorf("SameModel(%d, %q) = %t, want %t", tt.modelID, tt.s, got, tt.want)
+		}
+	}
+}
+
+func FuzzEncodeDecode(f *testing.F) {
+	f.Add([]byte{7}, []byte{1}, false, false)
+	f.Add([]byte{}, []byte{}, false, false)
+	f.Add([]byte{1}, new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil).Bytes(), false, false)
+	f.Add([]byte{1}, []byte{1}, true, false)
+	f.Add([]byte{1}, []byte{1}, false, true)
+	f.Add(maxUint256.Bytes(), []byte{0}, false, false)
+
+	factor := new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
+
+	f.Fuzz(func(t *testing.T, modelBytes, subscriptionBytes []byte, negativeModel, negativeSubscription bool) {
+		if len(modelBytes) > 40 || len(subscriptionBytes) > 40 {
+			t.Skip()
+		}
+		modelID := new(big.Int).SetBytes(modelBytes)
+		subscriptionID := new(big.Int).SetBytes(subscriptionBytes)
+		if negativeModel {
+			modelID.Neg(modelID)
+		}
+		if negativeSubscription {
+			subscriptionID.Neg(subscriptionID)
+		}
+
+		got, err := EncodeTokenID(modelID, subscriptionID)
+		if modelID.Sign() < 0 || subscriptionID.Sign() < 0 {
+			if !errors.Is(err, ErrNegative) {
+				t.Fatalf("EncodeTokenID(%s, %s) error = %v, want ErrNegative", modelID, subscriptionID, err)
+			}
+			return
+		}
+
+		want := solidity(modelID, subscriptionID)
+		if want.Cmp(maxUint256) > 0 {
+			if !errors.Is(err, ErrOverflow) {
+				t.Fatalf("EncodeTokenID(%s, %s) error = %v, want ErrOverflow", modelID, subscriptionID, err)
+			}
+			return
+		}
+		if err != nil {
+			t.Fatalf("EncodeTokenID(%s, %s): %v", modelID, subscriptionID, err)
+		}
+		if got.Cmp(want) != 0 {
+			t.Fatalf("EncodeTokenID(%s, %s) = %s, want %s", modelID, subscriptionID, got, want)
+		}
+
+		decodedModel, decodedSubscription, err := DecodeTokenID(got)
+		if err != nil {
+			t.Fatalf("DecodeTokenID(%s): %v", got, err)
+		}
+		// The contract does not bound subscriptionId, so ids of 10**18 and
+		// above carry into the model id.
+		wantModel, wantSubscription := new(big.Int).QuoRem(want, factor, new(big.Int))
+		if decodedModel.Cmp(wantModel) != 0 || decodedSubscription.Cmp(wantSubscription) != 0 {
+			t.Fatalf("DecodeTokenID(%s) = %s, %s, want %s, %s", got, decodedModel, decodedSubscription, wantModel, wantSubscription)
+		}
+		if subscriptionID.Cmp(factor) < 0 && (decodedModel.Cmp(modelID) != 0 || decodedSubscription.Cmp(subscriptionID) != 0) {
+			t.Fatalf("DecodeTokenID(%s) = %s, %s, want %s, %s", got, decodedModel, decodedSubscription, modelID, subscriptionID)
+		}
+
+		parsed, err := Parse(got.String())
+		if err != nil || parsed.Cmp(got) != 0 {
+			t.Fatalf("Parse(%s) = %v, %v", got, parsed, err)
+		}
+	})
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	"arjunmal1311/fans_flow_on_chain/backend/models"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -11,6 +13,18 @@ type UserResponse struct {
 	Message string      `json:"message,omitempty"`
 	Data    interface{} `json:"data,omitempty"`
 	Error   string      `json:"error,omitempty"`
+	Code    string      `json:"code,omitempty"`
+	// Orphaned lists subscriptions left out of Data because their model
+	// no longer exists.
+	Orphaned []OrphanedSubscription `json:"orphaned,omitempty"`
+}
+
+// OrphanedSubscription identifies a subscription whose model is missing.
+type OrphanedSubscription struct {
+	ID      primitive.ObjectID `json:"id"`
+	Chain   string             `json:"chain"`
+	TokenID string             `json:"token_id"`
+	ModelID primitive.ObjectID `json:"model_id"`
 }
 
 type RegisterRequest struct {
@@ -27,12 +41,16 @@ type UserInfoResponse struct {
 }
 
 type SubscriptionDetails struct {
-	ModelID   string `json:"modelId"`
-	ModelName string `json:"modelName"`
-	IpfsUrl   string `json:"ipfsUrl"`
-	TokenID   string `json:"tokenId"`
-	IsListed  bool   `json:"isListed"`
-	Price     string `json:"price,omitempty"`
+	Chain     string     `json:"chain"`
+	ModelID   string     `json:"modelId"`
+	ModelName string     `json:"modelName"`
+	IpfsUrl   string     `json:"ipfsUrl"`
+	TokenID   string     `json:"tokenId"`
+	IsListed  bool       `json:"isListed"`
+	Price     string     `json:"price,omitempty"`
+	Status    string     `json:"status"`
+	StartsAt  *time.Time `json:"startsAt,omitempty"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
 }
 
 type UserModelInfoResponse struct {
@@ -41,15 +59,20 @@ type UserModelInfoResponse struct {
 }
 
 type PurchaseSubscriptionRequest struct {
-	Email   string `json:"email"`
-	ModelId string `json:"modelId"`
-	TokenId string `json:"tokenId"`
+	Email                string `json:"email"`
+	ModelId              string `json:"modelId"`
+	TokenId              string `json:"tokenId"`
+	TxHash               string `json:"txHash"`
+	SubscriptionOptionId string `json:"subscriptionOptionId,omitempty"`
 }
 
 type PurchaseSubscriptionResponse struct {
-	UserId  primitive.ObjectID `json:"userId"`
-	ModelId primitive.ObjectID `json:"modelId"`
-	TokenId string             `json:"tokenId"`
+	Chain     string             `json:"chain"`
+	UserId    primitive.ObjectID `json:"userId"`
+	ModelId   primitive.ObjectID `json:"modelId"`
+	TokenId   string             `json:"tokenId"`
+	StartsAt  *time.Time         `json:"startsAt,omitempty"`
+	ExpiresAt *time.Time         `json:"expiresAt,omitempty"`
 }
 
 type RegisterModelRequest struct {
@@ -65,36 +88,48 @@ type RegisterModelRequest struct {
 	Value         float64 `json:"value"`
 	Views         int64   `json:"views"`
 	Tease         int64   `json:"tease"`
-	Posts         int64   `json:"posts"`
 	Image         struct {
 		Src string `json:"src"`
 	} `json:"image"`
 	Icon struct {
 		Src string `json:"src"`
 	} `json:"icon"`
+	// AssetID is the published avatar; when set, image and icon are taken
+	// from its renditions.
+	AssetID string `json:"assetId,omitempty"`
 }
 
 type ListSubscriptionRequest struct {
 	TokenId   string `json:"tokenId"`
-	ListingId string `json:"listingId"`
+	ListingId string `json:"listingId,omitempty"`
 	Price     string `json:"price"`
 }
 
+// UpdateSubscriptionRequest transfers a subscription to the user identified
+// by WalletAddress or Email. Field names are matched case-insensitively, so
+// the legacy {"TokenId", "WalletAddress", "IsListed", "Price"} body decodes
+// into it as well. TxHash is the marketplace sale and is required when the
+// subscription changes holder.
 type UpdateSubscriptionRequest struct {
-	TokenId       string `json:"TokenId"`
-	WalletAddress string `json:"WalletAddress"`
-	IsListed      bool   `json:"IsListed"`
-	Price         string `json:"Price"`
+	TokenId       string  `json:"tokenId"`
+	WalletAddress string  `json:"walletAddress,omitempty"`
+	Email         string  `json:"email,omitempty"`
+	IsListed      bool    `json:"isListed"`
+	Price         *string `json:"price,omitempty"`
+	TxHash        string  `json:"txHash,omitempty"`
 }
 
 type ListedSubscriptionResponse struct {
 	ID        primitive.ObjectID `json:"id"`
+	Chain     string             `json:"chain"`
 	UserID    primitive.ObjectID `json:"user_id"`
 	ModelID   primitive.ObjectID `json:"model_id"`
 	TokenID   string             `json:"token_id"`
 	ListingID string             `json:"listing_id,omitempty"`
 	Price     string             `json:"price,omitempty"`
 	IsListed  bool               `json:"is_listed"`
+	Status    string             `json:"status"`
+	ExpiresAt *time.Time         `json:"expires_at,omitempty"`
 	Model     ModelInfo          `json:"model"`
 }
 
@@ -136,85 +171,130 @@ type ModelInfo struct {
 	IpfsUrl string `json:"ipfs_url"`
 }
 
-type ChainSubscriptionRequest struct {
-	Email   string `json:"email"`
-	ModelId string `json:"modelId"`
-	TokenId string `json:"tokenId"`
+type GenerateAvatarRequest struct {
+	Name   string `json:"name"`
+	Prompt string `json:"prompt"`
 }
 
-type ChainListSubscriptionRequest struct {
-	TokenId string `json:"tokenId"`
-	Price   string `json:"price,omitempty"`
+type CreateNFTMetadataRequest struct {
+	AssetID         string                 `json:"assetId"`
+	Name            string                 `json:"name"`
+	Description     string                 `json:"description"`
+	ExternalURL     string                 `json:"externalUrl"`
+	AnimationURL    string                 `json:"animationUrl"`
+	BackgroundColor string                 `json:"backgroundColor"`
+	Attributes      []TokenAttribute       `json:"attributes"`
+	Properties      map[string]interface{} `json:"properties"`
 }
 
-type ChainUpdateSubscriptionRequest struct {
-	TokenId string `json:"tokenId"`
-	Email   string `json:"email"`
+// CIDResponse is what POST /ipfs/cid returns for an uploaded file.
+type CIDResponse struct {
+	CIDv0      string `json:"cidV0"`
+	CIDv1      string `json:"cidV1"`
+	Size       int64  `json:"size"`
+	GatewayURL string `json:"gatewayUrl"`
 }
 
-type ChainSubscriptionResponse struct {
-	UserId  primitive.ObjectID `json:"userId"`
-	ModelId primitive.ObjectID `json:"modelId"`
-	TokenId string             `json:"tokenId"`
+type CreateNFTMetadataResponse struct {
+	Success      bool   `json:"success"`
+	Message      string `json:"message"`
+	ImageURL     string `json:"imageUrl"`
+	IpfsURL      string `json:"ipfsUrl"`
+	MetadataJSON string `json:"metadataJson"`
+	// Renditions maps rendition names (icon, card, full) to their public
+	// URLs.
+	Renditions map[string]string `json:"renditions,omitempty"`
 }
 
-type ChainListedSubscriptionResponse struct {
-	ID       primitive.ObjectID `json:"id"`
-	UserID   primitive.ObjectID `json:"user_id"`
-	ModelID  primitive.ObjectID `json:"model_id"`
-	TokenID  string             `json:"token_id"`
-	Price    string             `json:"price,omitempty"`
-	IsListed bool               `json:"is_listed"`
-	Model    ModelInfo          `json:"model"`
+type ServerStorageCleanRequest struct {
+	AssetID string `json:"assetId"`
 }
 
-type GenerateAvatarRequest struct {
-	Name   string `json:"name"`
-	Prompt string `json:"prompt"`
+// CreatePostRequest publishes a post. Visibility is "public" or
+// "subscribers"; OptionIDs narrows a subscriber post to holders of those
+// subscription options.
+type CreatePostRequest struct {
+	Text       string   `json:"text"`
+	AssetIDs   []string `json:"assetIds,omitempty"`
+	Visibility string   `json:"visibility"`
+	OptionIDs  []string `json:"optionIds,omitempty"`
 }
 
-type GenerateAvatarResponse struct {
-	Success  bool   `json:"success"`
-	Message  string `json:"message,omitempty"`
-	FilePath string `json:"filePath,omitempty"`
-	Error    string `json:"error,omitempty"`
+// PostResponse is a post as a viewer sees it. Locked posts leave out the
+// text and media and only say how much media there is.
+type PostResponse struct {
+	ID         primitive.ObjectID   `json:"id"`
+	ModelID    primitive.ObjectID   `json:"modelId"`
+	Visibility string               `json:"visibility"`
+	OptionIDs  []primitive.ObjectID `json:"optionIds,omitempty"`
+	Locked     bool                 `json:"locked"`
+	Text       string               `json:"text,omitempty"`
+	AssetIDs   []string             `json:"assetIds,omitempty"`
+	MediaCount int                  `json:"mediaCount"`
+	CreatedAt  time.Time            `json:"createdAt"`
 }
 
-type CreateNFTMetadataRequest struct {
-	Name        string `json:"name"`
-	Description string `json:"description"`
+// SetAssetAccessRequest changes who sees an asset's original through
+// /media. ModelID is required for the "subscribers" tier.
+type SetAssetAccessRequest struct {
+	Access  string `json:"access"`
+	ModelID string `json:"modelId,omitempty"`
 }
 
-type NFTAttribute struct {
-	TraitType string `json:"trait_type"`
-	Value     string `json:"value"`
+type ServerStorageCleanResponse struct {
+	Success bool   `json:"success"`
+	Message string `json:"message,omitempty"`
+	Error   string `json:"error,omitempty"`
 }
 
-type NFTMetadata struct {
-	Name        string         `json:"name"`
-	Description string         `json:"description"`
-	Image       string         `json:"image"`
-	Attributes  []NFTAttribute `json:"attributes"`
+// ChainInfo is the public view of a registry entry; the RPC URL is left out
+// since it may embed provider credentials.
+type ChainInfo struct {
+	Name                string `json:"name"`
+	ChainID             uint64 `json:"chainId"`
+	DisplayName         string `json:"displayName"`
+	MarketplaceAddress  string `json:"marketplaceAddress,omitempty"`
+	NFTAddress          string `json:"nftAddress,omitempty"`
+	PaymentTokenAddress string `json:"paymentTokenAddress,omitempty"`
+	PaymentDecimals     int    `json:"paymentDecimals"`
+	Confirmations       uint64 `json:"confirmations"`
+	ExplorerURL         string `json:"explorerUrl,omitempty"`
+	Enabled             bool   `json:"enabled"`
 }
 
-type PinataResponse struct {
-	IpfsHash string `json:"IpfsHash"`
+type NonceResponse struct {
+	Nonce string `json:"nonce"`
 }
 
-type CreateNFTMetadataResponse struct {
-	Success      bool   `json:"success"`
-	Message      string `json:"message"`
-	ImageURL     string `json:"imageUrl"`
-	IpfsURL      string `json:"ipfsUrl"`
-	MetadataJSON string `json:"metadataJson"`
+type VerifySIWERequest struct {
+	Message   string `json:"message"`
+	Signature string `json:"signature"`
 }
 
-type ServerStorageCleanRequest struct {
-	Name string `json:"name"`
+type SessionResponse struct {
+	Token         string    `json:"token,omitempty"`
+	WalletAddress string    `json:"walletAddress"`
+	ChainID       uint64    `json:"chainId"`
+	ExpiresAt     time.Time `json:"expiresAt"`
 }
 
-type ServerStorageCleanResponse struct {
-	Success bool   `json:"success"`
-	Message string `json:"message,omitempty"`
-	Error   string `json:"error,omitempty"`
+// TokenMetadata is ERC-1155 token metadata, with OpenSea-style attributes
+// alongside the spec's properties.
+type TokenMetadata struct {
+	Name            string                 `json:"name"`
+	Description     string                 `json:"description"`
+	Image           string                 `json:"image"`
+	ExternalURL     string                 `json:"external_url,omitempty"`
+	AnimationURL    string                 `json:"animation_url,omitempty"`
+	BackgroundColor string                 `json:"background_color,omitempty"`
+	Decimals        int                    `json:"decimals"`
+	Properties      map[string]interface{} `json:"properties,omitempty"`
+	Attributes      []TokenAttribute       `json:"attributes"`
+}
+
+type TokenAttribute struct {
+	TraitType   string      `json:"trait_type"`
+	Value       interface{} `json:"value"`
+	DisplayType string      `json:"display_type,omitempty"`
+	MaxValue    interface{} `json:"max_value,omitempty"`
 }